@@ -5,9 +5,13 @@ import (
 	"os"
 
 	"github.com/fahmifan/autograd/cmd"
+	"github.com/fahmifan/autograd/pkg/core/grading/sandbox"
 )
 
 func main() {
+	// must be first, the sandbox runner re-executes the binary
+	sandbox.Init()
+
 	if err := cmd.Execute(); err != nil {
 		fmt.Fprint(os.Stderr, err)
 	}
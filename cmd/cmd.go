@@ -15,6 +15,9 @@ import (
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/core/auth/auth_cmd"
 	"github.com/fahmifan/autograd/pkg/core/core_service"
+	"github.com/fahmifan/autograd/pkg/core/grading/grading_cmd"
+	"github.com/fahmifan/autograd/pkg/core/grading/sandbox"
+	"github.com/fahmifan/autograd/pkg/core/isolate"
 	"github.com/fahmifan/autograd/pkg/core/user_management/user_management_cmd"
 	"github.com/fahmifan/autograd/pkg/dbconn"
 	"github.com/fahmifan/autograd/pkg/fs"
@@ -75,11 +78,39 @@ func mustInitService() *core_service.Service {
 	return svc
 }
 
+// mustInitGradingRunner sets the registry of the configured grading runner,
+// only the commands that grade submissions need it
+func mustInitGradingRunner() {
+	switch runner := config.GradingRunner(); runner {
+	case "podman":
+		// podman is the default registry
+	case "sandbox":
+		registry, err := sandbox.DefaultRegistry(sandbox.Config{CgroupPath: config.SandboxCgroupPath()})
+		if err != nil {
+			log.Fatal("init sandbox failed:", err)
+		}
+		grading_cmd.SetDefaultRegistry(registry)
+	case "isolate":
+		registry, err := isolate.DefaultRegistry(isolate.Config{
+			BinPath:       config.IsolateBinPath(),
+			BoxCount:      int(config.WorkerConcurrency()),
+			ControlGroups: true,
+		})
+		if err != nil {
+			log.Fatal("init isolate failed:", err)
+		}
+		grading_cmd.SetDefaultRegistry(registry)
+	default:
+		log.Fatalf("unknown grading runner %q, it must be podman, sandbox or isolate", runner)
+	}
+}
+
 func serverCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "server",
 		Short: "Run autograd server",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			mustInitGradingRunner()
 			service := mustInitService()
 
 			ctx := context.Background()
@@ -131,6 +162,7 @@ func adminCmd() *cobra.Command {
 
 	cmd.AddCommand(runCreateAdminUser())
 	cmd.AddCommand(cmdAdminUser())
+	cmd.AddCommand(cmdAdminAssignment())
 
 	return cmd
 }
@@ -229,6 +261,46 @@ func runAdminCreateUser() *cobra.Command {
 	return cmd
 }
 
+func cmdAdminAssignment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assignment",
+		Short: "Admin assignment command",
+	}
+
+	cmd.AddCommand(runAdminRegradeAssignment())
+
+	return cmd
+}
+
+func runAdminRegradeAssignment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "regrade",
+		Short: "Regrade every submission of an assignment",
+	}
+
+	req := &autogradv1.RegradeAssignmentRequest{}
+	cmd.Flags().StringVar(&req.AssignmentId, "id", "", "assignment id")
+
+	cmd.MarkFlagRequired("id")
+
+	client := initServiceClient()
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		res, err := client.RegradeAssignment(cmd.Context(), &connect.Request[autogradv1.RegradeAssignmentRequest]{
+			Msg: req,
+		})
+		if err != nil {
+			fmt.Println("RegradeAssignment failed:", err)
+			return err
+		}
+
+		fmt.Printf("Regrading %d submissions with test version %d\n", res.Msg.GetSubmissionCount(), res.Msg.GetTestVersion())
+		return nil
+	}
+
+	return cmd
+}
+
 func loginCmd() *cobra.Command {
 	service := mustInitService()
 
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE "submission_case_results" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "submission_id" TEXT NOT NULL,
+    "case_number" INT NOT NULL,
+    "verdict" TEXT NOT NULL,
+    "exit_code" INT NOT NULL DEFAULT 0,
+    "stderr" TEXT NOT NULL DEFAULT '',
+    "wall_time_ms" BIGINT NOT NULL DEFAULT 0,
+    "cpu_time_ms" BIGINT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (submission_id) REFERENCES submissions(id)
+);
+
+CREATE INDEX submission_case_results_submission_id ON submission_case_results ("submission_id");
+
+-- +migrate Down
+DROP TABLE "submission_case_results";
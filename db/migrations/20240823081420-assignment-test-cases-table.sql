@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE "assignment_test_cases" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "assignment_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "position" INT NOT NULL DEFAULT 0,
+    "input" TEXT NOT NULL DEFAULT '',
+    "expected_output" TEXT NOT NULL DEFAULT '',
+    "weight" INT NOT NULL DEFAULT 1,
+    "is_hidden" INT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (assignment_id) REFERENCES assignments(id)
+);
+
+CREATE INDEX assignment_test_cases_assignment_id ON assignment_test_cases ("assignment_id");
+
+ALTER TABLE "submission_case_results" ADD COLUMN "test_case_id" TEXT;
+
+-- +migrate Down
+ALTER TABLE "submission_case_results" DROP COLUMN "test_case_id";
+
+DROP TABLE "assignment_test_cases";
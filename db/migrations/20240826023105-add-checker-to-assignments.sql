@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "checker_type" TEXT NOT NULL DEFAULT 'exact';
+ALTER TABLE "assignments" ADD COLUMN "checker_abs_epsilon" DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "checker_rel_epsilon" DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "checker_source" TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "checker_type";
+ALTER TABLE "assignments" DROP COLUMN "checker_abs_epsilon";
+ALTER TABLE "assignments" DROP COLUMN "checker_rel_epsilon";
+ALTER TABLE "assignments" DROP COLUMN "checker_source";
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "allowed_languages" TEXT NOT NULL DEFAULT 'cpp';
+ALTER TABLE "submissions" ADD COLUMN "language" TEXT NOT NULL DEFAULT 'cpp';
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "allowed_languages";
+ALTER TABLE "submissions" DROP COLUMN "language";
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE "submissions" ADD COLUMN "compile_output" TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "submissions" DROP COLUMN "compile_output";
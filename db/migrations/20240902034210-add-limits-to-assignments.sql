@@ -0,0 +1,12 @@
+-- +migrate Up
+-- zero limit means the language default
+ALTER TABLE "assignments" ADD COLUMN "time_limit_sec" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "memory_limit_mib" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "output_limit_kib" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "process_limit" INT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "time_limit_sec";
+ALTER TABLE "assignments" DROP COLUMN "memory_limit_mib";
+ALTER TABLE "assignments" DROP COLUMN "output_limit_kib";
+ALTER TABLE "assignments" DROP COLUMN "process_limit";
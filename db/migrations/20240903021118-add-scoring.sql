@@ -0,0 +1,18 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "max_score" INT NOT NULL DEFAULT 100;
+ALTER TABLE "assignment_test_cases" ADD COLUMN "group_name" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "submissions" ADD COLUMN "max_score" INT NOT NULL DEFAULT 100;
+ALTER TABLE "submission_case_results" ADD COLUMN "weight" INT NOT NULL DEFAULT 1;
+ALTER TABLE "submission_case_results" ADD COLUMN "group_name" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "submission_case_results" ADD COLUMN "earned_weight" INT NOT NULL DEFAULT 0;
+
+-- previous grading counted every accepted case the same
+UPDATE "submission_case_results" SET "earned_weight" = 1 WHERE "verdict" = 'AC';
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "max_score";
+ALTER TABLE "assignment_test_cases" DROP COLUMN "group_name";
+ALTER TABLE "submissions" DROP COLUMN "max_score";
+ALTER TABLE "submission_case_results" DROP COLUMN "weight";
+ALTER TABLE "submission_case_results" DROP COLUMN "group_name";
+ALTER TABLE "submission_case_results" DROP COLUMN "earned_weight";
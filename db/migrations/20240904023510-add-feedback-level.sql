@@ -0,0 +1,14 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "feedback_level" TEXT NOT NULL DEFAULT 'verdicts';
+-- existing results are hidden, their cases were never shown to the students
+ALTER TABLE "submission_case_results" ADD COLUMN "is_hidden" INT NOT NULL DEFAULT 1;
+ALTER TABLE "submission_case_results" ADD COLUMN "input" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "submission_case_results" ADD COLUMN "expected_output" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "submission_case_results" ADD COLUMN "output" TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "feedback_level";
+ALTER TABLE "submission_case_results" DROP COLUMN "is_hidden";
+ALTER TABLE "submission_case_results" DROP COLUMN "input";
+ALTER TABLE "submission_case_results" DROP COLUMN "expected_output";
+ALTER TABLE "submission_case_results" DROP COLUMN "output";
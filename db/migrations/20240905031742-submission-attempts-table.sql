@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE "submission_attempts" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "submission_id" TEXT NOT NULL,
+    "attempt_number" INT NOT NULL,
+    "file_id" TEXT NOT NULL,
+    "language" TEXT NOT NULL DEFAULT 'cpp',
+    "grade" INT NOT NULL DEFAULT 0,
+    "max_score" INT NOT NULL DEFAULT 100,
+    "is_graded" INT NOT NULL DEFAULT 0,
+    "compile_output" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (submission_id) REFERENCES submissions(id),
+    FOREIGN KEY (file_id) REFERENCES files(id)
+);
+
+CREATE UNIQUE INDEX submission_attempts_submission_id_attempt_number ON submission_attempts ("submission_id", "attempt_number");
+
+ALTER TABLE "submissions" ADD COLUMN "current_attempt_id" TEXT NOT NULL DEFAULT '';
+ALTER TABLE "submission_case_results" ADD COLUMN "attempt_id" TEXT NOT NULL DEFAULT '';
+
+-- the existing submissions become their first attempt, with the same id
+INSERT INTO "submission_attempts" (
+    "id", "submission_id", "attempt_number", "file_id", "language", "grade", "max_score",
+    "is_graded", "compile_output", "created_at", "updated_at", "deleted_at"
+)
+SELECT
+    "id", "id", 1, "file_id", "language", "grade", "max_score",
+    "is_graded", "compile_output", "created_at", "updated_at", "deleted_at"
+FROM "submissions";
+
+UPDATE "submissions" SET "current_attempt_id" = "id";
+UPDATE "submission_case_results" SET "attempt_id" = "submission_id";
+
+CREATE INDEX submission_case_results_attempt_id ON submission_case_results ("attempt_id");
+
+-- +migrate Down
+DROP INDEX submission_case_results_attempt_id;
+ALTER TABLE "submission_case_results" DROP COLUMN "attempt_id";
+ALTER TABLE "submissions" DROP COLUMN "current_attempt_id";
+DROP TABLE "submission_attempts";
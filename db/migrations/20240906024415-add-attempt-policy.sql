@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "max_attempts" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "attempt_cooldown_sec" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "score_policy" TEXT NOT NULL DEFAULT 'last';
+ALTER TABLE "submissions" ADD COLUMN "scored_attempt_id" TEXT NOT NULL DEFAULT '';
+
+UPDATE "submissions" SET "scored_attempt_id" = "current_attempt_id";
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "max_attempts";
+ALTER TABLE "assignments" DROP COLUMN "attempt_cooldown_sec";
+ALTER TABLE "assignments" DROP COLUMN "score_policy";
+ALTER TABLE "submissions" DROP COLUMN "scored_attempt_id";
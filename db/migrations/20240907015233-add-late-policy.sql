@@ -0,0 +1,32 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "hard_deadline_at" TIMESTAMP;
+ALTER TABLE "assignments" ADD COLUMN "late_grace_period_sec" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "late_penalty_percent_per_day" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "submissions" ADD COLUMN "raw_grade" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "is_late" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "late_days" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "late_penalty_percent" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "submission_attempts" ADD COLUMN "raw_grade" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submission_attempts" ADD COLUMN "is_late" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submission_attempts" ADD COLUMN "late_days" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submission_attempts" ADD COLUMN "late_penalty_percent" INT NOT NULL DEFAULT 0;
+
+UPDATE "submissions" SET "raw_grade" = "grade";
+UPDATE "submission_attempts" SET "raw_grade" = "grade";
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "hard_deadline_at";
+ALTER TABLE "assignments" DROP COLUMN "late_grace_period_sec";
+ALTER TABLE "assignments" DROP COLUMN "late_penalty_percent_per_day";
+
+ALTER TABLE "submissions" DROP COLUMN "raw_grade";
+ALTER TABLE "submissions" DROP COLUMN "is_late";
+ALTER TABLE "submissions" DROP COLUMN "late_days";
+ALTER TABLE "submissions" DROP COLUMN "late_penalty_percent";
+
+ALTER TABLE "submission_attempts" DROP COLUMN "raw_grade";
+ALTER TABLE "submission_attempts" DROP COLUMN "is_late";
+ALTER TABLE "submission_attempts" DROP COLUMN "late_days";
+ALTER TABLE "submission_attempts" DROP COLUMN "late_penalty_percent";
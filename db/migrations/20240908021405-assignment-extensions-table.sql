@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE "assignment_extensions" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "assignment_id" TEXT NOT NULL,
+    "student_id" TEXT NOT NULL,
+    "deadline_at" TIMESTAMP NOT NULL,
+    "reason" TEXT NOT NULL DEFAULT '',
+    "granted_by" TEXT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (assignment_id) REFERENCES assignments(id),
+    FOREIGN KEY (student_id) REFERENCES users(id),
+    FOREIGN KEY (granted_by) REFERENCES users(id)
+);
+
+-- a student has at most one active extension per assignment
+CREATE UNIQUE INDEX assignment_extensions_assignment_id_student_id
+    ON assignment_extensions ("assignment_id", "student_id")
+    WHERE "deleted_at" IS NULL;
+
+CREATE INDEX assignment_extensions_student_id ON assignment_extensions ("student_id");
+
+-- +migrate Down
+DROP TABLE "assignment_extensions";
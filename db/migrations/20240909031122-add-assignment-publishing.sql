@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "status" TEXT NOT NULL DEFAULT 'published';
+ALTER TABLE "assignments" ADD COLUMN "publish_at" TIMESTAMP;
+
+ALTER TABLE "outbox_items" ADD COLUMN "run_at" TIMESTAMP;
+
+-- +migrate Down
+ALTER TABLE "outbox_items" DROP COLUMN "run_at";
+
+ALTER TABLE "assignments" DROP COLUMN "publish_at";
+ALTER TABLE "assignments" DROP COLUMN "status";
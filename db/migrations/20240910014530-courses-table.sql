@@ -0,0 +1,72 @@
+-- +migrate Up
+CREATE TABLE "terms" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "name" TEXT NOT NULL,
+    "start_at" TIMESTAMP NOT NULL,
+    "end_at" TIMESTAMP NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP
+);
+
+CREATE TABLE "courses" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "term_id" TEXT NOT NULL,
+    "code" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (term_id) REFERENCES terms(id)
+);
+
+-- a course code is offered once per term
+CREATE UNIQUE INDEX courses_term_id_code ON courses ("term_id", "code") WHERE "deleted_at" IS NULL;
+
+CREATE TABLE "course_sections" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "course_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (course_id) REFERENCES courses(id)
+);
+
+CREATE INDEX course_sections_course_id ON course_sections ("course_id");
+
+CREATE TABLE "course_enrollments" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "course_id" TEXT NOT NULL,
+    "section_id" TEXT NOT NULL,
+    "user_id" TEXT NOT NULL,
+    "role" TEXT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (course_id) REFERENCES courses(id),
+    FOREIGN KEY (section_id) REFERENCES course_sections(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- a user is enrolled once per course, in one of its sections
+CREATE UNIQUE INDEX course_enrollments_course_id_user_id
+    ON course_enrollments ("course_id", "user_id")
+    WHERE "deleted_at" IS NULL;
+
+CREATE INDEX course_enrollments_user_id ON course_enrollments ("user_id");
+
+-- assignments without a course are visible to every student
+ALTER TABLE "assignments" ADD COLUMN "course_id" TEXT REFERENCES courses(id);
+
+CREATE INDEX assignments_course_id ON assignments ("course_id");
+
+-- +migrate Down
+DROP INDEX assignments_course_id;
+ALTER TABLE "assignments" DROP COLUMN "course_id";
+
+DROP TABLE "course_enrollments";
+DROP TABLE "course_sections";
+DROP TABLE "courses";
+DROP TABLE "terms";
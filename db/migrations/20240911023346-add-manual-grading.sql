@@ -0,0 +1,78 @@
+-- +migrate Up
+CREATE TABLE "assignment_rubric_criteria" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "assignment_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "max_points" INT NOT NULL,
+    "position" INT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (assignment_id) REFERENCES assignments(id)
+);
+
+CREATE INDEX assignment_rubric_criteria_assignment_id ON assignment_rubric_criteria ("assignment_id");
+
+CREATE TABLE "submission_rubric_scores" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "submission_id" TEXT NOT NULL,
+    "criterion_id" TEXT NOT NULL,
+    "points" INT NOT NULL,
+    "comment" TEXT NOT NULL DEFAULT '',
+    "graded_by" TEXT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (submission_id) REFERENCES submissions(id),
+    FOREIGN KEY (criterion_id) REFERENCES assignment_rubric_criteria(id),
+    FOREIGN KEY (graded_by) REFERENCES users(id)
+);
+
+CREATE UNIQUE INDEX submission_rubric_scores_submission_id_criterion_id
+    ON submission_rubric_scores ("submission_id", "criterion_id");
+
+-- submission_grade_audits is appended on every manual grading, it's never updated
+CREATE TABLE "submission_grade_audits" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "submission_id" TEXT NOT NULL,
+    "graded_by" TEXT NOT NULL,
+    "previous_grade" INT NOT NULL,
+    "previous_max_score" INT NOT NULL,
+    "grade" INT NOT NULL,
+    "max_score" INT NOT NULL,
+    "auto_grade" INT NOT NULL,
+    "rubric_points" INT NOT NULL,
+    "is_overridden" INT NOT NULL DEFAULT 0,
+    "reason" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (submission_id) REFERENCES submissions(id),
+    FOREIGN KEY (graded_by) REFERENCES users(id)
+);
+
+CREATE INDEX submission_grade_audits_submission_id ON submission_grade_audits ("submission_id");
+
+ALTER TABLE "submissions" ADD COLUMN "auto_grade" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "auto_max_score" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "rubric_points" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "rubric_max_points" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "is_overridden" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "override_grade" INT NOT NULL DEFAULT 0;
+ALTER TABLE "submissions" ADD COLUMN "override_reason" TEXT NOT NULL DEFAULT '';
+
+UPDATE "submissions" SET "auto_grade" = "grade", "auto_max_score" = "max_score";
+
+-- +migrate Down
+ALTER TABLE "submissions" DROP COLUMN "auto_grade";
+ALTER TABLE "submissions" DROP COLUMN "auto_max_score";
+ALTER TABLE "submissions" DROP COLUMN "rubric_points";
+ALTER TABLE "submissions" DROP COLUMN "rubric_max_points";
+ALTER TABLE "submissions" DROP COLUMN "is_overridden";
+ALTER TABLE "submissions" DROP COLUMN "override_grade";
+ALTER TABLE "submissions" DROP COLUMN "override_reason";
+
+DROP TABLE "submission_grade_audits";
+DROP TABLE "submission_rubric_scores";
+DROP TABLE "assignment_rubric_criteria";
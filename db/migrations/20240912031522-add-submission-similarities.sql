@@ -0,0 +1,39 @@
+-- +migrate Up
+CREATE TABLE "submission_similarities" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "assignment_id" TEXT NOT NULL,
+    "first_submission_id" TEXT NOT NULL,
+    "second_submission_id" TEXT NOT NULL,
+    "first_file_id" TEXT NOT NULL,
+    "second_file_id" TEXT NOT NULL,
+    "score" INT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (assignment_id) REFERENCES assignments(id),
+    FOREIGN KEY (first_submission_id) REFERENCES submissions(id),
+    FOREIGN KEY (second_submission_id) REFERENCES submissions(id),
+    FOREIGN KEY (first_file_id) REFERENCES files(id),
+    FOREIGN KEY (second_file_id) REFERENCES files(id)
+);
+
+CREATE INDEX submission_similarities_assignment_id ON submission_similarities ("assignment_id", "score");
+
+CREATE TABLE "submission_similarity_regions" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "similarity_id" TEXT NOT NULL,
+    "first_start_line" INT NOT NULL,
+    "first_end_line" INT NOT NULL,
+    "second_start_line" INT NOT NULL,
+    "second_end_line" INT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (similarity_id) REFERENCES submission_similarities(id)
+);
+
+CREATE INDEX submission_similarity_regions_similarity_id ON submission_similarity_regions ("similarity_id");
+
+-- +migrate Down
+DROP TABLE "submission_similarity_regions";
+DROP TABLE "submission_similarities";
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "test_version" INT NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "test_version";
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "test_framework" TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE "assignment_harness_files" (
+    "id" TEXT PRIMARY KEY NOT NULL,
+    "assignment_id" TEXT NOT NULL,
+    "file_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    "deleted_at" TIMESTAMP,
+    FOREIGN KEY (assignment_id) REFERENCES assignments(id),
+    FOREIGN KEY (file_id) REFERENCES files(id)
+);
+
+CREATE INDEX assignment_harness_files_assignment_id ON assignment_harness_files ("assignment_id");
+
+ALTER TABLE "submission_case_results" ADD COLUMN "name" TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "submission_case_results" DROP COLUMN "name";
+
+DROP TABLE "assignment_harness_files";
+
+ALTER TABLE "assignments" DROP COLUMN "test_framework";
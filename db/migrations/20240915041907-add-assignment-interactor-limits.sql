@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "interactor_time_limit_sec" INT NOT NULL DEFAULT 0;
+ALTER TABLE "assignments" ADD COLUMN "interactor_memory_limit_mib" INT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "interactor_memory_limit_mib";
+ALTER TABLE "assignments" DROP COLUMN "interactor_time_limit_sec";
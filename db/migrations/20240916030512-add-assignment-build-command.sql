@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE "assignments" ADD COLUMN "build_command" TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE "assignments" DROP COLUMN "build_command";
@@ -5,4 +5,8 @@ SELECT * FROM outbox_items WHERE idempotent_key = $1 AND "status" = @status LIMI
 SELECT * FROM outbox_items WHERE id = $1;
 
 -- name: FindAllOutboxItemIDsByStatus :many
-SELECT id FROM outbox_items WHERE "status" = @status LIMIT @size_limit;
\ No newline at end of file
+SELECT id FROM outbox_items
+WHERE "status" = @status
+    -- scheduled items are sent once they are due
+    AND (run_at IS NULL OR run_at <= @now)
+LIMIT @size_limit;
\ No newline at end of file
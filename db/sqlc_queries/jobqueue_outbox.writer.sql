@@ -1,6 +1,6 @@
 -- name: CreateOutboxItem :one
-INSERT INTO outbox_items (id, idempotent_key, "status", job_type, payload)
-VALUES (@id, @idempotent_key, @status, @job_type, @payload)
+INSERT INTO outbox_items (id, idempotent_key, "status", job_type, payload, run_at)
+VALUES (@id, @idempotent_key, @status, @job_type, @payload, @run_at)
 RETURNING id, "version";
 
 -- name: UpdateOutboxItem :one
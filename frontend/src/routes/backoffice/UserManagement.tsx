@@ -86,6 +86,14 @@ export function CreateManagedUser() {
 			value: "admin",
 			label: "Admin",
 		},
+		{
+			value: "instructor",
+			label: "Instructor",
+		},
+		{
+			value: "ta",
+			label: "Teaching Assistant",
+		},
 		{
 			value: "student",
 			label: "Student",
@@ -14,7 +14,7 @@ import { AutogradCmdClient, getDecodedJWTToken, saveJWTToken } from "../../servi
 export function LoginPage() {
 	const decoded = getDecodedJWTToken();
 	if (decoded?.id) {
-		if (decoded?.role === "admin") {
+		if (isBackofficeRole(decoded?.role)) {
 			return <Navigate to="/backoffice" />;
 		} else if (decoded?.role === "student") {
 			return <Navigate to="/student-dashboard" />;
@@ -78,9 +78,14 @@ export async function loginAction({
 	saveJWTToken(res.token);
 	const decoded = getDecodedJWTToken();
 
-	if (decoded?.role === "admin") {
+	if (isBackofficeRole(decoded?.role)) {
 		return redirect("/backoffice");
 	}
 
 	return redirect("/student-dashboard");
 }
+
+// the course staff share the backoffice with the admin
+function isBackofficeRole(role?: string): boolean {
+	return role === "admin" || role === "instructor" || role === "ta";
+}
@@ -128,6 +128,26 @@ func AutogradServerURL() string {
 	return val
 }
 
+// GradingRunner is the runner used to grade submissions, "podman", "sandbox" or "isolate"
+func GradingRunner() string {
+	val, ok := os.LookupEnv("GRADING_RUNNER")
+	if ok {
+		return val
+	}
+
+	return "podman"
+}
+
+// SandboxCgroupPath is the cgroup v2 dir delegated to the sandbox runner
+func SandboxCgroupPath() string {
+	return os.Getenv("SANDBOX_CGROUP_PATH")
+}
+
+// IsolateBinPath is the path of the isolate binary, empty means the bundled one
+func IsolateBinPath() string {
+	return os.Getenv("ISOLATE_BIN_PATH")
+}
+
 func SenderEmail() string {
 	return os.Getenv("SENDER_EMAIL")
 }
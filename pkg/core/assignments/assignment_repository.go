@@ -3,8 +3,10 @@ package assignments
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -19,16 +21,46 @@ func (AssignmentWriter) Create(ctx context.Context, tx *gorm.DB, assignment Assi
 		Base: dbmodel.Base{
 			ID: assignment.ID,
 		},
-		AssignedBy:       assignment.Assigner.ID,
-		Name:             assignment.Name,
-		Description:      assignment.Description,
-		CaseInputFileID:  assignment.CaseInputFile.ID,
-		CaseOutputFileID: assignment.CaseOutputFile.ID,
-		DeadlineAt:       assignment.DeadlineAt,
-		Template:         assignment.Template,
+		AssignedBy:               assignment.Assigner.ID,
+		Name:                     assignment.Name,
+		Description:              assignment.Description,
+		CaseInputFileID:          assignment.CaseInputFile.ID,
+		CaseOutputFileID:         assignment.CaseOutputFile.ID,
+		DeadlineAt:               assignment.DeadlineAt,
+		Template:                 assignment.Template,
+		CheckerType:              assignment.Checker.Type,
+		CheckerAbsEpsilon:        assignment.Checker.AbsEpsilon,
+		CheckerRelEpsilon:        assignment.Checker.RelEpsilon,
+		CheckerSource:            assignment.Checker.Source,
+		InteractorTimeLimitSec:   int(assignment.Checker.InteractorTimeLimit),
+		InteractorMemoryLimitMib: int(assignment.Checker.InteractorMemoryLimit),
+		AllowedLanguages:         grading.JoinLanguages(assignment.AllowedLanguages),
+		TimeLimitSec:             int(assignment.Limits.TimeLimit),
+		MemoryLimitMib:           int(assignment.Limits.MemoryLimit),
+		OutputLimitKib:           int(assignment.Limits.OutputLimit),
+		ProcessLimit:             assignment.Limits.ProcessLimit,
+		MaxScore:                 assignment.MaxScore,
+		FeedbackLevel:            assignment.FeedbackLevel,
+		MaxAttempts:              assignment.AttemptPolicy.MaxAttempts,
+		AttemptCooldownSec:       int32(assignment.AttemptPolicy.Cooldown / time.Second),
+		ScorePolicy:              assignment.AttemptPolicy.ScorePolicy,
+		HardDeadlineAt:           null.NewTime(assignment.LatePolicy.HardDeadlineAt, !assignment.LatePolicy.HardDeadlineAt.IsZero()),
+		LateGracePeriodSec:       int32(assignment.LatePolicy.GracePeriod / time.Second),
+		LatePenaltyPercentPerDay: assignment.LatePolicy.PenaltyPercentPerDay,
+		Status:                   assignment.Release.Status,
+		PublishAt:                null.NewTime(assignment.Release.PublishAt, !assignment.Release.PublishAt.IsZero()),
+		CourseID:                 uuid.NullUUID{UUID: assignment.CourseID, Valid: assignment.CourseID != uuid.Nil},
+		TestVersion:              assignment.TestVersion,
+		TestFramework:            string(assignment.TestFramework),
+		BuildCommand:             assignment.BuildCommand,
 	}
 
-	return tx.Table("assignments").Create(&model).Error
+	err := tx.Table("assignments").Create(&model).Error
+	if err != nil {
+		return err
+	}
+
+	return HarnessFileWriter{}.ReplaceAllByAssignmentID(ctx, tx, assignment)
 }
 
 func (AssignmentWriter) Update(ctx context.Context, tx *gorm.DB, assignment Assignment) error {
@@ -40,26 +72,90 @@ func (AssignmentWriter) Update(ctx context.Context, tx *gorm.DB, assignment Assi
 				UpdatedAt: null.TimeFrom(assignment.UpdatedAt),
 			},
 		},
-		AssignedBy:       assignment.Assigner.ID,
-		Name:             assignment.Name,
-		Description:      assignment.Description,
-		CaseInputFileID:  assignment.CaseInputFile.ID,
-		CaseOutputFileID: assignment.CaseOutputFile.ID,
-		DeadlineAt:       assignment.DeadlineAt,
-		Template:         assignment.Template,
+		AssignedBy:               assignment.Assigner.ID,
+		Name:                     assignment.Name,
+		Description:              assignment.Description,
+		CaseInputFileID:          assignment.CaseInputFile.ID,
+		CaseOutputFileID:         assignment.CaseOutputFile.ID,
+		DeadlineAt:               assignment.DeadlineAt,
+		Template:                 assignment.Template,
+		CheckerType:              assignment.Checker.Type,
+		CheckerAbsEpsilon:        assignment.Checker.AbsEpsilon,
+		CheckerRelEpsilon:        assignment.Checker.RelEpsilon,
+		CheckerSource:            assignment.Checker.Source,
+		InteractorTimeLimitSec:   int(assignment.Checker.InteractorTimeLimit),
+		InteractorMemoryLimitMib: int(assignment.Checker.InteractorMemoryLimit),
+		AllowedLanguages:         grading.JoinLanguages(assignment.AllowedLanguages),
+		TimeLimitSec:             int(assignment.Limits.TimeLimit),
+		MemoryLimitMib:           int(assignment.Limits.MemoryLimit),
+		OutputLimitKib:           int(assignment.Limits.OutputLimit),
+		ProcessLimit:             assignment.Limits.ProcessLimit,
+		MaxScore:                 assignment.MaxScore,
+		FeedbackLevel:            assignment.FeedbackLevel,
+		MaxAttempts:              assignment.AttemptPolicy.MaxAttempts,
+		AttemptCooldownSec:       int32(assignment.AttemptPolicy.Cooldown / time.Second),
+		ScorePolicy:              assignment.AttemptPolicy.ScorePolicy,
+		HardDeadlineAt:           null.NewTime(assignment.LatePolicy.HardDeadlineAt, !assignment.LatePolicy.HardDeadlineAt.IsZero()),
+		LateGracePeriodSec:       int32(assignment.LatePolicy.GracePeriod / time.Second),
+		LatePenaltyPercentPerDay: assignment.LatePolicy.PenaltyPercentPerDay,
+		Status:                   assignment.Release.Status,
+		PublishAt:                null.NewTime(assignment.Release.PublishAt, !assignment.Release.PublishAt.IsZero()),
+		CourseID:                 uuid.NullUUID{UUID: assignment.CourseID, Valid: assignment.CourseID != uuid.Nil},
+		TestVersion:              assignment.TestVersion,
+		TestFramework:            string(assignment.TestFramework),
+		BuildCommand:             assignment.BuildCommand,
+	}
+
+	err := tx.Table("assignments").Where("id = ?", assignment.ID).
+		UpdateColumns(map[string]any{
+			"assigned_by":                  model.AssignedBy,
+			"name":                         model.Name,
+			"description":                  model.Description,
+			"case_input_file_id":           model.CaseInputFileID,
+			"case_output_file_id":          model.CaseOutputFileID,
+			"deadline_at":                  model.DeadlineAt,
+			"updated_at":                   model.UpdatedAt,
+			"deleted_at":                   model.DeletedAt,
+			"template":                     model.Template,
+			"checker_type":                 model.CheckerType,
+			"checker_abs_epsilon":          model.CheckerAbsEpsilon,
+			"checker_rel_epsilon":          model.CheckerRelEpsilon,
+			"checker_source":               model.CheckerSource,
+			"interactor_time_limit_sec":    model.InteractorTimeLimitSec,
+			"interactor_memory_limit_mib":  model.InteractorMemoryLimitMib,
+			"allowed_languages":            model.AllowedLanguages,
+			"time_limit_sec":               model.TimeLimitSec,
+			"memory_limit_mib":             model.MemoryLimitMib,
+			"output_limit_kib":             model.OutputLimitKib,
+			"process_limit":                model.ProcessLimit,
+			"max_score":                    model.MaxScore,
+			"feedback_level":               model.FeedbackLevel,
+			"max_attempts":                 model.MaxAttempts,
+			"attempt_cooldown_sec":         model.AttemptCooldownSec,
+			"score_policy":                 model.ScorePolicy,
+			"hard_deadline_at":             model.HardDeadlineAt,
+			"late_grace_period_sec":        model.LateGracePeriodSec,
+			"late_penalty_percent_per_day": model.LatePenaltyPercentPerDay,
+			"status":                       model.Status,
+			"publish_at":                   model.PublishAt,
+			"course_id":                    model.CourseID,
+			"test_version":                 model.TestVersion,
+			"test_framework":               model.TestFramework,
+			"build_command":                model.BuildCommand,
+		}).Error
+	if err != nil {
+		return err
 	}
 
-	return tx.Table("assignments").Where("id = ?", assignment.ID).
+	return HarnessFileWriter{}.ReplaceAllByAssignmentID(ctx, tx, assignment)
+}
+
+// UpdateTestVersion saves the test version without touching the other fields
+func (AssignmentWriter) UpdateTestVersion(ctx context.Context, tx *gorm.DB, assignment Assignment) error {
+	return tx.WithContext(ctx).Table("assignments").Where("id = ?", assignment.ID).
 		UpdateColumns(map[string]any{
-			"assigned_by":         model.AssignedBy,
-			"name":                model.Name,
-			"description":         model.Description,
-			"case_input_file_id":  model.CaseInputFileID,
-			"case_output_file_id": model.CaseOutputFileID,
-			"deadline_at":         model.DeadlineAt,
-			"updated_at":          model.UpdatedAt,
-			"deleted_at":          model.DeletedAt,
-			"template":            model.Template,
+			"test_version": assignment.TestVersion,
+			"updated_at":   assignment.UpdatedAt,
 		}).Error
 }
 
@@ -78,6 +174,16 @@ func (AssignmentReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 		return Assignment{}, err
 	}
 
+	harnessFiles, err := HarnessFileReader{}.FindAllByAssignmentIDs(ctx, tx, []uuid.UUID{assignment.ID})
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	// case files are optional, an assignment may use test cases instead
+	if assignment.CaseInputFileID == uuid.Nil && assignment.CaseOutputFileID == uuid.Nil {
+		return toAssignment(assignment, user, dbmodel.File{}, dbmodel.File{}, harnessFiles[assignment.ID]), nil
+	}
+
 	files := []dbmodel.File{}
 	fileIDs := []uuid.UUID{assignment.CaseInputFileID, assignment.CaseOutputFileID}
 	err = tx.Table("files").Where("id IN (?)", fileIDs).Find(&files).Error
@@ -86,7 +192,7 @@ func (AssignmentReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 	}
 
 	if len(files) != 2 {
-		return Assignment{}, err
+		return Assignment{}, errors.New("case files not found")
 	}
 
 	caseInputFile, _, found := lo.FindIndexOf(files, func(file dbmodel.File) bool {
@@ -103,11 +209,15 @@ func (AssignmentReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 		return Assignment{}, errors.New("case output file not found")
 	}
 
-	return toAssignment(assignment, user, caseInputFile, caseOutputFile), err
+	return toAssignment(assignment, user, caseInputFile, caseOutputFile, harnessFiles[assignment.ID]), err
 }
 
 type FindAllAssignmentsRequest struct {
 	core.PaginationRequest
+	// CourseID is optional
+	CourseID uuid.UUID
+	// CourseIDs restricts the assignments to the courses when it's not nil
+	CourseIDs []uuid.UUID
 }
 
 type FindAllAssignmentsResponse struct {
@@ -116,8 +226,22 @@ type FindAllAssignmentsResponse struct {
 }
 
 func (AssignmentReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllAssignmentsRequest) (FindAllAssignmentsResponse, error) {
+	courseScope := func(db *gorm.DB) *gorm.DB {
+		if req.CourseID == uuid.Nil {
+			return db
+		}
+		return db.Where("course_id = ?", req.CourseID)
+	}
+	coursesScope := func(db *gorm.DB) *gorm.DB {
+		if req.CourseIDs == nil {
+			return db
+		}
+		return db.Where("course_id IN ?", req.CourseIDs)
+	}
+
 	assignments := []dbmodel.Assignment{}
 	err := tx.Table("assignments").
+		Scopes(courseScope, coursesScope).
 		Limit(int(req.Limit)).
 		Offset(int(req.Offset())).
 		Find(&assignments).Error
@@ -127,7 +251,7 @@ func (AssignmentReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllAss
 	}
 
 	count := int64(0)
-	err = tx.Table("assignments").Count(&count).Error
+	err = tx.Table("assignments").Scopes(courseScope, coursesScope).Count(&count).Error
 	if err != nil {
 		return FindAllAssignmentsResponse{}, err
 	}
@@ -164,6 +288,14 @@ func (AssignmentReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllAss
 		fileMap[file.ID] = file
 	}
 
+	assignmentIDs := lo.Map(assignments, func(assignment dbmodel.Assignment, _ int) uuid.UUID {
+		return assignment.ID
+	})
+	harnessFiles, err := HarnessFileReader{}.FindAllByAssignmentIDs(ctx, tx, assignmentIDs)
+	if err != nil {
+		return FindAllAssignmentsResponse{}, err
+	}
+
 	result := FindAllAssignmentsResponse{
 		Pagination: core.Pagination{
 			Page:  req.Page,
@@ -178,7 +310,7 @@ func (AssignmentReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllAss
 		fileInput := fileMap[assignment.CaseInputFileID]
 		fileOutput := fileMap[assignment.CaseOutputFileID]
 
-		asg := toAssignment(assignment, user, fileInput, fileOutput)
+		asg := toAssignment(assignment, user, fileInput, fileOutput, harnessFiles[assignment.ID])
 		result.Assignments[i] = asg
 	}
 
@@ -214,6 +346,7 @@ func toAssignment(
 	user dbmodel.User,
 	inputFile dbmodel.File,
 	outputFile dbmodel.File,
+	harnessFiles []HarnessFile,
 ) Assignment {
 	return Assignment{
 		ID:                model.ID,
@@ -225,6 +358,42 @@ func toAssignment(
 		TimestampMetadata: toEntityMeta(model.Base),
 		Template:          model.Template,
 		DeadlineAt:        model.DeadlineAt,
+		Checker: Checker{
+			Type:                  model.CheckerType,
+			AbsEpsilon:            model.CheckerAbsEpsilon,
+			RelEpsilon:            model.CheckerRelEpsilon,
+			Source:                model.CheckerSource,
+			InteractorTimeLimit:   grading.Second(model.InteractorTimeLimitSec),
+			InteractorMemoryLimit: grading.Mib(model.InteractorMemoryLimitMib),
+		}.withDefault(),
+		AllowedLanguages: grading.SplitLanguages(model.AllowedLanguages),
+		Limits: Limits{
+			TimeLimit:    grading.Second(model.TimeLimitSec),
+			MemoryLimit:  grading.Mib(model.MemoryLimitMib),
+			OutputLimit:  grading.Kib(model.OutputLimitKib),
+			ProcessLimit: model.ProcessLimit,
+		},
+		MaxScore:      model.MaxScore,
+		FeedbackLevel: model.FeedbackLevel,
+		AttemptPolicy: AttemptPolicy{
+			MaxAttempts: model.MaxAttempts,
+			Cooldown:    time.Duration(model.AttemptCooldownSec) * time.Second,
+			ScorePolicy: model.ScorePolicy,
+		}.withDefault(),
+		LatePolicy: grading.LatePolicy{
+			HardDeadlineAt:       model.HardDeadlineAt.Time,
+			GracePeriod:          time.Duration(model.LateGracePeriodSec) * time.Second,
+			PenaltyPercentPerDay: model.LatePenaltyPercentPerDay,
+		},
+		Release: Release{
+			Status:    model.Status,
+			PublishAt: model.PublishAt.Time,
+		},
+		CourseID:      model.CourseID.UUID,
+		TestVersion:   model.TestVersion,
+		TestFramework: grading.TestFramework(model.TestFramework),
+		HarnessFiles:  harnessFiles,
+		BuildCommand:  model.BuildCommand,
 	}
 }
 
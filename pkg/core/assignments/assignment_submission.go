@@ -2,12 +2,16 @@ package assignments
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -24,6 +28,328 @@ type CaseFile struct {
 	core.TimestampMetadata
 }
 
+// Checker is how the assignment judges the output of a test case
+type Checker struct {
+	Type       dbmodel.CheckerType
+	AbsEpsilon float64
+	RelEpsilon float64
+	// Source of the c++ checker program, see grading.ProgramChecker,
+	// or of the interactor program, see grading.ProgramInteractor
+	Source string
+	// limits of the interactor program, zero means grading.DefaultInteractorLimits
+	InteractorTimeLimit   grading.Second
+	InteractorMemoryLimit grading.Mib
+}
+
+func (checker Checker) Validate() error {
+	switch checker.Type {
+	case dbmodel.CheckerTypeExact, dbmodel.CheckerTypeToken, dbmodel.CheckerTypeCaseInsensitive:
+		return nil
+	case dbmodel.CheckerTypeFloat:
+		if checker.AbsEpsilon < 0 || checker.RelEpsilon < 0 {
+			return errors.New("checker epsilon must not be negative")
+		}
+		if checker.AbsEpsilon == 0 && checker.RelEpsilon == 0 {
+			return errors.New("float checker requires absolute or relative epsilon")
+		}
+		return nil
+	case dbmodel.CheckerTypeProgram:
+		if strings.TrimSpace(checker.Source) == "" {
+			return errors.New("checker program source is required")
+		}
+		return nil
+	case dbmodel.CheckerTypeInteractor:
+		if strings.TrimSpace(checker.Source) == "" {
+			return errors.New("interactor program source is required")
+		}
+		err := Limits{
+			TimeLimit:   checker.InteractorTimeLimit,
+			MemoryLimit: checker.InteractorMemoryLimit,
+		}.Validate()
+		if err != nil {
+			return fmt.Errorf("interactor %w", err)
+		}
+		return nil
+	default:
+		return errors.New("invalid checker type")
+	}
+}
+
+// withDefault use exact checker when the type is not set
+func (checker Checker) withDefault() Checker {
+	if checker.Type == "" {
+		checker.Type = dbmodel.CheckerTypeExact
+	}
+	return checker
+}
+
+// HarnessFile is a teacher test file of a unit test assignment
+type HarnessFile struct {
+	FileID uuid.UUID
+	// Name is the file name the harness is compiled as, e.g. stack_test.cpp
+	Name string
+}
+
+// maxHarnessFiles is the upper bound of the harness files of an assignment
+const maxHarnessFiles = 20
+
+// validHarness checks the harness files of the test framework,
+// a unit test assignment is only submitted in the framework language.
+// It returns the allowed languages, defaulted to the framework language.
+func validHarness(framework grading.TestFramework, files []HarnessFile, langs []grading.Language) ([]grading.Language, error) {
+	if framework == grading.TestFrameworkNone {
+		if len(files) > 0 {
+			return nil, errors.New("harness files require a test framework")
+		}
+		return validLanguages(langs)
+	}
+
+	spec, ok := grading.LookupFrameworkSpec(framework)
+	if !ok {
+		return nil, fmt.Errorf("invalid test framework %q", framework)
+	}
+
+	if len(files) == 0 || len(files) > maxHarnessFiles {
+		return nil, fmt.Errorf("test framework requires between 1 and %d harness files", maxHarnessFiles)
+	}
+
+	names := map[string]bool{}
+	for _, file := range files {
+		if err := spec.ValidateHarnessFileName(file.Name); err != nil {
+			return nil, err
+		}
+		if names[file.Name] {
+			return nil, fmt.Errorf("duplicate harness file name %q", file.Name)
+		}
+		names[file.Name] = true
+	}
+
+	for _, lang := range langs {
+		if lang != spec.Language {
+			return nil, fmt.Errorf("%s assignment only allows %s submissions", framework, spec.Language)
+		}
+	}
+
+	return []grading.Language{spec.Language}, nil
+}
+
+// maxBuildCommandLen is the upper bound of the build command length
+const maxBuildCommandLen = 1024
+
+// validBuildCommand checks the build command, a unit test assignment
+// is compiled with the test framework command instead
+func validBuildCommand(framework grading.TestFramework, buildCommand string) (string, error) {
+	buildCommand = strings.TrimSpace(buildCommand)
+	if buildCommand == "" {
+		return "", nil
+	}
+
+	if framework != grading.TestFrameworkNone {
+		return "", errors.New("test framework assignment can't set a build command")
+	}
+
+	if len(buildCommand) > maxBuildCommandLen {
+		return "", fmt.Errorf("build command must be at most %d characters", maxBuildCommandLen)
+	}
+
+	return buildCommand, nil
+}
+
+// sortHarnessFiles sorts the files by name, the order they are stored in
+func sortHarnessFiles(files []HarnessFile) []HarnessFile {
+	sorted := slices.Clone(files)
+	slices.SortFunc(sorted, func(a, b HarnessFile) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return sorted
+}
+
+// isHarnessChanged reports whether the harness files are added, removed, renamed or replaced
+func isHarnessChanged(prev, files []HarnessFile) bool {
+	if len(prev) != len(files) {
+		return true
+	}
+	for i := range files {
+		if prev[i] != files[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Limits is the resource limits of the assignment programs,
+// a zero limit uses the default of the submission language
+type Limits struct {
+	TimeLimit    grading.Second
+	MemoryLimit  grading.Mib
+	OutputLimit  grading.Kib
+	ProcessLimit int
+}
+
+// bounds of the limits
+const (
+	maxTimeLimit    grading.Second = 60
+	minMemoryLimit  grading.Mib    = 16
+	maxMemoryLimit  grading.Mib    = 4096
+	maxOutputLimit  grading.Kib    = 256 << 10
+	maxProcessLimit                = 256
+)
+
+func (limits Limits) Validate() error {
+	if limits.TimeLimit < 0 || limits.TimeLimit > maxTimeLimit {
+		return fmt.Errorf("time limit must be between 1 and %d seconds", maxTimeLimit)
+	}
+
+	if limits.MemoryLimit < 0 || limits.MemoryLimit > maxMemoryLimit ||
+		(limits.MemoryLimit > 0 && limits.MemoryLimit < minMemoryLimit) {
+		return fmt.Errorf("memory limit must be between %d and %d MiB", minMemoryLimit, maxMemoryLimit)
+	}
+
+	if limits.OutputLimit < 0 || limits.OutputLimit > maxOutputLimit {
+		return fmt.Errorf("output limit must be between 1 and %d KiB", maxOutputLimit)
+	}
+
+	if limits.ProcessLimit < 0 || limits.ProcessLimit > maxProcessLimit {
+		return fmt.Errorf("process limit must be between 1 and %d", maxProcessLimit)
+	}
+
+	return nil
+}
+
+// maxMaxScore is the upper bound of an assignment max score
+const maxMaxScore int32 = 10000
+
+// validMaxScore defaults to grading.DefaultMaxScore when the max score is not set
+func validMaxScore(maxScore int32) (int32, error) {
+	if maxScore == 0 {
+		return grading.DefaultMaxScore, nil
+	}
+
+	if maxScore < 0 || maxScore > maxMaxScore {
+		return 0, fmt.Errorf("max score must be between 1 and %d", maxMaxScore)
+	}
+
+	return maxScore, nil
+}
+
+// validFeedbackLevel defaults to verdicts when the level is not set
+func validFeedbackLevel(level dbmodel.FeedbackLevel) (dbmodel.FeedbackLevel, error) {
+	switch level {
+	case "":
+		return dbmodel.FeedbackLevelVerdicts, nil
+	case dbmodel.FeedbackLevelScoreOnly, dbmodel.FeedbackLevelVerdicts, dbmodel.FeedbackLevelFullDiff:
+		return level, nil
+	default:
+		return "", fmt.Errorf("invalid feedback level %q", level)
+	}
+}
+
+const (
+	// maxLateWindow bounds the hard deadline after the deadline
+	maxLateWindow  = 30 * 24 * time.Hour
+	maxGracePeriod = 24 * time.Hour
+)
+
+func validLatePolicy(deadlineAt time.Time, policy grading.LatePolicy) error {
+	if !policy.HardDeadlineAt.IsZero() {
+		if policy.HardDeadlineAt.Before(deadlineAt) {
+			return errors.New("hard deadline must not be before the deadline")
+		}
+		if policy.HardDeadlineAt.Sub(deadlineAt) > maxLateWindow {
+			return fmt.Errorf("hard deadline must be at most %s after the deadline", maxLateWindow)
+		}
+	}
+
+	if policy.GracePeriod < 0 || policy.GracePeriod > maxGracePeriod {
+		return fmt.Errorf("late grace period must be at most %s", maxGracePeriod)
+	}
+
+	if policy.PenaltyPercentPerDay < 0 || policy.PenaltyPercentPerDay > 100 {
+		return errors.New("late penalty per day must be between 0 and 100 percent")
+	}
+
+	return nil
+}
+
+// validRelease defaults to published, a published assignment without
+// a publish time is released at defaultPublishAt
+func validRelease(defaultPublishAt, deadlineAt time.Time, release Release) (Release, error) {
+	switch release.Status {
+	case "":
+		release.Status = dbmodel.AssignmentStatusPublished
+	case dbmodel.AssignmentStatusDraft, dbmodel.AssignmentStatusPublished:
+	default:
+		return Release{}, fmt.Errorf("invalid assignment status %q", release.Status)
+	}
+
+	if release.Status == dbmodel.AssignmentStatusPublished && release.PublishAt.IsZero() {
+		release.PublishAt = defaultPublishAt
+	}
+
+	if !release.PublishAt.IsZero() && !release.PublishAt.Before(deadlineAt) {
+		return Release{}, errors.New("publish time must be before the deadline")
+	}
+
+	return release, nil
+}
+
+// AttemptPolicy limits how often a student may resubmit
+// and decides which attempt is graded
+type AttemptPolicy struct {
+	// MaxAttempts zero means unlimited
+	MaxAttempts int32
+	// Cooldown is the minimum interval between attempts
+	Cooldown    time.Duration
+	ScorePolicy dbmodel.ScorePolicy
+}
+
+// bounds of the attempt policy
+const (
+	maxMaxAttempts = 100
+	maxCooldown    = 24 * time.Hour
+)
+
+// withDefault use the last score policy when it's not set
+func (policy AttemptPolicy) withDefault() AttemptPolicy {
+	if policy.ScorePolicy == "" {
+		policy.ScorePolicy = dbmodel.ScorePolicyLast
+	}
+	return policy
+}
+
+func (policy AttemptPolicy) Validate() error {
+	if policy.MaxAttempts < 0 || policy.MaxAttempts > maxMaxAttempts {
+		return fmt.Errorf("max attempts must be between 1 and %d", maxMaxAttempts)
+	}
+
+	if policy.Cooldown < 0 || policy.Cooldown > maxCooldown {
+		return fmt.Errorf("attempt cooldown must be at most %s", maxCooldown)
+	}
+
+	switch policy.ScorePolicy {
+	case dbmodel.ScorePolicyLast, dbmodel.ScorePolicyBest:
+		return nil
+	default:
+		return fmt.Errorf("invalid score policy %q", policy.ScorePolicy)
+	}
+}
+
+// CanAttempt checks the max attempts & the cooldown before a new attempt
+func (policy AttemptPolicy) CanAttempt(now time.Time, attemptCount int32, lastAttemptAt time.Time) error {
+	if policy.MaxAttempts > 0 && attemptCount >= policy.MaxAttempts {
+		return fmt.Errorf("no attempt left, the max is %d attempts", policy.MaxAttempts)
+	}
+
+	if policy.Cooldown > 0 && !lastAttemptAt.IsZero() {
+		nextAttemptAt := lastAttemptAt.Add(policy.Cooldown)
+		if now.Before(nextAttemptAt) {
+			return fmt.Errorf("next attempt is allowed at %s", nextAttemptAt.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
 type Assignment struct {
 	ID             uuid.UUID
 	Name           string
@@ -33,20 +359,98 @@ type Assignment struct {
 	CaseInputFile  CaseFile
 	CaseOutputFile CaseFile
 	DeadlineAt     time.Time
+	Checker        Checker
+	// AllowedLanguages is the languages a student may submit in
+	AllowedLanguages []grading.Language
+	Limits           Limits
+	// MaxScore is the grade of a submission that passes every test case
+	MaxScore int32
+	// FeedbackLevel is how much of the case results the students see
+	FeedbackLevel dbmodel.FeedbackLevel
+	AttemptPolicy AttemptPolicy
+	// LatePolicy accepts submissions after DeadlineAt until its hard deadline
+	LatePolicy grading.LatePolicy
+	// Release hides the assignment from the students until it's published
+	Release Release
+	// CourseID is the course of the enrolled students who see the assignment,
+	// uuid.Nil means every student sees it
+	CourseID uuid.UUID
+	// TestVersion is bumped whenever the submissions are graded differently,
+	// i.e. the test cases, case files, checker, harness or build command change
+	TestVersion int32
+	// TestFramework grades the submissions with the HarnessFiles instead of the test cases
+	TestFramework grading.TestFramework
+	HarnessFiles  []HarnessFile
+	// BuildCommand replaces the language compile command, e.g. make for a multi file submission
+	BuildCommand string
 
 	core.TimestampMetadata
 }
 
+// IsReleaseChanged reports whether the assignment is published at a new time,
+// the students are notified at every new release time
+func (assignment Assignment) IsReleaseChanged(prev Assignment) bool {
+	if assignment.Release.Status != dbmodel.AssignmentStatusPublished || assignment.Release.PublishAt.IsZero() {
+		return false
+	}
+
+	return prev.Release.Status != dbmodel.AssignmentStatusPublished ||
+		!prev.Release.PublishAt.Equal(assignment.Release.PublishAt)
+}
+
+// IsTestChanged reports whether the submissions graded with the previous tests must be regraded
+func (assignment Assignment) IsTestChanged(prev Assignment) bool {
+	return assignment.TestVersion != prev.TestVersion
+}
+
+// ChangeTests bumps the test version, e.g. when a test case is changed or a regrade is requested
+func (assignment Assignment) ChangeTests(now time.Time) (Assignment, error) {
+	if assignment.DeletedAt.Valid {
+		return Assignment{}, errors.New("assignment is deleted")
+	}
+
+	assignment.TestVersion++
+	assignment.UpdatedAt = now
+	return assignment, nil
+}
+
+// validLanguages defaults to c++ when no language is given
+func validLanguages(langs []grading.Language) ([]grading.Language, error) {
+	if len(langs) == 0 {
+		return []grading.Language{grading.LanguageCPP}, nil
+	}
+
+	for _, lang := range langs {
+		if !grading.ValidLanguage(lang) {
+			return nil, fmt.Errorf("invalid language %q", lang)
+		}
+	}
+
+	return lo.Uniq(langs), nil
+}
+
 type CreateAssignmentRequest struct {
-	NewID          uuid.UUID
-	Now            time.Time
-	Assigner       Assigner
-	Name           string
-	Description    string
-	Template       string
-	CaseInputFile  CaseFile
-	CaseOutputFile CaseFile
-	DeadlineAt     time.Time
+	NewID            uuid.UUID
+	Now              time.Time
+	Assigner         Assigner
+	Name             string
+	Description      string
+	Template         string
+	CaseInputFile    CaseFile
+	CaseOutputFile   CaseFile
+	DeadlineAt       time.Time
+	Checker          Checker
+	AllowedLanguages []grading.Language
+	Limits           Limits
+	MaxScore         int32
+	FeedbackLevel    dbmodel.FeedbackLevel
+	AttemptPolicy    AttemptPolicy
+	LatePolicy       grading.LatePolicy
+	Release          Release
+	CourseID         uuid.UUID
+	TestFramework    grading.TestFramework
+	HarnessFiles     []HarnessFile
+	BuildCommand     string
 }
 
 func CreateAssignment(req CreateAssignmentRequest) (Assignment, error) {
@@ -62,6 +466,49 @@ func CreateAssignment(req CreateAssignmentRequest) (Assignment, error) {
 		return Assignment{}, errors.New("description must be at least 3 characters")
 	}
 
+	checker := req.Checker.withDefault()
+	if err := checker.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	allowedLanguages, err := validHarness(req.TestFramework, req.HarnessFiles, req.AllowedLanguages)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	buildCommand, err := validBuildCommand(req.TestFramework, req.BuildCommand)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	if err := req.Limits.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	maxScore, err := validMaxScore(req.MaxScore)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	feedbackLevel, err := validFeedbackLevel(req.FeedbackLevel)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	attemptPolicy := req.AttemptPolicy.withDefault()
+	if err := attemptPolicy.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	if err := validLatePolicy(req.DeadlineAt, req.LatePolicy); err != nil {
+		return Assignment{}, err
+	}
+
+	release, err := validRelease(req.Now, req.DeadlineAt, req.Release)
+	if err != nil {
+		return Assignment{}, err
+	}
+
 	return Assignment{
 		ID:                req.NewID,
 		TimestampMetadata: core.NewTimestampMeta(req.Now),
@@ -72,21 +519,139 @@ func CreateAssignment(req CreateAssignmentRequest) (Assignment, error) {
 		Assigner:          req.Assigner,
 		DeadlineAt:        req.DeadlineAt,
 		Template:          req.Template,
+		Checker:           checker,
+		AllowedLanguages:  allowedLanguages,
+		Limits:            req.Limits,
+		MaxScore:          maxScore,
+		FeedbackLevel:     feedbackLevel,
+		AttemptPolicy:     attemptPolicy,
+		LatePolicy:        req.LatePolicy,
+		Release:           release,
+		CourseID:          req.CourseID,
+		TestVersion:       1,
+		TestFramework:     req.TestFramework,
+		HarnessFiles:      sortHarnessFiles(req.HarnessFiles),
+		BuildCommand:      buildCommand,
 	}, nil
 }
 
+// AssignmentField is an assignment setting an update may keep, see UpdateAssignmentRequest.Fields
+type AssignmentField string
+
+const (
+	AssignmentFieldChecker          AssignmentField = "checker"
+	AssignmentFieldAllowedLanguages AssignmentField = "allowed_languages"
+	AssignmentFieldLimits           AssignmentField = "limits"
+	AssignmentFieldMaxScore         AssignmentField = "max_score"
+	AssignmentFieldFeedbackLevel    AssignmentField = "feedback_level"
+	AssignmentFieldAttemptPolicy    AssignmentField = "attempt_policy"
+	AssignmentFieldLatePolicy       AssignmentField = "late_policy"
+	AssignmentFieldCourseID         AssignmentField = "course_id"
+	AssignmentFieldTestFramework    AssignmentField = "test_framework"
+	AssignmentFieldHarnessFiles     AssignmentField = "harness_files"
+	AssignmentFieldBuildCommand     AssignmentField = "build_command"
+)
+
+var assignmentFields = []AssignmentField{
+	AssignmentFieldChecker,
+	AssignmentFieldAllowedLanguages,
+	AssignmentFieldLimits,
+	AssignmentFieldMaxScore,
+	AssignmentFieldFeedbackLevel,
+	AssignmentFieldAttemptPolicy,
+	AssignmentFieldLatePolicy,
+	AssignmentFieldCourseID,
+	AssignmentFieldTestFramework,
+	AssignmentFieldHarnessFiles,
+	AssignmentFieldBuildCommand,
+}
+
+// ParseAssignmentFields parses the field names of an update mask
+func ParseAssignmentFields(names []string) ([]AssignmentField, error) {
+	fields := make([]AssignmentField, len(names))
+	for i, name := range names {
+		field := AssignmentField(name)
+		if !slices.Contains(assignmentFields, field) {
+			return nil, fmt.Errorf("invalid assignment field %q", name)
+		}
+		fields[i] = field
+	}
+	return fields, nil
+}
+
 type UpdateAssignmentRequest struct {
-	Now            time.Time
-	Assigner       Assigner
-	Name           string
-	Description    string
-	Template       string
-	CaseInputFile  CaseFile
-	CaseOutputFile CaseFile
-	DeadlineAt     time.Time
+	// Fields are the settings the update replaces, the other settings keep their current value.
+	// The name, description, case files, deadline & template are always replaced
+	// and the release is kept when its status is empty.
+	Fields           []AssignmentField
+	Now              time.Time
+	Assigner         Assigner
+	Name             string
+	Description      string
+	Template         string
+	CaseInputFile    CaseFile
+	CaseOutputFile   CaseFile
+	DeadlineAt       time.Time
+	Checker          Checker
+	AllowedLanguages []grading.Language
+	Limits           Limits
+	MaxScore         int32
+	FeedbackLevel    dbmodel.FeedbackLevel
+	AttemptPolicy    AttemptPolicy
+	LatePolicy       grading.LatePolicy
+	Release          Release
+	CourseID         uuid.UUID
+	TestFramework    grading.TestFramework
+	HarnessFiles     []HarnessFile
+	BuildCommand     string
+}
+
+// withCurrent keeps the current settings the request doesn't replace
+func (req UpdateAssignmentRequest) withCurrent(assignment Assignment) UpdateAssignmentRequest {
+	isKept := func(field AssignmentField) bool {
+		return !slices.Contains(req.Fields, field)
+	}
+
+	if isKept(AssignmentFieldChecker) {
+		req.Checker = assignment.Checker
+	}
+	if isKept(AssignmentFieldAllowedLanguages) {
+		req.AllowedLanguages = assignment.AllowedLanguages
+	}
+	if isKept(AssignmentFieldLimits) {
+		req.Limits = assignment.Limits
+	}
+	if isKept(AssignmentFieldMaxScore) {
+		req.MaxScore = assignment.MaxScore
+	}
+	if isKept(AssignmentFieldFeedbackLevel) {
+		req.FeedbackLevel = assignment.FeedbackLevel
+	}
+	if isKept(AssignmentFieldAttemptPolicy) {
+		req.AttemptPolicy = assignment.AttemptPolicy
+	}
+	if isKept(AssignmentFieldLatePolicy) {
+		req.LatePolicy = assignment.LatePolicy
+	}
+	if isKept(AssignmentFieldCourseID) {
+		req.CourseID = assignment.CourseID
+	}
+	if isKept(AssignmentFieldTestFramework) {
+		req.TestFramework = assignment.TestFramework
+	}
+	if isKept(AssignmentFieldHarnessFiles) {
+		req.HarnessFiles = assignment.HarnessFiles
+	}
+	if isKept(AssignmentFieldBuildCommand) {
+		req.BuildCommand = assignment.BuildCommand
+	}
+
+	return req
 }
 
 func (assignment Assignment) Update(req UpdateAssignmentRequest) (Assignment, error) {
+	req = req.withCurrent(assignment)
+
 	if !req.Assigner.Active {
 		return Assignment{}, errors.New("assigner must active")
 	}
@@ -99,6 +664,73 @@ func (assignment Assignment) Update(req UpdateAssignmentRequest) (Assignment, er
 		return Assignment{}, errors.New("description must be at least 3 characters")
 	}
 
+	checker := req.Checker.withDefault()
+	if err := checker.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	allowedLanguages, err := validHarness(req.TestFramework, req.HarnessFiles, req.AllowedLanguages)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	buildCommand, err := validBuildCommand(req.TestFramework, req.BuildCommand)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	if err := req.Limits.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	maxScore, err := validMaxScore(req.MaxScore)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	feedbackLevel, err := validFeedbackLevel(req.FeedbackLevel)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	attemptPolicy := req.AttemptPolicy.withDefault()
+	if err := attemptPolicy.Validate(); err != nil {
+		return Assignment{}, err
+	}
+
+	if err := validLatePolicy(req.DeadlineAt, req.LatePolicy); err != nil {
+		return Assignment{}, err
+	}
+
+	// an already published assignment keeps its release time,
+	// which is zero for the assignments published before release times existed
+	defaultPublishAt := req.Now
+	if assignment.Release.Status == dbmodel.AssignmentStatusPublished {
+		defaultPublishAt = assignment.Release.PublishAt
+	}
+
+	// the release is kept when the status is not given
+	releaseReq := req.Release
+	if releaseReq.Status == "" {
+		releaseReq = assignment.Release
+	}
+
+	release, err := validRelease(defaultPublishAt, req.DeadlineAt, releaseReq)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	harnessFiles := sortHarnessFiles(req.HarnessFiles)
+	isTestChanged := req.CaseInputFile.ID != assignment.CaseInputFile.ID ||
+		req.CaseOutputFile.ID != assignment.CaseOutputFile.ID ||
+		checker != assignment.Checker ||
+		req.TestFramework != assignment.TestFramework ||
+		isHarnessChanged(assignment.HarnessFiles, harnessFiles) ||
+		buildCommand != assignment.BuildCommand
+	if isTestChanged {
+		assignment.TestVersion++
+	}
+
 	assignment.Name = req.Name
 	assignment.Description = req.Description
 	assignment.CaseInputFile = req.CaseInputFile
@@ -107,6 +739,18 @@ func (assignment Assignment) Update(req UpdateAssignmentRequest) (Assignment, er
 	assignment.UpdatedAt = req.Now
 	assignment.DeadlineAt = req.DeadlineAt
 	assignment.Template = req.Template
+	assignment.Checker = checker
+	assignment.AllowedLanguages = allowedLanguages
+	assignment.Limits = req.Limits
+	assignment.MaxScore = maxScore
+	assignment.FeedbackLevel = feedbackLevel
+	assignment.AttemptPolicy = attemptPolicy
+	assignment.LatePolicy = req.LatePolicy
+	assignment.Release = release
+	assignment.CourseID = req.CourseID
+	assignment.TestFramework = req.TestFramework
+	assignment.HarnessFiles = harnessFiles
+	assignment.BuildCommand = buildCommand
 
 	return assignment, nil
 }
@@ -122,15 +766,38 @@ type SubmissionFile struct {
 }
 
 type Submission struct {
-	ID         uuid.UUID
+	ID uuid.UUID
+	// Assignment has the class deadline, the extension of the submitter
+	// is resolved on create & update
 	Assignment Assignment
 	Submitter  Submitter
 	SourceFile SubmissionFile
-	Grade      int32
-	Feedback   string
+	// Grade & MaxScore are the automated grade combined with the manual grade
+	Grade    int32
+	MaxScore int32
+	// AutoGrade & AutoMaxScore are the automated grade of the scored attempt
+	AutoGrade    int32
+	AutoMaxScore int32
+	Manual       grading.ManualGrade
+	Feedback     string
+	// CurrentAttempt is the attempt of the current source file
+	CurrentAttempt SubmissionAttempt
 	core.TimestampMetadata
 }
 
+// SubmissionAttempt is appended on every create & update of the submission source file
+type SubmissionAttempt struct {
+	ID        uuid.UUID
+	Number    int32
+	CreatedAt time.Time
+}
+
+// RegradeAttempt is the attempt of a submission graded on a regrade
+type RegradeAttempt struct {
+	SubmissionID uuid.UUID
+	AttemptID    uuid.UUID
+}
+
 type Submitter struct {
 	ID     uuid.UUID
 	Name   string
@@ -139,14 +806,30 @@ type Submitter struct {
 
 type CreateSubmissionRequest struct {
 	NewID          uuid.UUID
+	NewAttemptID   uuid.UUID
 	Now            time.Time
 	Assignment     Assignment
 	Submitter      Submitter
 	SubmissionFile SubmissionFile
+	// Extension is the deadline extension of the submitter, if HasExtension
+	Extension    Extension
+	HasExtension bool
+	// IsEnrolled is true when the assignment has no course
+	// or the submitter is enrolled in its course
+	IsEnrolled bool
 }
 
 func CreateSubmission(req CreateSubmissionRequest) (Submission, error) {
-	if req.Now.After(req.Assignment.DeadlineAt) {
+	if !req.Assignment.Release.IsPublished(req.Now) {
+		return Submission{}, errors.New("assignment is not published yet")
+	}
+
+	if req.HasExtension && req.Extension.Student.ID != req.Submitter.ID {
+		return Submission{}, errors.New("extension is not of the submitter")
+	}
+
+	assignment := req.Assignment.forSubmitter(req.Extension, req.HasExtension)
+	if !assignment.LatePolicy.IsOpen(assignment.DeadlineAt, req.Now) {
 		return Submission{}, errors.New("assignment is already closed")
 	}
 
@@ -154,6 +837,10 @@ func CreateSubmission(req CreateSubmissionRequest) (Submission, error) {
 		return Submission{}, errors.New("submitter must active")
 	}
 
+	if !req.IsEnrolled {
+		return Submission{}, errors.New("submitter is not enrolled in the course")
+	}
+
 	subm := Submission{
 		ID:                req.NewID,
 		Assignment:        req.Assignment,
@@ -161,6 +848,7 @@ func CreateSubmission(req CreateSubmissionRequest) (Submission, error) {
 		SourceFile:        req.SubmissionFile,
 		Grade:             0,
 		Feedback:          "",
+		CurrentAttempt:    SubmissionAttempt{ID: req.NewAttemptID, Number: 1, CreatedAt: req.Now},
 		TimestampMetadata: core.NewTimestampMeta(req.Now),
 	}
 
@@ -168,17 +856,49 @@ func CreateSubmission(req CreateSubmissionRequest) (Submission, error) {
 }
 
 type UpdateSubmissionRequest struct {
+	NewAttemptID   uuid.UUID
 	Now            time.Time
 	Submitter      Submitter
 	SubmissionFile SubmissionFile
+	// Extension is the deadline extension of the submitter, if HasExtension
+	Extension    Extension
+	HasExtension bool
+	// IsEnrolled is true when the assignment has no course
+	// or the submitter is enrolled in its course
+	IsEnrolled bool
 }
 
 func (submission Submission) Update(req UpdateSubmissionRequest) (Submission, error) {
-	if req.Now.After(submission.Assignment.DeadlineAt) {
+	if !req.IsEnrolled {
+		return Submission{}, errors.New("submitter is not enrolled in the course")
+	}
+
+	if req.HasExtension && req.Extension.Student.ID != req.Submitter.ID {
+		return Submission{}, errors.New("extension is not of the submitter")
+	}
+
+	assignment := submission.Assignment.forSubmitter(req.Extension, req.HasExtension)
+	if !assignment.LatePolicy.IsOpen(assignment.DeadlineAt, req.Now) {
 		return Submission{}, errors.New("assignment is already closed")
 	}
 
+	attemptPolicy := submission.Assignment.AttemptPolicy
+	err := attemptPolicy.CanAttempt(req.Now, submission.CurrentAttempt.Number, submission.CurrentAttempt.CreatedAt)
+	if err != nil {
+		return Submission{}, err
+	}
+
+	// the new attempt is not graded yet, the manual grade is kept
+	submission.Grade = 0
+	submission.MaxScore = 0
+	submission.AutoGrade = 0
+	submission.AutoMaxScore = 0
 	submission.SourceFile = req.SubmissionFile
+	submission.CurrentAttempt = SubmissionAttempt{
+		ID:        req.NewAttemptID,
+		Number:    submission.CurrentAttempt.Number + 1,
+		CreatedAt: req.Now,
+	}
 	submission.UpdatedAt = req.Now
 	submission.Submitter = req.Submitter
 
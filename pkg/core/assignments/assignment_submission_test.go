@@ -0,0 +1,215 @@
+package assignments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+)
+
+func TestAssignmentUpdate(t *testing.T) {
+	now := time.Now()
+	assigner := Assigner{ID: uuid.New(), Name: "teacher", Active: true}
+
+	assignment, err := CreateAssignment(CreateAssignmentRequest{
+		NewID:            uuid.New(),
+		Now:              now,
+		Assigner:         assigner,
+		Name:             "sum",
+		Description:      "sum two numbers",
+		DeadlineAt:       now.Add(24 * time.Hour),
+		Checker:          Checker{Type: dbmodel.CheckerTypeToken},
+		AllowedLanguages: []grading.Language{grading.LanguagePython},
+		Limits:           Limits{TimeLimit: 2},
+		MaxScore:         50,
+		FeedbackLevel:    dbmodel.FeedbackLevelFullDiff,
+		AttemptPolicy:    AttemptPolicy{MaxAttempts: 3, Cooldown: time.Minute},
+		CourseID:         uuid.New(),
+		BuildCommand:     "make",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the backoffice only sends the always replaced fields
+	updateReq := UpdateAssignmentRequest{
+		Now:         now.Add(time.Hour),
+		Assigner:    assigner,
+		Name:        "sum of two",
+		Description: "sum two numbers",
+		DeadlineAt:  assignment.DeadlineAt,
+	}
+
+	t.Run("keep settings", func(t *testing.T) {
+		updated, err := assignment.Update(updateReq)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if updated.Name != "sum of two" {
+			t.Errorf("want name updated, got %q", updated.Name)
+		}
+		if updated.CourseID != assignment.CourseID {
+			t.Errorf("want course %s kept, got %s", assignment.CourseID, updated.CourseID)
+		}
+		if updated.Checker != assignment.Checker || updated.Limits != assignment.Limits ||
+			updated.MaxScore != assignment.MaxScore || updated.FeedbackLevel != assignment.FeedbackLevel ||
+			updated.AttemptPolicy != assignment.AttemptPolicy || updated.BuildCommand != assignment.BuildCommand ||
+			len(updated.AllowedLanguages) != 1 || updated.AllowedLanguages[0] != grading.LanguagePython {
+			t.Errorf("want settings kept, got %+v", updated)
+		}
+		if updated.TestVersion != assignment.TestVersion {
+			t.Errorf("want test version %d kept, got %d", assignment.TestVersion, updated.TestVersion)
+		}
+	})
+
+	t.Run("replace settings", func(t *testing.T) {
+		req := updateReq
+		req.Fields = []AssignmentField{AssignmentFieldCourseID, AssignmentFieldChecker}
+		updated, err := assignment.Update(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if updated.CourseID != uuid.Nil {
+			t.Errorf("want course cleared, got %s", updated.CourseID)
+		}
+		if updated.Checker.Type != dbmodel.CheckerTypeExact {
+			t.Errorf("want default checker, got %s", updated.Checker.Type)
+		}
+		if updated.TestVersion != assignment.TestVersion+1 {
+			t.Errorf("want test version bumped, got %d", updated.TestVersion)
+		}
+		if updated.MaxScore != assignment.MaxScore {
+			t.Errorf("want max score %d kept, got %d", assignment.MaxScore, updated.MaxScore)
+		}
+	})
+}
+
+func TestParseAssignmentFields(t *testing.T) {
+	fields, err := ParseAssignmentFields([]string{"course_id", "harness_files"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields) != 2 || fields[0] != AssignmentFieldCourseID || fields[1] != AssignmentFieldHarnessFiles {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	if _, err := ParseAssignmentFields([]string{"name"}); err == nil {
+		t.Errorf("want error for a field that is always replaced")
+	}
+}
+
+func TestSubmissionUpdateAttemptPolicy(t *testing.T) {
+	now := time.Now()
+	submitter := Submitter{ID: uuid.New(), Name: "student", Active: true}
+
+	submission := Submission{
+		ID: uuid.New(),
+		Assignment: Assignment{
+			DeadlineAt:    now.Add(24 * time.Hour),
+			AttemptPolicy: AttemptPolicy{MaxAttempts: 2, Cooldown: time.Minute},
+		},
+		Submitter:      submitter,
+		CurrentAttempt: SubmissionAttempt{ID: uuid.New(), Number: 1, CreatedAt: now},
+	}
+
+	updateReq := UpdateSubmissionRequest{
+		NewAttemptID: uuid.New(),
+		Now:          now.Add(30 * time.Second),
+		Submitter:    submitter,
+		IsEnrolled:   true,
+	}
+
+	if _, err := submission.Update(updateReq); err == nil {
+		t.Errorf("want error within the cooldown")
+	}
+
+	updateReq.Now = now.Add(time.Minute)
+	updated, err := submission.Update(updateReq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.CurrentAttempt.Number != 2 || !updated.CurrentAttempt.CreatedAt.Equal(updateReq.Now) {
+		t.Errorf("want second attempt at %s, got %+v", updateReq.Now, updated.CurrentAttempt)
+	}
+
+	updateReq.Now = now.Add(time.Hour)
+	if _, err := updated.Update(updateReq); err == nil {
+		t.Errorf("want error when no attempt left")
+	}
+}
+
+func TestSubmissionDeadlineAndEnrollment(t *testing.T) {
+	now := time.Now()
+	submitter := Submitter{ID: uuid.New(), Name: "student", Active: true}
+	closedAssignment := Assignment{
+		ID:         uuid.New(),
+		DeadlineAt: now.Add(-time.Hour),
+		Release:    Release{Status: dbmodel.AssignmentStatusPublished},
+	}
+	extension := Extension{
+		AssignmentID: closedAssignment.ID,
+		Student:      submitter,
+		DeadlineAt:   now.Add(time.Hour),
+	}
+	otherExtension := extension
+	otherExtension.Student = Submitter{ID: uuid.New(), Active: true}
+
+	tests := []struct {
+		name         string
+		extension    Extension
+		hasExtension bool
+		isEnrolled   bool
+		wantErr      bool
+	}{
+		{"class deadline has passed", Extension{}, false, true, true},
+		{"extended deadline", extension, true, true, false},
+		{"extension of another student", otherExtension, true, true, true},
+		{"not enrolled", extension, true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("create "+tt.name, func(t *testing.T) {
+			_, err := CreateSubmission(CreateSubmissionRequest{
+				NewID:        uuid.New(),
+				NewAttemptID: uuid.New(),
+				Now:          now,
+				Assignment:   closedAssignment,
+				Submitter:    submitter,
+				Extension:    tt.extension,
+				HasExtension: tt.hasExtension,
+				IsEnrolled:   tt.isEnrolled,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %t, got %v", tt.wantErr, err)
+			}
+		})
+
+		t.Run("update "+tt.name, func(t *testing.T) {
+			submission := Submission{
+				ID:             uuid.New(),
+				Assignment:     closedAssignment,
+				Submitter:      submitter,
+				CurrentAttempt: SubmissionAttempt{ID: uuid.New(), Number: 1, CreatedAt: now.Add(-2 * time.Hour)},
+			}
+
+			updated, err := submission.Update(UpdateSubmissionRequest{
+				NewAttemptID: uuid.New(),
+				Now:          now,
+				Submitter:    submitter,
+				Extension:    tt.extension,
+				HasExtension: tt.hasExtension,
+				IsEnrolled:   tt.isEnrolled,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %t, got %v", tt.wantErr, err)
+			}
+			if err == nil && !updated.Assignment.DeadlineAt.Equal(closedAssignment.DeadlineAt) {
+				t.Errorf("want the class deadline kept, got %s", updated.Assignment.DeadlineAt)
+			}
+		})
+	}
+}
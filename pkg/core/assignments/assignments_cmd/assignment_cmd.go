@@ -3,12 +3,16 @@ package assignments_cmd
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/core/assignments"
 	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/course"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/fahmifan/autograd/pkg/logs"
 	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
@@ -27,13 +31,12 @@ func (cmd *AssignmentCmd) CreateAssignment(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	if !authUser.Role.Can(auth.CreateAssignment) {
+	if !authUser.Role.CanInAnyCourse(auth.CreateAssignment) {
 		return nil, connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
 	assignerReader := assignments.AssignerReader{}
 	assignmentWriter := assignments.AssignmentWriter{}
-	fileReader := assignments.FileReader{}
 
 	now := time.Now()
 	deadlineAt, err := time.Parse(time.RFC3339, req.Msg.GetDeadlineAt())
@@ -41,13 +44,28 @@ func (cmd *AssignmentCmd) CreateAssignment(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	latePolicy, err := latePolicyFromProto(req.Msg.GetLatePolicy())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	release, err := releaseFromProto(req.Msg.GetStatus(), req.Msg.GetPublishAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	courseID, err := parseOptionalID(req.Msg.GetCourseId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	assignment := assignments.Assignment{}
-	caseStdinFileID, err := uuid.Parse(req.Msg.GetCaseInputFileId())
+	caseStdinFileID, caseStdoutFileID, err := parseCaseFileIDs(req.Msg.GetCaseInputFileId(), req.Msg.GetCaseOutputFileId())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	caseStdoutFileID, err := uuid.Parse(req.Msg.GetCaseOutputFileId())
+	harnessFiles, err := harnessFilesFromProto(req.Msg.GetHarnessFiles())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -59,36 +77,44 @@ func (cmd *AssignmentCmd) CreateAssignment(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
-		fileIDs := []uuid.UUID{caseStdinFileID, caseStdoutFileID}
-		caseFiles, err := fileReader.FindCaseFiles(ctx, cmd.GormDB, fileIDs)
+		caseInputFile, caseOutputFile, err := findCaseFiles(ctx, cmd.GormDB, caseStdinFileID, caseStdoutFileID)
 		if err != nil {
-			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignment: FindCaseFiles")
-			return core.ErrInternalServer
+			return err
 		}
 
-		caseInputFile, _, found := lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
-			return file.Type == dbmodel.FileTypeAssignmentCaseInput
-		})
-		if !found {
-			return errors.New("case input file not found")
+		if err = findHarnessFiles(ctx, tx, harnessFiles); err != nil {
+			return err
 		}
 
-		caseOutputFile, _, found := lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
-			return file.Type == dbmodel.FileTypeAssignmentCaseOutput
-		})
-		if !found {
-			return errors.New("case output file not found")
+		if err = findCourse(ctx, tx, courseID); err != nil {
+			return err
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, courseID, auth.CreateAssignment); err != nil {
+			return err
 		}
 
 		assignment, err = assignments.CreateAssignment(assignments.CreateAssignmentRequest{
-			NewID:          uuid.New(),
-			Name:           req.Msg.GetName(),
-			Description:    req.Msg.GetDescription(),
-			Assigner:       assigner,
-			Now:            now,
-			CaseInputFile:  caseInputFile,
-			CaseOutputFile: caseOutputFile,
-			DeadlineAt:     deadlineAt,
+			NewID:            uuid.New(),
+			Name:             req.Msg.GetName(),
+			Description:      req.Msg.GetDescription(),
+			Assigner:         assigner,
+			Now:              now,
+			CaseInputFile:    caseInputFile,
+			CaseOutputFile:   caseOutputFile,
+			DeadlineAt:       deadlineAt,
+			Checker:          checkerFromProto(req.Msg.GetChecker()),
+			AllowedLanguages: languagesFromProto(req.Msg.GetAllowedLanguages()),
+			Limits:           limitsFromProto(req.Msg.GetLimits()),
+			MaxScore:         req.Msg.GetMaxScore(),
+			FeedbackLevel:    dbmodel.FeedbackLevel(req.Msg.GetFeedbackLevel()),
+			AttemptPolicy:    attemptPolicyFromProto(req.Msg.GetAttemptPolicy()),
+			LatePolicy:       latePolicy,
+			Release:          release,
+			CourseID:         courseID,
+			TestFramework:    grading.TestFramework(req.Msg.GetTestFramework()),
+			HarnessFiles:     harnessFiles,
+			BuildCommand:     req.Msg.GetBuildCommand(),
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -100,6 +126,14 @@ func (cmd *AssignmentCmd) CreateAssignment(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
+		if assignment.IsReleaseChanged(assignments.Assignment{}) {
+			err = cmd.enqueueAssignmentPublished(ctx, tx, assignment)
+			if err != nil {
+				logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignment: enqueueAssignmentPublished")
+				return core.ErrInternalServer
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -120,7 +154,7 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	if !authUser.Role.Can(auth.UpdateAssignment) {
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
 		return nil, connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
@@ -134,12 +168,32 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	caseInputFileID, err := uuid.Parse(req.Msg.GetCaseInputFileId())
+	latePolicy, err := latePolicyFromProto(req.Msg.GetLatePolicy())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	release, err := releaseFromProto(req.Msg.GetStatus(), req.Msg.GetPublishAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	courseID, err := parseOptionalID(req.Msg.GetCourseId())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	caseOutputFileID, err := uuid.Parse(req.Msg.GetCaseOutputFileId())
+	caseInputFileID, caseOutputFileID, err := parseCaseFileIDs(req.Msg.GetCaseInputFileId(), req.Msg.GetCaseOutputFileId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	harnessFiles, err := harnessFilesFromProto(req.Msg.GetHarnessFiles())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	fields, err := assignments.ParseAssignmentFields(req.Msg.GetUpdateMask().GetPaths())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -149,7 +203,6 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 	assignerReader := assignments.AssignerReader{}
 	assignmentReader := assignments.AssignmentReader{}
 	assignmentWriter := assignments.AssignmentWriter{}
-	fileReader := assignments.FileReader{}
 
 	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
 		assigner, err := assignerReader.FindByID(ctx, cmd.GormDB, authUser.UserID)
@@ -164,36 +217,53 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
-		fileIDs := []uuid.UUID{caseInputFileID, caseOutputFileID}
-		caseFiles, err := fileReader.FindCaseFiles(ctx, cmd.GormDB, fileIDs)
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		caseInputFile, caseOutputFile, err := findCaseFiles(ctx, cmd.GormDB, caseInputFileID, caseOutputFileID)
 		if err != nil {
-			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignment: FindCaseFiles")
-			return core.ErrInternalServer
+			return err
 		}
 
-		caseInputFile, _, found := lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
-			return file.Type == dbmodel.FileTypeAssignmentCaseInput
-		})
-		if !found {
-			return errors.New("case input file not found")
+		if err = findHarnessFiles(ctx, tx, harnessFiles); err != nil {
+			return err
 		}
 
-		caseOutputFile, _, found := lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
-			return file.Type == dbmodel.FileTypeAssignmentCaseOutput
-		})
-		if !found {
-			return errors.New("case output file not found")
+		// moving the assignment also requires the permission in the new course
+		if slices.Contains(fields, assignments.AssignmentFieldCourseID) && courseID != assignment.CourseID {
+			if err = findCourse(ctx, tx, courseID); err != nil {
+				return err
+			}
+
+			if err = authorizeCourse(ctx, tx, authUser, courseID, auth.CreateAssignment); err != nil {
+				return err
+			}
 		}
 
+		prevAssignment := assignment
 		assignment, err = assignment.Update(assignments.UpdateAssignmentRequest{
-			Now:            now,
-			Name:           req.Msg.GetName(),
-			Description:    req.Msg.GetDescription(),
-			Assigner:       assigner,
-			CaseInputFile:  caseInputFile,
-			CaseOutputFile: caseOutputFile,
-			DeadlineAt:     deadlineAt,
-			Template:       req.Msg.GetTemplate(),
+			Fields:           fields,
+			Now:              now,
+			Name:             req.Msg.GetName(),
+			Description:      req.Msg.GetDescription(),
+			Assigner:         assigner,
+			CaseInputFile:    caseInputFile,
+			CaseOutputFile:   caseOutputFile,
+			DeadlineAt:       deadlineAt,
+			Template:         req.Msg.GetTemplate(),
+			Checker:          checkerFromProto(req.Msg.GetChecker()),
+			AllowedLanguages: languagesFromProto(req.Msg.GetAllowedLanguages()),
+			Limits:           limitsFromProto(req.Msg.GetLimits()),
+			MaxScore:         req.Msg.GetMaxScore(),
+			FeedbackLevel:    dbmodel.FeedbackLevel(req.Msg.GetFeedbackLevel()),
+			AttemptPolicy:    attemptPolicyFromProto(req.Msg.GetAttemptPolicy()),
+			LatePolicy:       latePolicy,
+			Release:          release,
+			CourseID:         courseID,
+			TestFramework:    grading.TestFramework(req.Msg.GetTestFramework()),
+			HarnessFiles:     harnessFiles,
+			BuildCommand:     req.Msg.GetBuildCommand(),
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -205,6 +275,22 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
+		if assignment.IsReleaseChanged(prevAssignment) {
+			err = cmd.enqueueAssignmentPublished(ctx, tx, assignment)
+			if err != nil {
+				logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignment: enqueueAssignmentPublished")
+				return core.ErrInternalServer
+			}
+		}
+
+		if assignment.IsTestChanged(prevAssignment) {
+			_, err = cmd.enqueueRegrade(ctx, tx, assignment)
+			if err != nil {
+				logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignment: enqueueRegrade")
+				return core.ErrInternalServer
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -214,6 +300,233 @@ func (cmd *AssignmentCmd) UpdateAssignment(ctx context.Context, req *connect.Req
 	return core.ProtoEmptyResponse, nil
 }
 
+func checkerFromProto(checker *autogradv1.AssignmentChecker) assignments.Checker {
+	return assignments.Checker{
+		Type:                  dbmodel.CheckerType(checker.GetType()),
+		AbsEpsilon:            checker.GetAbsEpsilon(),
+		RelEpsilon:            checker.GetRelEpsilon(),
+		Source:                checker.GetSource(),
+		InteractorTimeLimit:   grading.Second(checker.GetInteractorTimeLimitSec()),
+		InteractorMemoryLimit: grading.Mib(checker.GetInteractorMemoryLimitMib()),
+	}
+}
+
+func limitsFromProto(limits *autogradv1.AssignmentLimits) assignments.Limits {
+	return assignments.Limits{
+		TimeLimit:    grading.Second(limits.GetTimeLimitSec()),
+		MemoryLimit:  grading.Mib(limits.GetMemoryLimitMib()),
+		OutputLimit:  grading.Kib(limits.GetOutputLimitKib()),
+		ProcessLimit: int(limits.GetProcessLimit()),
+	}
+}
+
+func attemptPolicyFromProto(policy *autogradv1.AssignmentAttemptPolicy) assignments.AttemptPolicy {
+	return assignments.AttemptPolicy{
+		MaxAttempts: policy.GetMaxAttempts(),
+		Cooldown:    time.Duration(policy.GetCooldownSec()) * time.Second,
+		ScorePolicy: dbmodel.ScorePolicy(policy.GetScorePolicy()),
+	}
+}
+
+// latePolicyFromProto parses the hard deadline, an empty hard deadline means no late submission
+func latePolicyFromProto(policy *autogradv1.AssignmentLatePolicy) (grading.LatePolicy, error) {
+	latePolicy := grading.LatePolicy{
+		GracePeriod:          time.Duration(policy.GetGracePeriodSec()) * time.Second,
+		PenaltyPercentPerDay: policy.GetPenaltyPercentPerDay(),
+	}
+
+	if policy.GetHardDeadlineAt() == "" {
+		return latePolicy, nil
+	}
+
+	hardDeadlineAt, err := time.Parse(time.RFC3339, policy.GetHardDeadlineAt())
+	if err != nil {
+		return grading.LatePolicy{}, fmt.Errorf("invalid hard deadline: %w", err)
+	}
+	latePolicy.HardDeadlineAt = hardDeadlineAt
+
+	return latePolicy, nil
+}
+
+// releaseFromProto parses the publish time, an empty publish time publishes right away
+func releaseFromProto(status, publishAt string) (assignments.Release, error) {
+	release := assignments.Release{
+		Status: dbmodel.AssignmentStatus(status),
+	}
+
+	if publishAt == "" {
+		return release, nil
+	}
+
+	publishAtTime, err := time.Parse(time.RFC3339, publishAt)
+	if err != nil {
+		return assignments.Release{}, fmt.Errorf("invalid publish time: %w", err)
+	}
+	release.PublishAt = publishAtTime
+
+	return release, nil
+}
+
+// testCaseWeightFromProto defaults the weight when it's not given, a given zero is kept
+func testCaseWeightFromProto(weight *int32) int32 {
+	if weight == nil {
+		return assignments.DefaultTestCaseWeight
+	}
+	return *weight
+}
+
+func languagesFromProto(langs []string) []grading.Language {
+	return lo.Map(langs, func(lang string, _ int) grading.Language {
+		return grading.Language(lang)
+	})
+}
+
+// parseCaseFileIDs parses the optional case file ids,
+// both must be given when one of them is given.
+func parseCaseFileIDs(inputFileID, outputFileID string) (uuid.UUID, uuid.UUID, error) {
+	if inputFileID == "" && outputFileID == "" {
+		return uuid.Nil, uuid.Nil, nil
+	}
+
+	caseInputFileID, err := uuid.Parse(inputFileID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid case input file id: %w", err)
+	}
+
+	caseOutputFileID, err := uuid.Parse(outputFileID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid case output file id: %w", err)
+	}
+
+	return caseInputFileID, caseOutputFileID, nil
+}
+
+func findCaseFiles(ctx context.Context, tx *gorm.DB, caseInputFileID, caseOutputFileID uuid.UUID) (
+	caseInputFile assignments.CaseFile, caseOutputFile assignments.CaseFile, err error,
+) {
+	if caseInputFileID == uuid.Nil && caseOutputFileID == uuid.Nil {
+		return caseInputFile, caseOutputFile, nil
+	}
+
+	fileIDs := []uuid.UUID{caseInputFileID, caseOutputFileID}
+	caseFiles, err := assignments.FileReader{}.FindCaseFiles(ctx, tx, fileIDs)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentCmd: findCaseFiles: FindCaseFiles")
+		return caseInputFile, caseOutputFile, core.ErrInternalServer
+	}
+
+	caseInputFile, _, found := lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
+		return file.Type == dbmodel.FileTypeAssignmentCaseInput
+	})
+	if !found {
+		return caseInputFile, caseOutputFile, connect.NewError(connect.CodeInvalidArgument, errors.New("case input file not found"))
+	}
+
+	caseOutputFile, _, found = lo.FindIndexOf(caseFiles, func(file assignments.CaseFile) bool {
+		return file.Type == dbmodel.FileTypeAssignmentCaseOutput
+	})
+	if !found {
+		return caseInputFile, caseOutputFile, connect.NewError(connect.CodeInvalidArgument, errors.New("case output file not found"))
+	}
+
+	return caseInputFile, caseOutputFile, nil
+}
+
+func harnessFilesFromProto(files []*autogradv1.AssignmentHarnessFile) ([]assignments.HarnessFile, error) {
+	harnessFiles := make([]assignments.HarnessFile, len(files))
+	for i, file := range files {
+		fileID, err := uuid.Parse(file.GetFileId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid harness file id: %w", err)
+		}
+
+		harnessFiles[i] = assignments.HarnessFile{
+			FileID: fileID,
+			Name:   file.GetName(),
+		}
+	}
+
+	return harnessFiles, nil
+}
+
+// findHarnessFiles checks every harness file is uploaded as a harness media
+func findHarnessFiles(ctx context.Context, tx *gorm.DB, harnessFiles []assignments.HarnessFile) error {
+	if len(harnessFiles) == 0 {
+		return nil
+	}
+
+	fileIDs := lo.Uniq(lo.Map(harnessFiles, func(file assignments.HarnessFile, _ int) uuid.UUID {
+		return file.FileID
+	}))
+	files, err := assignments.FileReader{}.FindHarnessFiles(ctx, tx, fileIDs)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentCmd: findHarnessFiles: FindHarnessFiles")
+		return core.ErrInternalServer
+	}
+
+	if len(files) != len(fileIDs) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("harness file not found"))
+	}
+
+	return nil
+}
+
+// parseOptionalID parses an optional id, empty is uuid.Nil
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+// findCourse checks the course of the assignment exists, uuid.Nil is no course
+func findCourse(ctx context.Context, tx *gorm.DB, courseID uuid.UUID) error {
+	if courseID == uuid.Nil {
+		return nil
+	}
+
+	_, err := course.CourseReader{}.FindByID(ctx, tx, courseID)
+	if core.IsDBNotFoundErr(err) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("course not found"))
+	}
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentCmd: findCourse: CourseReader{}.FindByID")
+		return core.ErrInternalServer
+	}
+
+	return nil
+}
+
+// authorizeCourse checks the user is granted with the permissions in the course, uuid.Nil is no course
+func authorizeCourse(ctx context.Context, tx *gorm.DB, authUser auth.AuthUser, courseID uuid.UUID, perms ...auth.Permission) error {
+	granted, err := auth.CourseRoleReader{}.Authorize(ctx, tx, authUser, courseID, perms...)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentCmd: authorizeCourse: CourseRoleReader{}.Authorize")
+		return core.ErrInternalServer
+	}
+
+	if !granted {
+		return connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	return nil
+}
+
+// authorizeAssignmentCourse checks the user is granted with the permissions in the course of the assignment
+func authorizeAssignmentCourse(ctx context.Context, tx *gorm.DB, authUser auth.AuthUser, assignmentID uuid.UUID, perms ...auth.Permission) error {
+	if authUser.Role.Can(perms...) {
+		return nil
+	}
+
+	assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentCmd: authorizeAssignmentCourse: AssignmentReader{}.FindByID")
+		return core.ErrInternalServer
+	}
+
+	return authorizeCourse(ctx, tx, authUser, assignment.CourseID, perms...)
+}
+
 func (cmd *AssignmentCmd) DeleteAssignment(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
 
 	authUser, ok := auth.GetUserFromCtx(ctx)
@@ -221,7 +534,7 @@ func (cmd *AssignmentCmd) DeleteAssignment(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	if !authUser.Role.Can(auth.DeleteAssignment) {
+	if !authUser.Role.CanInAnyCourse(auth.DeleteAssignment) {
 		return nil, connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
@@ -241,6 +554,10 @@ func (cmd *AssignmentCmd) DeleteAssignment(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.DeleteAssignment); err != nil {
+			return err
+		}
+
 		assignment, err = assignment.Delete(now)
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -308,6 +625,18 @@ func (cmd *AssignmentCmd) CreateSubmission(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
+		extension, hasExtension, err := assignments.ExtensionReader{}.FindByStudent(ctx, cmd.GormDB, assignment.ID, submitter.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateSubmission: ExtensionReader{}.FindByStudent")
+			return core.ErrInternalServer
+		}
+
+		isEnrolled, err := assignments.SubmitterReader{}.IsEnrolled(ctx, cmd.GormDB, assignment.CourseID, submitter.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateSubmission: SubmitterReader{}.IsEnrolled")
+			return core.ErrInternalServer
+		}
+
 		submissionFile, err := assignments.SubmissionFileReader{}.FindByID(ctx, cmd.GormDB, submissionFileID)
 		if err != nil {
 			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateSubmission: SubmissionFileReader{}.FindByID")
@@ -316,10 +645,14 @@ func (cmd *AssignmentCmd) CreateSubmission(ctx context.Context, req *connect.Req
 
 		submission, err = assignments.CreateSubmission(assignments.CreateSubmissionRequest{
 			NewID:          uuid.New(),
+			NewAttemptID:   uuid.New(),
 			Now:            now,
 			Assignment:     assignment,
 			Submitter:      submitter,
 			SubmissionFile: submissionFile,
+			Extension:      extension,
+			HasExtension:   hasExtension,
+			IsEnrolled:     isEnrolled,
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -394,6 +727,18 @@ func (cmd *AssignmentCmd) UpdateSubmission(ctx context.Context, req *connect.Req
 			return core.ErrInternalServer
 		}
 
+		extension, hasExtension, err := assignments.ExtensionReader{}.FindByStudent(ctx, cmd.GormDB, submission.Assignment.ID, submitter.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateSubmission: ExtensionReader{}.FindByStudent")
+			return core.ErrInternalServer
+		}
+
+		isEnrolled, err := assignments.SubmitterReader{}.IsEnrolled(ctx, cmd.GormDB, submission.Assignment.CourseID, submitter.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateSubmission: SubmitterReader{}.IsEnrolled")
+			return core.ErrInternalServer
+		}
+
 		submissionFile, err := assignments.SubmissionFileReader{}.FindByID(ctx, cmd.GormDB, submissionFileID)
 		if err != nil {
 			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateSubmission: SubmissionFileReader{}.FindByID")
@@ -401,9 +746,13 @@ func (cmd *AssignmentCmd) UpdateSubmission(ctx context.Context, req *connect.Req
 		}
 
 		submission, err = submission.Update(assignments.UpdateSubmissionRequest{
+			NewAttemptID:   uuid.New(),
 			Now:            now,
 			SubmissionFile: submissionFile,
 			Submitter:      submitter,
+			Extension:      extension,
+			HasExtension:   hasExtension,
+			IsEnrolled:     isEnrolled,
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -474,3 +823,200 @@ func (cmd *AssignmentCmd) DeleteSubmission(ctx context.Context, req *connect.Req
 
 	return core.ProtoEmptyResponse, nil
 }
+
+func (cmd *AssignmentCmd) CreateAssignmentTestCase(ctx context.Context, req *connect.Request[autogradv1.CreateAssignmentTestCaseRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+	testCase := assignments.TestCase{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) (err error) {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentTestCase: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		testCase, err = assignments.CreateTestCase(assignments.CreateTestCaseRequest{
+			NewID:          uuid.New(),
+			Now:            now,
+			Assignment:     assignment,
+			Name:           req.Msg.GetName(),
+			Position:       req.Msg.GetPosition(),
+			Input:          req.Msg.GetInput(),
+			ExpectedOutput: req.Msg.GetExpectedOutput(),
+			Weight:         testCaseWeightFromProto(req.Msg.Weight),
+			IsHidden:       req.Msg.GetIsHidden(),
+			Group:          req.Msg.GetGroup(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.TestCaseWriter{}.Create(ctx, tx, testCase)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentTestCase: TestCaseWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		err = cmd.changeTests(ctx, tx, assignment.ID, now)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentTestCase: changeTests")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      testCase.ID.String(),
+			Message: "test case created",
+		},
+	}, nil
+}
+
+func (cmd *AssignmentCmd) UpdateAssignmentTestCase(ctx context.Context, req *connect.Request[autogradv1.UpdateAssignmentTestCaseRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	testCaseID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		testCase, err := assignments.TestCaseReader{}.FindByID(ctx, tx, testCaseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentTestCase: TestCaseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeAssignmentCourse(ctx, tx, authUser, testCase.AssignmentID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		testCase, err = testCase.Update(assignments.UpdateTestCaseRequest{
+			Now:            now,
+			Name:           req.Msg.GetName(),
+			Position:       req.Msg.GetPosition(),
+			Input:          req.Msg.GetInput(),
+			ExpectedOutput: req.Msg.GetExpectedOutput(),
+			Weight:         testCaseWeightFromProto(req.Msg.Weight),
+			IsHidden:       req.Msg.GetIsHidden(),
+			Group:          req.Msg.GetGroup(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.TestCaseWriter{}.Update(ctx, tx, testCase)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentTestCase: TestCaseWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		err = cmd.changeTests(ctx, tx, testCase.AssignmentID, now)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentTestCase: changeTests")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *AssignmentCmd) DeleteAssignmentTestCase(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	testCaseID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		testCase, err := assignments.TestCaseReader{}.FindByID(ctx, tx, testCaseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentTestCase: TestCaseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeAssignmentCourse(ctx, tx, authUser, testCase.AssignmentID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		testCase, err = testCase.Delete(now)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.TestCaseWriter{}.Update(ctx, tx, testCase)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentTestCase: TestCaseWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		err = cmd.changeTests(ctx, tx, testCase.AssignmentID, now)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentTestCase: changeTests")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
@@ -0,0 +1,105 @@
+package assignments_cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/jobqueue"
+	"github.com/fahmifan/autograd/pkg/jobqueue/outbox"
+	"github.com/fahmifan/autograd/pkg/logs"
+	"github.com/fahmifan/autograd/pkg/mailer"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const JobAssignmentPublished jobqueue.JobType = "assignment_published"
+
+type AssignmentPublishedHandler struct {
+	*core.Ctx
+}
+
+type AssignmentPublishedPayload struct {
+	AssignmentID uuid.UUID
+	// PublishAt is the release time the job is scheduled for,
+	// the job is stale when the assignment is rescheduled
+	PublishAt time.Time
+}
+
+func (handler *AssignmentPublishedHandler) JobType() jobqueue.JobType {
+	return JobAssignmentPublished
+}
+
+func (handler *AssignmentPublishedHandler) Handle(ctx context.Context, tx *gorm.DB, payload jobqueue.Payload) error {
+	req := AssignmentPublishedPayload{}
+	err := jobqueue.UnmarshalPayload(payload, &req)
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "AssignmentPublishedHandler: Handle: json.Unmarshal")
+	}
+
+	assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, req.AssignmentID)
+	if core.IsDBNotFoundErr(err) {
+		logs.InfoCtx(ctx, "AssignmentPublishedHandler: Handle", "assignment is deleted", req.AssignmentID.String())
+		return nil
+	}
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "AssignmentPublishedHandler: Handle: FindByID")
+	}
+
+	isStale := !assignment.Release.IsPublished(time.Now()) ||
+		assignment.Release.PublishAt.Unix() != req.PublishAt.Unix()
+	if isStale {
+		logs.InfoCtx(ctx, "AssignmentPublishedHandler: Handle", "assignment is rescheduled", req.AssignmentID.String())
+		return nil
+	}
+
+	recipients, err := assignments.RecipientReader{}.FindAllActiveStudents(ctx, tx, assignment.CourseID)
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "AssignmentPublishedHandler: Handle: FindAllActiveStudents")
+	}
+
+	// a failed email should not stop notifying the other students
+	for _, recipient := range recipients {
+		publishedEmail, err := assignments.CreatePublishedEmail(assignments.CreatePublishedEmailRequest{
+			SenderEmail: handler.SenderEmail,
+			AppLink:     handler.AppLink,
+			LogoURL:     handler.LogoURL,
+			Assignment:  assignment,
+			Recipient:   recipient,
+		})
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentPublishedHandler: Handle: CreatePublishedEmail", "recipient", recipient.ID.String())
+			continue
+		}
+
+		err = handler.Ctx.Mailer.Send(ctx, mailer.Email{
+			Subject:   publishedEmail.Subject,
+			From:      publishedEmail.FromEmail,
+			To:        publishedEmail.ToEmail,
+			Body:      publishedEmail.HTMLBody,
+			BodyPlain: publishedEmail.PlainTextBody,
+		})
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentPublishedHandler: Handle: Mailer.Send", "recipient", recipient.ID.String())
+		}
+	}
+
+	return nil
+}
+
+// enqueueAssignmentPublished schedules the notification at the release time of the assignment
+func (cmd *AssignmentCmd) enqueueAssignmentPublished(ctx context.Context, tx *gorm.DB, assignment assignments.Assignment) error {
+	publishAt := assignment.Release.PublishAt
+	_, err := cmd.OutboxEnqueuer.Enqueue(ctx, tx, outbox.EnqueueRequest{
+		JobType:       JobAssignmentPublished,
+		IdempotentKey: jobqueue.IdempotentKey(fmt.Sprintf("%s:%d", assignment.ID, publishAt.Unix())),
+		RunAt:         publishAt,
+		Payload: AssignmentPublishedPayload{
+			AssignmentID: assignment.ID,
+			PublishAt:    publishAt,
+		},
+	})
+	return err
+}
@@ -0,0 +1,240 @@
+package assignments_cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (cmd *AssignmentCmd) CreateAssignmentExtension(ctx context.Context, req *connect.Request[autogradv1.CreateAssignmentExtensionRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	studentID, err := uuid.Parse(req.Msg.GetStudentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deadlineAt, err := time.Parse(time.RFC3339, req.Msg.GetDeadlineAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+	extension := assignments.Extension{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) (err error) {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentExtension: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		student, err := assignments.SubmitterReader{}.FindByID(ctx, tx, studentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentExtension: SubmitterReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		_, hasExtension, err := assignments.ExtensionReader{}.FindByStudent(ctx, tx, assignmentID, studentID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentExtension: ExtensionReader{}.FindByStudent")
+			return core.ErrInternalServer
+		}
+		if hasExtension {
+			return connect.NewError(connect.CodeAlreadyExists, errors.New("student already has an extension"))
+		}
+
+		grantedBy, err := assignments.AssignerReader{}.FindByID(ctx, tx, authUser.UserID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentExtension: AssignerReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		extension, err = assignments.GrantExtension(assignments.GrantExtensionRequest{
+			NewID:      uuid.New(),
+			Now:        now,
+			Assignment: assignment,
+			Student:    student,
+			GrantedBy:  grantedBy,
+			DeadlineAt: deadlineAt,
+			Reason:     req.Msg.GetReason(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.ExtensionWriter{}.Create(ctx, tx, extension)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentExtension: ExtensionWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      extension.ID.String(),
+			Message: "extension created",
+		},
+	}, nil
+}
+
+func (cmd *AssignmentCmd) UpdateAssignmentExtension(ctx context.Context, req *connect.Request[autogradv1.UpdateAssignmentExtensionRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	extensionID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deadlineAt, err := time.Parse(time.RFC3339, req.Msg.GetDeadlineAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		extension, err := assignments.ExtensionReader{}.FindByID(ctx, tx, extensionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentExtension: ExtensionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, extension.AssignmentID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentExtension: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		grantedBy, err := assignments.AssignerReader{}.FindByID(ctx, tx, authUser.UserID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentExtension: AssignerReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		extension, err = extension.Update(assignments.UpdateExtensionRequest{
+			Now:        now,
+			Assignment: assignment,
+			GrantedBy:  grantedBy,
+			DeadlineAt: deadlineAt,
+			Reason:     req.Msg.GetReason(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.ExtensionWriter{}.Update(ctx, tx, extension)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentExtension: ExtensionWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *AssignmentCmd) DeleteAssignmentExtension(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	extensionID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		extension, err := assignments.ExtensionReader{}.FindByID(ctx, tx, extensionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentExtension: ExtensionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeAssignmentCourse(ctx, tx, authUser, extension.AssignmentID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		extension, err = extension.Delete(now)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.ExtensionWriter{}.Update(ctx, tx, extension)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentExtension: ExtensionWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
@@ -0,0 +1,133 @@
+package assignments_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/student_assignment/student_assignment_cmd"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/fahmifan/autograd/pkg/jobqueue"
+	"github.com/fahmifan/autograd/pkg/jobqueue/outbox"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// RegradeAssignment bumps the test version of an assignment & regrades every submission
+func (cmd *AssignmentCmd) RegradeAssignment(ctx context.Context, req *connect.Request[autogradv1.RegradeAssignmentRequest]) (*connect.Response[autogradv1.RegradeAssignmentResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.RegradeAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+	res := &autogradv1.RegradeAssignmentResponse{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, errors.New("assignment not found"))
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: RegradeAssignment: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		assignment, err = assignment.ChangeTests(now)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		count, err := cmd.saveTestChange(ctx, tx, assignment)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: RegradeAssignment: saveTestChange")
+			return core.ErrInternalServer
+		}
+
+		res.TestVersion = assignment.TestVersion
+		res.SubmissionCount = int32(count)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.RegradeAssignmentResponse]{Msg: res}, nil
+}
+
+// changeTests bumps the test version of the assignment of a changed test case & regrades its submissions
+func (cmd *AssignmentCmd) changeTests(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID, now time.Time) error {
+	assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+	if err != nil {
+		return fmt.Errorf("find assignment: %w", err)
+	}
+
+	assignment, err = assignment.ChangeTests(now)
+	if err != nil {
+		return fmt.Errorf("change tests: %w", err)
+	}
+
+	_, err = cmd.saveTestChange(ctx, tx, assignment)
+	return err
+}
+
+// saveTestChange saves the bumped test version & enqueues a regrade of every submission,
+// it returns the number of the enqueued submissions
+func (cmd *AssignmentCmd) saveTestChange(ctx context.Context, tx *gorm.DB, assignment assignments.Assignment) (int, error) {
+	err := assignments.AssignmentWriter{}.UpdateTestVersion(ctx, tx, assignment)
+	if err != nil {
+		return 0, fmt.Errorf("update test version: %w", err)
+	}
+
+	return cmd.enqueueRegrade(ctx, tx, assignment)
+}
+
+// enqueueRegrade enqueues a grading of the current attempt of every submission,
+// the jobs of a test version are enqueued once. With the best score policy every graded attempt
+// is regraded too, so the best grade is picked from the grades of the same tests.
+// It returns the number of the regraded submissions.
+func (cmd *AssignmentCmd) enqueueRegrade(ctx context.Context, tx *gorm.DB, assignment assignments.Assignment) (int, error) {
+	withGraded := assignment.AttemptPolicy.ScorePolicy == dbmodel.ScorePolicyBest
+	attempts, err := assignments.SubmissionReader{}.FindAllRegradeAttempts(ctx, tx, assignment.ID, withGraded)
+	if err != nil {
+		return 0, fmt.Errorf("find attempts: %w", err)
+	}
+
+	for _, attempt := range attempts {
+		_, err = cmd.OutboxEnqueuer.Enqueue(ctx, tx, outbox.EnqueueRequest{
+			JobType:       student_assignment_cmd.JobGradeSubmission,
+			IdempotentKey: jobqueue.IdempotentKey(fmt.Sprintf("regrade:%s:%d", attempt.AttemptID, assignment.TestVersion)),
+			Payload: student_assignment_cmd.GradeStudentSubmissionPayload{
+				SubmissionID: attempt.SubmissionID,
+				AttemptID:    attempt.AttemptID,
+				TestVersion:  assignment.TestVersion,
+			},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("enqueue attempt %s: %w", attempt.AttemptID, err)
+		}
+	}
+
+	submissionIDs := lo.Uniq(lo.Map(attempts, func(attempt assignments.RegradeAttempt, _ int) uuid.UUID {
+		return attempt.SubmissionID
+	}))
+	return len(submissionIDs), nil
+}
@@ -0,0 +1,281 @@
+package assignments_cmd
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (cmd *AssignmentCmd) CreateAssignmentRubricCriterion(ctx context.Context, req *connect.Request[autogradv1.CreateAssignmentRubricCriterionRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+	criterion := assignments.RubricCriterion{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) (err error) {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentRubricCriterion: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, assignment.CourseID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		criterion, err = assignments.CreateRubricCriterion(assignments.CreateRubricCriterionRequest{
+			NewID:       uuid.New(),
+			Now:         now,
+			Assignment:  assignment,
+			Name:        req.Msg.GetName(),
+			Description: req.Msg.GetDescription(),
+			MaxPoints:   req.Msg.GetMaxPoints(),
+			Position:    req.Msg.GetPosition(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.RubricCriterionWriter{}.Create(ctx, tx, criterion)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: CreateAssignmentRubricCriterion: RubricCriterionWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      criterion.ID.String(),
+			Message: "rubric criterion created",
+		},
+	}, nil
+}
+
+func (cmd *AssignmentCmd) UpdateAssignmentRubricCriterion(ctx context.Context, req *connect.Request[autogradv1.UpdateAssignmentRubricCriterionRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	criterionID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		criterion, err := assignments.RubricCriterionReader{}.FindByID(ctx, tx, criterionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentRubricCriterion: RubricCriterionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeAssignmentCourse(ctx, tx, authUser, criterion.AssignmentID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		criterion, err = criterion.Update(assignments.UpdateRubricCriterionRequest{
+			Now:         now,
+			Name:        req.Msg.GetName(),
+			Description: req.Msg.GetDescription(),
+			MaxPoints:   req.Msg.GetMaxPoints(),
+			Position:    req.Msg.GetPosition(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.RubricCriterionWriter{}.Update(ctx, tx, criterion)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: UpdateAssignmentRubricCriterion: RubricCriterionWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *AssignmentCmd) DeleteAssignmentRubricCriterion(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.UpdateAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	criterionID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		criterion, err := assignments.RubricCriterionReader{}.FindByID(ctx, tx, criterionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentRubricCriterion: RubricCriterionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeAssignmentCourse(ctx, tx, authUser, criterion.AssignmentID, auth.UpdateAssignment); err != nil {
+			return err
+		}
+
+		criterion, err = criterion.Delete(now)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.RubricCriterionWriter{}.Update(ctx, tx, criterion)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: DeleteAssignmentRubricCriterion: RubricCriterionWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *AssignmentCmd) GradeSubmission(ctx context.Context, req *connect.Request[autogradv1.GradeSubmissionRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.GradeAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	submissionID, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rubricPoints := make([]assignments.RubricPoints, len(req.Msg.GetRubricScores()))
+	for i, score := range req.Msg.GetRubricScores() {
+		criterionID, err := uuid.Parse(score.GetCriterionId())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		rubricPoints[i] = assignments.RubricPoints{
+			CriterionID: criterionID,
+			Points:      score.GetPoints(),
+			Comment:     score.GetComment(),
+		}
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		submission, err := assignments.SubmissionReader{}.FindByID(ctx, tx, submissionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: GradeSubmission: SubmissionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		if err = authorizeCourse(ctx, tx, authUser, submission.Assignment.CourseID, auth.GradeAssignment); err != nil {
+			return err
+		}
+
+		grader, err := assignments.AssignerReader{}.FindByID(ctx, tx, authUser.UserID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: GradeSubmission: AssignerReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		criteria, err := assignments.RubricCriterionReader{}.FindAllByAssignmentID(ctx, tx, submission.Assignment.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: GradeSubmission: RubricCriterionReader{}.FindAllByAssignmentID")
+			return core.ErrInternalServer
+		}
+
+		scores, err := assignments.RubricScoreReader{}.FindAllBySubmissionID(ctx, tx, submission.ID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: GradeSubmission: RubricScoreReader{}.FindAllBySubmissionID")
+			return core.ErrInternalServer
+		}
+
+		graded, err := submission.GradeManually(assignments.GradeSubmissionRequest{
+			NewAuditID:    uuid.New(),
+			Now:           now,
+			Grader:        grader,
+			Criteria:      criteria,
+			Scores:        scores,
+			RubricPoints:  rubricPoints,
+			IsOverridden:  req.Msg.GetIsOverridden(),
+			OverrideGrade: req.Msg.GetOverrideGrade(),
+			Reason:        req.Msg.GetReason(),
+			Feedback:      req.Msg.GetFeedback(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = assignments.SubmissionWriter{}.SaveGrading(ctx, tx, graded)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentCmd: GradeSubmission: SubmissionWriter{}.SaveGrading")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
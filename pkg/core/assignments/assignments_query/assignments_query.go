@@ -9,11 +9,14 @@ import (
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/core/assignments"
 	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_query"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/fahmifan/autograd/pkg/logs"
 	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 type AssignmentsQuery struct {
@@ -29,12 +32,24 @@ func (query *AssignmentsQuery) FindAllAssignments(
 		return nil, core.ErrUnauthenticated
 	}
 
-	if !authUser.Role.Can(auth.ViewAnyAssignments) {
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyAssignments) {
 		return nil, core.ErrPermissionDenied
 	}
 
+	courseID, err := parseOptionalID(req.Msg.GetCourseId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	courseIDs, err := findGrantedCourseIDs(ctx, query.GormDB, authUser, auth.ViewAnyAssignments)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := assignments.AssignmentReader{}.FindAll(ctx, query.GormDB, assignments.FindAllAssignmentsRequest{
 		PaginationRequest: core.PaginationRequestFromProto(req.Msg.GetPaginationRequest()),
+		CourseID:          courseID,
+		CourseIDs:         courseIDs,
 	})
 	if err != nil {
 		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllAssignments: FindAll")
@@ -58,7 +73,7 @@ func (query *AssignmentsQuery) FindAssignment(
 		return nil, core.ErrUnauthenticated
 	}
 
-	if !authUser.Role.Can(auth.ViewAnyAssignments) {
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyAssignments) {
 		return nil, core.ErrPermissionDenied
 	}
 
@@ -77,6 +92,10 @@ func (query *AssignmentsQuery) FindAssignment(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	if err = authorizeCourse(ctx, query.GormDB, authUser, assignment.CourseID, auth.ViewAnyAssignments); err != nil {
+		return nil, err
+	}
+
 	return &connect.Response[autogradv1.Assignment]{
 		Msg: toAssignmentProto(assignment),
 	}, nil
@@ -91,7 +110,7 @@ func (query *AssignmentsQuery) FindSubmission(
 		return nil, core.ErrUnauthenticated
 	}
 
-	if !authUser.Role.Can(auth.ViewAnySubmissions) {
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnySubmissions) {
 		return nil, core.ErrPermissionDenied
 	}
 
@@ -110,7 +129,17 @@ func (query *AssignmentsQuery) FindSubmission(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	var submissionBuf []byte
+	if err = authorizeCourse(ctx, query.GormDB, authUser, submission.Assignment.CourseID, auth.ViewAnySubmissions); err != nil {
+		return nil, err
+	}
+
+	rubricScores, err := assignments.RubricScoreReader{}.FindAllBySubmissionID(ctx, query.GormDB, submission.ID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindSubmission: RubricScoreReader{}.FindAllBySubmissionID")
+		return nil, core.ErrInternalServer
+	}
+
+	var submissionSource grading.SubmissionSource
 	if submission.SourceFile.ID != uuid.Nil {
 		mediaStoreQuery := mediastore_query.MediaStoreQuery{Ctx: query.Ctx}
 		submissionMedia, err := mediaStoreQuery.InternalFindMediaFile(ctx, mediastore_query.InternalFindMediaFileRequest{
@@ -122,15 +151,21 @@ func (query *AssignmentsQuery) FindSubmission(
 		}
 		defer submissionMedia.BodyCloser.Close()
 
-		submissionBuf, err = io.ReadAll(submissionMedia.BodyCloser)
+		submissionBuf, err := io.ReadAll(submissionMedia.BodyCloser)
 		if err != nil {
 			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: FindStudentAssignment: io.ReadAll")
 			return nil, core.ErrInternalServer
 		}
+
+		submissionSource, err = grading.ReadSubmissionSource(submissionMedia.FileName, submissionBuf)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentsQuery: FindSubmission: ReadSubmissionSource")
+			return nil, core.ErrInternalServer
+		}
 	}
 
 	return &connect.Response[autogradv1.Submission]{
-		Msg: toSubmissionProto(submission, submissionBuf),
+		Msg: toSubmissionProto(submission, rubricScores, submissionSource),
 	}, nil
 }
 
@@ -143,7 +178,7 @@ func (query *AssignmentsQuery) FindAllSubmissionForAssignment(
 		return nil, core.ErrUnauthenticated
 	}
 
-	if !authUser.Role.Can(auth.ViewAnySubmissions) {
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnySubmissions) {
 		return nil, core.ErrPermissionDenied
 	}
 
@@ -156,16 +191,64 @@ func (query *AssignmentsQuery) FindAllSubmissionForAssignment(
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	if err = authorizeCourse(ctx, query.GormDB, authUser, assignment.CourseID.UUID, auth.ViewAnySubmissions); err != nil {
+		return nil, err
+	}
+
 	assigner := dbmodel.User{}
 	err = query.GormDB.Where("id = ?", assignment.AssignedBy).Take(&assigner).Error
 	if err != nil && core.IsDBNotFoundErr(err) {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
+	sectionID, err := parseOptionalID(req.Msg.GetSectionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// the submissions of a course assignment are of its enrolled students
+	enrollmentScope := func(db *gorm.DB) *gorm.DB { return db }
+	sectionMap := make(map[uuid.UUID]dbmodel.CourseSection)
+	if assignment.CourseID.Valid {
+		enrollments := []dbmodel.CourseEnrollment{}
+		enrollmentQuery := query.GormDB.
+			Where("course_id = ? and role = ?", assignment.CourseID.UUID, dbmodel.EnrollmentRoleStudent)
+		if sectionID != uuid.Nil {
+			enrollmentQuery = enrollmentQuery.Where("section_id = ?", sectionID)
+		}
+		err = enrollmentQuery.Find(&enrollments).Error
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllSubmissionForAssignment: find enrollments")
+			return nil, core.ErrInternalServer
+		}
+
+		sectionIDs := lo.Uniq(lo.Map(enrollments, func(enrollment dbmodel.CourseEnrollment, _ int) uuid.UUID {
+			return enrollment.SectionID
+		}))
+		sections := []dbmodel.CourseSection{}
+		err = query.GormDB.Unscoped().Where("id IN (?)", sectionIDs).Find(&sections).Error
+		if err != nil {
+			logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllSubmissionForAssignment: find sections")
+			return nil, core.ErrInternalServer
+		}
+
+		sectionByID := lo.KeyBy(sections, func(section dbmodel.CourseSection) uuid.UUID { return section.ID })
+		studentIDs := make([]uuid.UUID, len(enrollments))
+		for i, enrollment := range enrollments {
+			studentIDs[i] = enrollment.UserID
+			sectionMap[enrollment.UserID] = sectionByID[enrollment.SectionID]
+		}
+
+		enrollmentScope = func(db *gorm.DB) *gorm.DB {
+			return db.Where("submitted_by IN (?)", studentIDs)
+		}
+	}
+
 	var submissions []dbmodel.Submission
 	err = query.GormDB.
 		Model(&dbmodel.Submission{}).
-		Select("id", "submitted_by").
+		Select("id", "submitted_by", "grade", "raw_grade", "max_score", "is_graded", "is_late", "late_days", "late_penalty_percent", "is_overridden").
+		Scopes(enrollmentScope).
 		Where("assignment_id = ?", assignment.ID).Find(&submissions).Error
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
@@ -191,10 +274,23 @@ func (query *AssignmentsQuery) FindAllSubmissionForAssignment(
 
 	submissionRes := make([]*autogradv1.FindAllSubmissionsForAssignmentResponse_Submission, len(submissions))
 	for i := range submissions {
+		section := sectionMap[submissions[i].SubmittedBy]
 		submissionRes[i] = &autogradv1.FindAllSubmissionsForAssignmentResponse_Submission{
 			Id:            submissions[i].ID.String(),
 			SubmitterId:   submissions[i].SubmittedBy.String(),
 			SubmitterName: submitterMap[submissions[i].SubmittedBy],
+			Grade:         submissions[i].Grade,
+			RawGrade:      submissions[i].RawGrade,
+			MaxScore:      submissions[i].MaxScore,
+			IsGraded:      submissions[i].IsGraded == 1,
+			Lateness: &autogradv1.SubmissionLateness{
+				IsLate:         submissions[i].IsLate == 1,
+				Days:           submissions[i].LateDays,
+				PenaltyPercent: submissions[i].LatePenaltyPercent,
+			},
+			SectionId:    formatIDOrEmpty(section.ID),
+			SectionName:  section.Name,
+			IsOverridden: submissions[i].IsOverridden == 1,
 		}
 	}
 
@@ -211,7 +307,248 @@ func (query *AssignmentsQuery) FindAllSubmissionForAssignment(
 	return res, nil
 }
 
-func toSubmissionProto(submission assignments.Submission, submissionBuf []byte) *autogradv1.Submission {
+func (query *AssignmentsQuery) FindAllAssignmentTestCases(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllAssignmentTestCasesRequest],
+) (*connect.Response[autogradv1.FindAllAssignmentTestCasesResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyAssignments) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err = authorizeAssignmentCourse(ctx, query.GormDB, authUser, assignmentID, auth.ViewAnyAssignments); err != nil {
+		return nil, err
+	}
+
+	testCases, err := assignments.TestCaseReader{}.FindAllByAssignmentID(ctx, query.GormDB, assignmentID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllAssignmentTestCases: FindAllByAssignmentID")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &connect.Response[autogradv1.FindAllAssignmentTestCasesResponse]{
+		Msg: &autogradv1.FindAllAssignmentTestCasesResponse{
+			TestCases: toTestCaseProtos(testCases),
+		},
+	}, nil
+}
+
+func (query *AssignmentsQuery) FindAllAssignmentExtensions(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllAssignmentExtensionsRequest],
+) (*connect.Response[autogradv1.FindAllAssignmentExtensionsResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyAssignments) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err = authorizeAssignmentCourse(ctx, query.GormDB, authUser, assignmentID, auth.ViewAnyAssignments); err != nil {
+		return nil, err
+	}
+
+	extensions, err := assignments.ExtensionReader{}.FindAllByAssignmentID(ctx, query.GormDB, assignmentID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllAssignmentExtensions: FindAllByAssignmentID")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &connect.Response[autogradv1.FindAllAssignmentExtensionsResponse]{
+		Msg: &autogradv1.FindAllAssignmentExtensionsResponse{
+			Extensions: lo.Map(extensions, func(extension assignments.Extension, _ int) *autogradv1.AssignmentExtension {
+				return toExtensionProto(extension)
+			}),
+		},
+	}, nil
+}
+
+// authorizeCourse checks the user is granted with the permissions in the course, uuid.Nil is no course
+func authorizeCourse(ctx context.Context, tx *gorm.DB, authUser auth.AuthUser, courseID uuid.UUID, perms ...auth.Permission) error {
+	granted, err := auth.CourseRoleReader{}.Authorize(ctx, tx, authUser, courseID, perms...)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: authorizeCourse: CourseRoleReader{}.Authorize")
+		return core.ErrInternalServer
+	}
+
+	if !granted {
+		return core.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// authorizeAssignmentCourse checks the user is granted with the permissions in the course of the assignment
+func authorizeAssignmentCourse(ctx context.Context, tx *gorm.DB, authUser auth.AuthUser, assignmentID uuid.UUID, perms ...auth.Permission) error {
+	if authUser.Role.Can(perms...) {
+		return nil
+	}
+
+	assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+	if core.IsDBNotFoundErr(err) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: authorizeAssignmentCourse: AssignmentReader{}.FindByID")
+		return core.ErrInternalServer
+	}
+
+	return authorizeCourse(ctx, tx, authUser, assignment.CourseID, perms...)
+}
+
+// findGrantedCourseIDs finds the courses the user is granted with the permissions in,
+// nil when the permissions are granted globally
+func findGrantedCourseIDs(ctx context.Context, tx *gorm.DB, authUser auth.AuthUser, perms ...auth.Permission) ([]uuid.UUID, error) {
+	if authUser.Role.Can(perms...) {
+		return nil, nil
+	}
+
+	courseRoles, err := auth.CourseRoleReader{}.FindAll(ctx, tx, authUser.UserID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: findGrantedCourseIDs: CourseRoleReader{}.FindAll")
+		return nil, core.ErrInternalServer
+	}
+
+	return courseRoles.CourseIDs(authUser.Role, perms...), nil
+}
+
+func (query *AssignmentsQuery) FindAllAssignmentRubricCriteria(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllAssignmentRubricCriteriaRequest],
+) (*connect.Response[autogradv1.FindAllAssignmentRubricCriteriaResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyAssignments) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err = authorizeAssignmentCourse(ctx, query.GormDB, authUser, assignmentID, auth.ViewAnyAssignments); err != nil {
+		return nil, err
+	}
+
+	criteria, err := assignments.RubricCriterionReader{}.FindAllByAssignmentID(ctx, query.GormDB, assignmentID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllAssignmentRubricCriteria: FindAllByAssignmentID")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &connect.Response[autogradv1.FindAllAssignmentRubricCriteriaResponse]{
+		Msg: &autogradv1.FindAllAssignmentRubricCriteriaResponse{
+			Criteria: lo.Map(criteria, func(criterion assignments.RubricCriterion, _ int) *autogradv1.AssignmentRubricCriterion {
+				return &autogradv1.AssignmentRubricCriterion{
+					Id:                criterion.ID.String(),
+					AssignmentId:      criterion.AssignmentID.String(),
+					Name:              criterion.Name,
+					Description:       criterion.Description,
+					MaxPoints:         criterion.MaxPoints,
+					Position:          criterion.Position,
+					TimestampMetadata: criterion.ProtoTimestampMetadata(),
+				}
+			}),
+		},
+	}, nil
+}
+
+func (query *AssignmentsQuery) FindAllSubmissionGradeAudits(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllSubmissionGradeAuditsRequest],
+) (*connect.Response[autogradv1.FindAllSubmissionGradeAuditsResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnySubmissions) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	submissionID, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	submission := dbmodel.Submission{}
+	err = query.GormDB.Select("id", "assignment_id").Where("id = ?", submissionID).Take(&submission).Error
+	if core.IsDBNotFoundErr(err) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllSubmissionGradeAudits: find submission")
+		return nil, core.ErrInternalServer
+	}
+
+	if err = authorizeAssignmentCourse(ctx, query.GormDB, authUser, submission.AssignmentID, auth.ViewAnySubmissions); err != nil {
+		return nil, err
+	}
+
+	audits, err := assignments.GradeAuditReader{}.FindAllBySubmissionID(ctx, query.GormDB, submissionID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "AssignmentsQuery: FindAllSubmissionGradeAudits: FindAllBySubmissionID")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.FindAllSubmissionGradeAuditsResponse]{
+		Msg: &autogradv1.FindAllSubmissionGradeAuditsResponse{
+			Audits: lo.Map(audits, func(audit assignments.GradeAudit, _ int) *autogradv1.SubmissionGradeAudit {
+				return &autogradv1.SubmissionGradeAudit{
+					Id:                audit.ID.String(),
+					SubmissionId:      audit.SubmissionID.String(),
+					GradedById:        audit.GradedBy.ID.String(),
+					GradedByName:      audit.GradedBy.Name,
+					PreviousGrade:     audit.PreviousGrade,
+					PreviousMaxScore:  audit.PreviousMaxScore,
+					Grade:             audit.Grade,
+					MaxScore:          audit.MaxScore,
+					AutoGrade:         audit.AutoGrade,
+					RubricPoints:      audit.RubricPoints,
+					IsOverridden:      audit.IsOverridden,
+					Reason:            audit.Reason,
+					TimestampMetadata: audit.ProtoTimestampMetadata(),
+				}
+			}),
+		},
+	}, nil
+}
+
+func toExtensionProto(extension assignments.Extension) *autogradv1.AssignmentExtension {
+	return &autogradv1.AssignmentExtension{
+		Id:                extension.ID.String(),
+		AssignmentId:      extension.AssignmentID.String(),
+		StudentId:         extension.Student.ID.String(),
+		StudentName:       extension.Student.Name,
+		DeadlineAt:        extension.DeadlineAt.Format(time.RFC3339),
+		Reason:            extension.Reason,
+		GrantedById:       extension.GrantedBy.ID.String(),
+		GrantedByName:     extension.GrantedBy.Name,
+		TimestampMetadata: extension.ProtoTimestampMetadata(),
+	}
+}
+
+func toSubmissionProto(submission assignments.Submission, rubricScores []assignments.RubricScore, submissionSource grading.SubmissionSource) *autogradv1.Submission {
 	return &autogradv1.Submission{
 		Id:         submission.ID.String(),
 		Assignment: toAssignmentProto(submission.Assignment),
@@ -221,7 +558,35 @@ func toSubmissionProto(submission assignments.Submission, submissionBuf []byte)
 			Url: submission.SourceFile.URL,
 		},
 		TimestampMetadata: submission.ProtoTimestampMetadata(),
-		SubmissionCode:    string(submissionBuf),
+		SubmissionCode:    submissionSource.Code,
+		SourceFiles: lo.Map(submissionSource.Files, func(file grading.SourceFile, _ int) *autogradv1.SourceFile {
+			return &autogradv1.SourceFile{
+				Path:    file.Path,
+				Content: string(file.Content),
+			}
+		}),
+		Grade: &autogradv1.SubmissionGrade{
+			Grade:           submission.Grade,
+			MaxScore:        submission.MaxScore,
+			AutoGrade:       submission.AutoGrade,
+			AutoMaxScore:    submission.AutoMaxScore,
+			RubricPoints:    submission.Manual.RubricPoints,
+			RubricMaxPoints: submission.Manual.RubricMaxPoints,
+			IsOverridden:    submission.Manual.IsOverridden,
+			OverrideGrade:   submission.Manual.OverrideGrade,
+			OverrideReason:  submission.Manual.OverrideReason,
+			Feedback:        submission.Feedback,
+			RubricScores: lo.Map(rubricScores, func(score assignments.RubricScore, _ int) *autogradv1.SubmissionRubricScore {
+				return &autogradv1.SubmissionRubricScore{
+					CriterionId:  score.CriterionID.String(),
+					Points:       score.Points,
+					Comment:      score.Comment,
+					GradedById:   score.GradedBy.ID.String(),
+					GradedByName: score.GradedBy.Name,
+					GradedAt:     formatTimeOrEmpty(score.GradedAt),
+				}
+			}),
+		},
 	}
 }
 
@@ -262,5 +627,86 @@ func toAssignmentProto(assignment assignments.Assignment) *autogradv1.Assignment
 			Url:               assignment.CaseOutputFile.URL,
 			TimestampMetadata: assignment.CaseInputFile.ProtoTimestampMetadata(),
 		},
+		Checker: &autogradv1.AssignmentChecker{
+			Type:                     string(assignment.Checker.Type),
+			AbsEpsilon:               assignment.Checker.AbsEpsilon,
+			RelEpsilon:               assignment.Checker.RelEpsilon,
+			Source:                   assignment.Checker.Source,
+			InteractorTimeLimitSec:   int32(assignment.Checker.InteractorTimeLimit),
+			InteractorMemoryLimitMib: int32(assignment.Checker.InteractorMemoryLimit),
+		},
+		AllowedLanguages: lo.Map(assignment.AllowedLanguages, func(lang grading.Language, _ int) string {
+			return string(lang)
+		}),
+		Limits: &autogradv1.AssignmentLimits{
+			TimeLimitSec:   int32(assignment.Limits.TimeLimit),
+			MemoryLimitMib: int32(assignment.Limits.MemoryLimit),
+			OutputLimitKib: int32(assignment.Limits.OutputLimit),
+			ProcessLimit:   int32(assignment.Limits.ProcessLimit),
+		},
+		MaxScore:      assignment.MaxScore,
+		FeedbackLevel: string(assignment.FeedbackLevel),
+		AttemptPolicy: &autogradv1.AssignmentAttemptPolicy{
+			MaxAttempts: assignment.AttemptPolicy.MaxAttempts,
+			CooldownSec: int32(assignment.AttemptPolicy.Cooldown / time.Second),
+			ScorePolicy: string(assignment.AttemptPolicy.ScorePolicy),
+		},
+		LatePolicy: &autogradv1.AssignmentLatePolicy{
+			HardDeadlineAt:       formatTimeOrEmpty(assignment.LatePolicy.HardDeadlineAt),
+			GracePeriodSec:       int32(assignment.LatePolicy.GracePeriod / time.Second),
+			PenaltyPercentPerDay: assignment.LatePolicy.PenaltyPercentPerDay,
+		},
+		Status:        string(assignment.Release.Status),
+		PublishAt:     formatTimeOrEmpty(assignment.Release.PublishAt),
+		CourseId:      formatIDOrEmpty(assignment.CourseID),
+		TestFramework: string(assignment.TestFramework),
+		HarnessFiles: lo.Map(assignment.HarnessFiles, func(file assignments.HarnessFile, _ int) *autogradv1.AssignmentHarnessFile {
+			return &autogradv1.AssignmentHarnessFile{
+				FileId: file.FileID.String(),
+				Name:   file.Name,
+			}
+		}),
+		BuildCommand: assignment.BuildCommand,
+	}
+}
+
+func toTestCaseProtos(testCases []assignments.TestCase) []*autogradv1.AssignmentTestCase {
+	result := make([]*autogradv1.AssignmentTestCase, len(testCases))
+	for i, testCase := range testCases {
+		result[i] = &autogradv1.AssignmentTestCase{
+			Id:                testCase.ID.String(),
+			AssignmentId:      testCase.AssignmentID.String(),
+			Name:              testCase.Name,
+			Position:          testCase.Position,
+			Input:             testCase.Input,
+			ExpectedOutput:    testCase.ExpectedOutput,
+			Weight:            testCase.Weight,
+			IsHidden:          testCase.IsHidden,
+			Group:             testCase.Group,
+			TimestampMetadata: testCase.ProtoTimestampMetadata(),
+		}
+	}
+	return result
+}
+
+func formatTimeOrEmpty(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseOptionalID parses the id of an optional filter, empty is uuid.Nil
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+func formatIDOrEmpty(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
 	}
+	return id.String()
 }
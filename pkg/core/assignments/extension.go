@@ -0,0 +1,129 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Extension is the individual deadline of a student for an assignment,
+// it replaces the assignment deadline for the student, see Assignment.WithExtension
+type Extension struct {
+	ID           uuid.UUID
+	AssignmentID uuid.UUID
+	Student      Submitter
+	DeadlineAt   time.Time
+	Reason       string
+	GrantedBy    Assigner
+
+	core.TimestampMetadata
+}
+
+type GrantExtensionRequest struct {
+	NewID      uuid.UUID
+	Now        time.Time
+	Assignment Assignment
+	Student    Submitter
+	GrantedBy  Assigner
+	DeadlineAt time.Time
+	Reason     string
+}
+
+func GrantExtension(req GrantExtensionRequest) (Extension, error) {
+	if req.Assignment.DeletedAt.Valid {
+		return Extension{}, errors.New("assignment is deleted")
+	}
+
+	if !req.Student.Active {
+		return Extension{}, errors.New("student must active")
+	}
+
+	if !req.GrantedBy.Active {
+		return Extension{}, errors.New("granter must active")
+	}
+
+	if err := validateExtension(req.Assignment, req.DeadlineAt, req.Reason); err != nil {
+		return Extension{}, err
+	}
+
+	return Extension{
+		ID:                req.NewID,
+		AssignmentID:      req.Assignment.ID,
+		Student:           req.Student,
+		DeadlineAt:        req.DeadlineAt,
+		Reason:            strings.TrimSpace(req.Reason),
+		GrantedBy:         req.GrantedBy,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+type UpdateExtensionRequest struct {
+	Now        time.Time
+	Assignment Assignment
+	GrantedBy  Assigner
+	DeadlineAt time.Time
+	Reason     string
+}
+
+func (extension Extension) Update(req UpdateExtensionRequest) (Extension, error) {
+	if !req.GrantedBy.Active {
+		return Extension{}, errors.New("granter must active")
+	}
+
+	if err := validateExtension(req.Assignment, req.DeadlineAt, req.Reason); err != nil {
+		return Extension{}, err
+	}
+
+	extension.DeadlineAt = req.DeadlineAt
+	extension.Reason = strings.TrimSpace(req.Reason)
+	extension.GrantedBy = req.GrantedBy
+	extension.UpdatedAt = req.Now
+
+	return extension, nil
+}
+
+func (extension Extension) Delete(now time.Time) (Extension, error) {
+	extension.DeletedAt = null.TimeFrom(now)
+	return extension, nil
+}
+
+const maxReasonLength = 500
+
+func validateExtension(assignment Assignment, deadlineAt time.Time, reason string) error {
+	if !deadlineAt.After(assignment.DeadlineAt) {
+		return errors.New("extension deadline must be after the assignment deadline")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("reason is required")
+	}
+
+	if len(reason) > maxReasonLength {
+		return fmt.Errorf("reason must be at most %d characters", maxReasonLength)
+	}
+
+	return nil
+}
+
+// WithExtension resolves the assignment deadline of the extension student,
+// the hard deadline of the late policy is moved along, see grading.LatePolicy.Extend
+func (assignment Assignment) WithExtension(extension Extension) Assignment {
+	assignment.LatePolicy = assignment.LatePolicy.Extend(assignment.DeadlineAt, extension.DeadlineAt)
+	assignment.DeadlineAt = extension.DeadlineAt
+	return assignment
+}
+
+// forSubmitter resolves the assignment deadline of a submitter,
+// the assignment deadline is kept when the submitter has no extension
+func (assignment Assignment) forSubmitter(extension Extension, hasExtension bool) Assignment {
+	if !hasExtension {
+		return assignment
+	}
+	return assignment.WithExtension(extension)
+}
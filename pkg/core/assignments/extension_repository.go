@@ -0,0 +1,139 @@
+package assignments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ExtensionWriter struct{}
+
+func (ExtensionWriter) Create(ctx context.Context, tx *gorm.DB, extension Extension) error {
+	model := toExtensionModel(extension)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (ExtensionWriter) Update(ctx context.Context, tx *gorm.DB, extension Extension) error {
+	model := toExtensionModel(extension)
+
+	return tx.WithContext(ctx).Model(&dbmodel.AssignmentExtension{}).
+		Where("id = ?", extension.ID).
+		UpdateColumns(map[string]any{
+			"deadline_at": model.DeadlineAt,
+			"reason":      model.Reason,
+			"granted_by":  model.GrantedBy,
+			"updated_at":  model.UpdatedAt,
+			"deleted_at":  model.DeletedAt,
+		}).Error
+}
+
+type ExtensionReader struct{}
+
+func (ExtensionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Extension, error) {
+	model := dbmodel.AssignmentExtension{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return Extension{}, err
+	}
+
+	extensions, err := toExtensions(ctx, tx, []dbmodel.AssignmentExtension{model})
+	if err != nil {
+		return Extension{}, err
+	}
+
+	return extensions[0], nil
+}
+
+// FindByStudent finds the extension of the student, ok is false when there is none
+func (ExtensionReader) FindByStudent(ctx context.Context, tx *gorm.DB, assignmentID, studentID uuid.UUID) (
+	extension Extension, ok bool, err error,
+) {
+	model := dbmodel.AssignmentExtension{}
+	err = tx.WithContext(ctx).
+		Where("assignment_id = ? and student_id = ?", assignmentID, studentID).
+		Take(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Extension{}, false, nil
+	}
+	if err != nil {
+		return Extension{}, false, err
+	}
+
+	extensions, err := toExtensions(ctx, tx, []dbmodel.AssignmentExtension{model})
+	if err != nil {
+		return Extension{}, false, err
+	}
+
+	return extensions[0], true, nil
+}
+
+func (ExtensionReader) FindAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID) ([]Extension, error) {
+	models := []dbmodel.AssignmentExtension{}
+	err := tx.WithContext(ctx).
+		Where("assignment_id = ?", assignmentID).
+		Order("deadline_at asc").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toExtensions(ctx, tx, models)
+}
+
+// toExtensions finds the students & granters of the extensions
+func toExtensions(ctx context.Context, tx *gorm.DB, models []dbmodel.AssignmentExtension) ([]Extension, error) {
+	userIDs := make([]uuid.UUID, 0, 2*len(models))
+	for _, model := range models {
+		userIDs = append(userIDs, model.StudentID, model.GrantedBy)
+	}
+
+	users := []dbmodel.User{}
+	err := tx.WithContext(ctx).Where("id IN (?)", userIDs).Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("find users: %w", err)
+	}
+
+	userMap := make(map[uuid.UUID]dbmodel.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	extensions := make([]Extension, len(models))
+	for i, model := range models {
+		student := userMap[model.StudentID]
+		extensions[i] = Extension{
+			ID:           model.ID,
+			AssignmentID: model.AssignmentID,
+			Student: Submitter{
+				ID:     student.ID,
+				Name:   student.Name,
+				Active: student.Active == 1,
+			},
+			DeadlineAt:        model.DeadlineAt,
+			Reason:            model.Reason,
+			GrantedBy:         toAssigner(userMap[model.GrantedBy]),
+			TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+		}
+	}
+
+	return extensions, nil
+}
+
+func toExtensionModel(extension Extension) dbmodel.AssignmentExtension {
+	return dbmodel.AssignmentExtension{
+		Base: dbmodel.Base{
+			ID:       extension.ID,
+			Metadata: core.NewModelMetadata(extension.TimestampMetadata),
+		},
+		AssignmentID: extension.AssignmentID,
+		StudentID:    extension.Student.ID,
+		DeadlineAt:   extension.DeadlineAt,
+		Reason:       extension.Reason,
+		GrantedBy:    extension.GrantedBy.ID,
+	}
+}
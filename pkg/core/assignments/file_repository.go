@@ -20,6 +20,19 @@ func (FileReader) FindCaseFiles(ctx context.Context, tx *gorm.DB, ids []uuid.UUI
 	return toCaseFiles(files), nil
 }
 
+// FindHarnessFiles checks the files are uploaded as harness files
+func (FileReader) FindHarnessFiles(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) ([]CaseFile, error) {
+	var files []dbmodel.File
+	err := tx.WithContext(ctx).Table("files").
+		Where("id IN (?) AND type = ?", ids, dbmodel.FileTypeAssignmentHarness).
+		Find(&files).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toCaseFiles(files), nil
+}
+
 func toCaseFiles(files []dbmodel.File) []CaseFile {
 	var caseFiles []CaseFile
 	for _, file := range files {
@@ -0,0 +1,66 @@
+package assignments
+
+import (
+	"context"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type HarnessFileWriter struct{}
+
+// ReplaceAllByAssignmentID replaces the harness files of the assignment,
+// the files are only referenced by the assignment so the old rows are deleted
+func (HarnessFileWriter) ReplaceAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignment Assignment) error {
+	err := tx.WithContext(ctx).Unscoped().
+		Where("assignment_id = ?", assignment.ID).
+		Delete(&dbmodel.AssignmentHarnessFile{}).Error
+	if err != nil {
+		return err
+	}
+
+	if len(assignment.HarnessFiles) == 0 {
+		return nil
+	}
+
+	models := make([]dbmodel.AssignmentHarnessFile, len(assignment.HarnessFiles))
+	for i, file := range assignment.HarnessFiles {
+		models[i] = dbmodel.AssignmentHarnessFile{
+			Base: dbmodel.Base{
+				ID:       uuid.New(),
+				Metadata: core.NewModelMetadata(core.NewTimestampMeta(assignment.UpdatedAt)),
+			},
+			AssignmentID: assignment.ID,
+			FileID:       file.FileID,
+			Name:         file.Name,
+		}
+	}
+
+	return tx.WithContext(ctx).Create(&models).Error
+}
+
+type HarnessFileReader struct{}
+
+// FindAllByAssignmentIDs finds the harness files of the assignments keyed by the assignment id
+func (HarnessFileReader) FindAllByAssignmentIDs(ctx context.Context, tx *gorm.DB, assignmentIDs []uuid.UUID) (map[uuid.UUID][]HarnessFile, error) {
+	models := []dbmodel.AssignmentHarnessFile{}
+	err := tx.WithContext(ctx).
+		Where("assignment_id IN (?)", assignmentIDs).
+		Order("name asc").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[uuid.UUID][]HarnessFile{}
+	for _, model := range models {
+		files[model.AssignmentID] = append(files[model.AssignmentID], HarnessFile{
+			FileID: model.FileID,
+			Name:   model.Name,
+		})
+	}
+
+	return files, nil
+}
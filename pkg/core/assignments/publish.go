@@ -0,0 +1,97 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/matcornic/hermes/v2"
+)
+
+// Recipient is a student notified about the assignment
+type Recipient struct {
+	ID    uuid.UUID
+	Name  string
+	Email string
+}
+
+type PublishedEmail struct {
+	HTMLBody      string
+	PlainTextBody string
+	Subject       string
+	FromEmail     string
+	ToEmail       string
+}
+
+type CreatePublishedEmailRequest struct {
+	SenderEmail string
+	AppLink     string
+	LogoURL     string
+	Assignment  Assignment
+	Recipient   Recipient
+}
+
+// CreatePublishedEmail notifies a student about a newly published assignment
+func CreatePublishedEmail(req CreatePublishedEmailRequest) (PublishedEmail, error) {
+	if req.SenderEmail == "" {
+		return PublishedEmail{}, errors.New("invalid sender email")
+	}
+
+	if req.Recipient.Email == "" {
+		return PublishedEmail{}, errors.New("invalid recipient email")
+	}
+
+	hh := hermes.Hermes{
+		Product: hermes.Product{
+			Name: "Autograde",
+			Link: req.AppLink,
+			Logo: req.LogoURL,
+		},
+	}
+
+	emailBody := hermes.Email{
+		Body: hermes.Body{
+			Name: req.Recipient.Name,
+			Intros: []string{
+				fmt.Sprintf("A new assignment %q is published.", req.Assignment.Name),
+				fmt.Sprintf("The deadline is %s.", req.Assignment.DeadlineAt.Format("Monday, 02 January 2006 15:04 MST")),
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "Open the assignment here",
+					Button: hermes.Button{
+						Color: "#22BC66",
+						Text:  "Open Assignment",
+						Link:  createStudentAssignmentLink(req.AppLink, req.Assignment.ID),
+					},
+				},
+			},
+		},
+	}
+
+	htmlBody, err := hh.GenerateHTML(emailBody)
+	if err != nil {
+		return PublishedEmail{}, fmt.Errorf("generate html body: %w", err)
+	}
+
+	txtBody, err := hh.GeneratePlainText(emailBody)
+	if err != nil {
+		return PublishedEmail{}, fmt.Errorf("generate plain text body: %w", err)
+	}
+
+	return PublishedEmail{
+		Subject:       "New assignment: " + req.Assignment.Name,
+		FromEmail:     req.SenderEmail,
+		ToEmail:       req.Recipient.Email,
+		HTMLBody:      htmlBody,
+		PlainTextBody: txtBody,
+	}, nil
+}
+
+func createStudentAssignmentLink(webBaseURL string, assignmentID uuid.UUID) string {
+	urlVal := url.Values{}
+	urlVal.Add("id", assignmentID.String())
+
+	return webBaseURL + "/student-dashboard/assignments/detail?" + urlVal.Encode()
+}
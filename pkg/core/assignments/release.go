@@ -0,0 +1,21 @@
+package assignments
+
+import (
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+)
+
+// Release is when an assignment is visible to the students
+type Release struct {
+	Status dbmodel.AssignmentStatus
+	// PublishAt zero means the assignment is visible once it's published
+	PublishAt time.Time
+}
+
+func (release Release) IsPublished(now time.Time) bool {
+	if release.Status != dbmodel.AssignmentStatusPublished {
+		return false
+	}
+	return !release.PublishAt.After(now)
+}
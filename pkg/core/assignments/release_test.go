@@ -0,0 +1,32 @@
+package assignments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+)
+
+func TestReleaseIsPublished(t *testing.T) {
+	now := time.Date(2024, 9, 9, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		release     Release
+		isPublished bool
+	}{
+		{"draft", Release{Status: dbmodel.AssignmentStatusDraft}, false},
+		{"scheduled draft", Release{Status: dbmodel.AssignmentStatusDraft, PublishAt: now.Add(-time.Hour)}, false},
+		{"published", Release{Status: dbmodel.AssignmentStatusPublished}, true},
+		{"published at now", Release{Status: dbmodel.AssignmentStatusPublished, PublishAt: now}, true},
+		{"scheduled", Release{Status: dbmodel.AssignmentStatusPublished, PublishAt: now.Add(time.Second)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if isPublished := tt.release.IsPublished(now); isPublished != tt.isPublished {
+				t.Errorf("is published %v, want %v", isPublished, tt.isPublished)
+			}
+		})
+	}
+}
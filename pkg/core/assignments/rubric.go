@@ -0,0 +1,97 @@
+package assignments
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// RubricCriterion is scored manually by a grader, e.g. code style or complexity.
+// The rubric points are added to the automated grade, see grading.ManualGrade
+type RubricCriterion struct {
+	ID           uuid.UUID
+	AssignmentID uuid.UUID
+	Name         string
+	Description  string
+	MaxPoints    int32
+	Position     int32
+
+	core.TimestampMetadata
+}
+
+type CreateRubricCriterionRequest struct {
+	NewID       uuid.UUID
+	Now         time.Time
+	Assignment  Assignment
+	Name        string
+	Description string
+	MaxPoints   int32
+	Position    int32
+}
+
+func CreateRubricCriterion(req CreateRubricCriterionRequest) (RubricCriterion, error) {
+	if req.Assignment.DeletedAt.Valid {
+		return RubricCriterion{}, errors.New("assignment is deleted")
+	}
+
+	if err := validateRubricCriterion(req.Name, req.MaxPoints, req.Position); err != nil {
+		return RubricCriterion{}, err
+	}
+
+	return RubricCriterion{
+		ID:                req.NewID,
+		AssignmentID:      req.Assignment.ID,
+		Name:              strings.TrimSpace(req.Name),
+		Description:       strings.TrimSpace(req.Description),
+		MaxPoints:         req.MaxPoints,
+		Position:          req.Position,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+type UpdateRubricCriterionRequest struct {
+	Now         time.Time
+	Name        string
+	Description string
+	MaxPoints   int32
+	Position    int32
+}
+
+func (criterion RubricCriterion) Update(req UpdateRubricCriterionRequest) (RubricCriterion, error) {
+	if err := validateRubricCriterion(req.Name, req.MaxPoints, req.Position); err != nil {
+		return RubricCriterion{}, err
+	}
+
+	criterion.Name = strings.TrimSpace(req.Name)
+	criterion.Description = strings.TrimSpace(req.Description)
+	criterion.MaxPoints = req.MaxPoints
+	criterion.Position = req.Position
+	criterion.UpdatedAt = req.Now
+
+	return criterion, nil
+}
+
+func (criterion RubricCriterion) Delete(now time.Time) (RubricCriterion, error) {
+	criterion.DeletedAt = null.TimeFrom(now)
+	return criterion, nil
+}
+
+func validateRubricCriterion(name string, maxPoints, position int32) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("name is required")
+	}
+
+	if maxPoints < 1 {
+		return errors.New("max points must be at least 1")
+	}
+
+	if position < 0 {
+		return errors.New("position must not be negative")
+	}
+
+	return nil
+}
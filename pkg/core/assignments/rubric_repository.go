@@ -0,0 +1,88 @@
+package assignments
+
+import (
+	"context"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RubricCriterionWriter struct{}
+
+func (RubricCriterionWriter) Create(ctx context.Context, tx *gorm.DB, criterion RubricCriterion) error {
+	model := toRubricCriterionModel(criterion)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (RubricCriterionWriter) Update(ctx context.Context, tx *gorm.DB, criterion RubricCriterion) error {
+	model := toRubricCriterionModel(criterion)
+
+	return tx.WithContext(ctx).Model(&dbmodel.AssignmentRubricCriterion{}).
+		Where("id = ?", criterion.ID).
+		UpdateColumns(map[string]any{
+			"name":        model.Name,
+			"description": model.Description,
+			"max_points":  model.MaxPoints,
+			"position":    model.Position,
+			"updated_at":  model.UpdatedAt,
+			"deleted_at":  model.DeletedAt,
+		}).Error
+}
+
+type RubricCriterionReader struct{}
+
+func (RubricCriterionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (RubricCriterion, error) {
+	model := dbmodel.AssignmentRubricCriterion{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return RubricCriterion{}, err
+	}
+
+	return toRubricCriterion(model), nil
+}
+
+func (RubricCriterionReader) FindAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID) ([]RubricCriterion, error) {
+	models := []dbmodel.AssignmentRubricCriterion{}
+	err := tx.WithContext(ctx).
+		Where("assignment_id = ?", assignmentID).
+		Order("position asc, created_at asc").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := make([]RubricCriterion, len(models))
+	for i, model := range models {
+		criteria[i] = toRubricCriterion(model)
+	}
+
+	return criteria, nil
+}
+
+func toRubricCriterionModel(criterion RubricCriterion) dbmodel.AssignmentRubricCriterion {
+	return dbmodel.AssignmentRubricCriterion{
+		Base: dbmodel.Base{
+			ID:       criterion.ID,
+			Metadata: core.NewModelMetadata(criterion.TimestampMetadata),
+		},
+		AssignmentID: criterion.AssignmentID,
+		Name:         criterion.Name,
+		Description:  criterion.Description,
+		MaxPoints:    criterion.MaxPoints,
+		Position:     criterion.Position,
+	}
+}
+
+func toRubricCriterion(model dbmodel.AssignmentRubricCriterion) RubricCriterion {
+	return RubricCriterion{
+		ID:                model.ID,
+		AssignmentID:      model.AssignmentID,
+		Name:              model.Name,
+		Description:       model.Description,
+		MaxPoints:         model.MaxPoints,
+		Position:          model.Position,
+		TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+	}
+}
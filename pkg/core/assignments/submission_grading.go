@@ -0,0 +1,183 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/google/uuid"
+)
+
+// RubricScore is the points a grader gives a submission for a rubric criterion
+type RubricScore struct {
+	CriterionID uuid.UUID
+	Points      int32
+	Comment     string
+	GradedBy    Assigner
+	GradedAt    time.Time
+}
+
+// GradeAudit is appended on every manual grading of a submission
+type GradeAudit struct {
+	ID               uuid.UUID
+	SubmissionID     uuid.UUID
+	GradedBy         Assigner
+	PreviousGrade    int32
+	PreviousMaxScore int32
+	Grade            int32
+	MaxScore         int32
+	AutoGrade        int32
+	RubricPoints     int32
+	IsOverridden     bool
+	Reason           string
+
+	core.TimestampMetadata
+}
+
+// RubricPoints is the points given for a rubric criterion in a manual grading
+type RubricPoints struct {
+	CriterionID uuid.UUID
+	Points      int32
+	Comment     string
+}
+
+type GradeSubmissionRequest struct {
+	NewAuditID uuid.UUID
+	Now        time.Time
+	Grader     Assigner
+	// Criteria are the rubric criteria of the assignment
+	Criteria []RubricCriterion
+	// Scores are the current rubric scores of the submission
+	Scores []RubricScore
+	// RubricPoints replace the scores of their criteria, the other scores are kept
+	RubricPoints  []RubricPoints
+	IsOverridden  bool
+	OverrideGrade int32
+	// Reason is required to override the grade
+	Reason   string
+	Feedback string
+}
+
+type GradedSubmission struct {
+	Submission Submission
+	// Scores replace the rubric scores of the submission
+	Scores []RubricScore
+	Audit  GradeAudit
+}
+
+// GradeManually grades the submission, the rubric points are added to the automated grade
+// and an override replaces both, see grading.ManualGrade
+func (submission Submission) GradeManually(req GradeSubmissionRequest) (GradedSubmission, error) {
+	criterionByID := make(map[uuid.UUID]RubricCriterion, len(req.Criteria))
+	rubricMaxPoints := int32(0)
+	for _, criterion := range req.Criteria {
+		criterionByID[criterion.ID] = criterion
+		rubricMaxPoints += criterion.MaxPoints
+	}
+
+	scoreByCriterionID := make(map[uuid.UUID]RubricScore, len(req.Scores))
+	for _, score := range req.Scores {
+		scoreByCriterionID[score.CriterionID] = score
+	}
+
+	gradedCriterionIDs := make(map[uuid.UUID]bool, len(req.RubricPoints))
+	for _, points := range req.RubricPoints {
+		criterion, ok := criterionByID[points.CriterionID]
+		if !ok {
+			return GradedSubmission{}, fmt.Errorf("rubric criterion %s not found", points.CriterionID)
+		}
+
+		if gradedCriterionIDs[points.CriterionID] {
+			return GradedSubmission{}, fmt.Errorf("rubric criterion %q is graded twice", criterion.Name)
+		}
+		gradedCriterionIDs[points.CriterionID] = true
+
+		if points.Points < 0 || points.Points > criterion.MaxPoints {
+			return GradedSubmission{}, fmt.Errorf("points of %q must be between 0 and %d", criterion.Name, criterion.MaxPoints)
+		}
+
+		scoreByCriterionID[points.CriterionID] = RubricScore{
+			CriterionID: points.CriterionID,
+			Points:      points.Points,
+			Comment:     strings.TrimSpace(points.Comment),
+			GradedBy:    req.Grader,
+			GradedAt:    req.Now,
+		}
+	}
+
+	// the scores of the deleted criteria are dropped
+	scores := []RubricScore{}
+	rubricPoints := int32(0)
+	for _, criterion := range req.Criteria {
+		score, ok := scoreByCriterionID[criterion.ID]
+		if !ok {
+			continue
+		}
+		scores = append(scores, score)
+		rubricPoints += score.Points
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	manual := grading.ManualGrade{
+		RubricPoints:    rubricPoints,
+		RubricMaxPoints: rubricMaxPoints,
+		IsOverridden:    req.IsOverridden,
+	}
+
+	grade, maxScore := manual.Combine(submission.AutoGrade, submission.autoMaxScore())
+	if req.IsOverridden {
+		if reason == "" {
+			return GradedSubmission{}, errors.New("reason is required to override the grade")
+		}
+
+		if req.OverrideGrade < 0 || req.OverrideGrade > maxScore {
+			return GradedSubmission{}, fmt.Errorf("override grade must be between 0 and %d", maxScore)
+		}
+
+		manual.OverrideGrade = req.OverrideGrade
+		manual.OverrideReason = reason
+		grade = req.OverrideGrade
+	}
+
+	audit := GradeAudit{
+		ID:                req.NewAuditID,
+		SubmissionID:      submission.ID,
+		GradedBy:          req.Grader,
+		PreviousGrade:     submission.Grade,
+		PreviousMaxScore:  submission.MaxScore,
+		Grade:             grade,
+		MaxScore:          maxScore,
+		AutoGrade:         submission.AutoGrade,
+		RubricPoints:      rubricPoints,
+		IsOverridden:      req.IsOverridden,
+		Reason:            reason,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}
+
+	submission.Manual = manual
+	submission.Grade = grade
+	submission.MaxScore = maxScore
+	submission.Feedback = strings.TrimSpace(req.Feedback)
+	submission.UpdatedAt = req.Now
+
+	return GradedSubmission{
+		Submission: submission,
+		Scores:     scores,
+		Audit:      audit,
+	}, nil
+}
+
+// autoMaxScore is the max score of the automated grade,
+// the assignment max score when the submission is not graded yet
+func (submission Submission) autoMaxScore() int32 {
+	if submission.AutoMaxScore > 0 {
+		return submission.AutoMaxScore
+	}
+	if submission.Assignment.MaxScore > 0 {
+		return submission.Assignment.MaxScore
+	}
+	return grading.DefaultMaxScore
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -37,6 +39,12 @@ func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 		return Submission{}, fmt.Errorf("find source file: %w", err)
 	}
 
+	attemptModel := dbmodel.SubmissionAttempt{}
+	err = tx.WithContext(ctx).Where("id = ?", subModel.CurrentAttemptID).Take(&attemptModel).Error
+	if err != nil {
+		return Submission{}, fmt.Errorf("find current attempt: %w", err)
+	}
+
 	return Submission{
 		ID:         subModel.ID,
 		Assignment: assignment,
@@ -49,41 +57,275 @@ func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 			ID:  sourceFileModel.ID,
 			URL: sourceFileModel.URL,
 		},
+		Grade:        subModel.Grade,
+		MaxScore:     subModel.MaxScore,
+		AutoGrade:    subModel.AutoGrade,
+		AutoMaxScore: subModel.AutoMaxScore,
+		Manual:       toManualGrade(subModel),
+		Feedback:     subModel.Feedback,
+		CurrentAttempt: SubmissionAttempt{
+			ID:        attemptModel.ID,
+			Number:    attemptModel.AttemptNumber,
+			CreatedAt: attemptModel.CreatedAt.Time,
+		},
+		TimestampMetadata: core.TimestampMetaFromModel(subModel.Metadata),
 	}, nil
 }
 
+// FindAllRegradeAttempts finds the current attempt of every submission of the assignment,
+// with withGraded every graded attempt is found too
+func (SubmissionReader) FindAllRegradeAttempts(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID, withGraded bool) ([]RegradeAttempt, error) {
+	tx = tx.WithContext(ctx)
+
+	submissionIDs := tx.Model(&dbmodel.Submission{}).Select("id").Where("assignment_id = ?", assignmentID)
+	currentAttemptIDs := tx.Model(&dbmodel.Submission{}).Select("current_attempt_id").Where("assignment_id = ?", assignmentID)
+
+	attemptScope := tx.Where("id IN (?)", currentAttemptIDs)
+	if withGraded {
+		attemptScope = attemptScope.Or("submission_id IN (?) AND is_graded = 1", submissionIDs)
+	}
+
+	models := []dbmodel.SubmissionAttempt{}
+	err := tx.Select("id", "submission_id").Where(attemptScope).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(models, func(model dbmodel.SubmissionAttempt, _ int) RegradeAttempt {
+		return RegradeAttempt{
+			SubmissionID: model.SubmissionID,
+			AttemptID:    model.ID,
+		}
+	}), nil
+}
+
+func toManualGrade(model dbmodel.Submission) grading.ManualGrade {
+	return grading.ManualGrade{
+		RubricPoints:    model.RubricPoints,
+		RubricMaxPoints: model.RubricMaxPoints,
+		IsOverridden:    model.IsOverridden == 1,
+		OverrideGrade:   model.OverrideGrade,
+		OverrideReason:  model.OverrideReason,
+	}
+}
+
 type SubmissionWriter struct{}
 
 func (SubmissionWriter) SaveNew(ctx context.Context, tx *gorm.DB, submission *Submission) error {
-	model := dbmodel.Submission{
+	model := toSubmissionModel(submission)
+	if err := tx.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	return createAttempt(ctx, tx, submission)
+}
+
+// Save saves the submission and appends its new current attempt
+func (SubmissionWriter) Save(ctx context.Context, tx *gorm.DB, submission *Submission) error {
+	model := toSubmissionModel(submission)
+	if err := tx.WithContext(ctx).Save(model).Error; err != nil {
+		return err
+	}
+
+	return createAttempt(ctx, tx, submission)
+}
+
+func toSubmissionModel(submission *Submission) dbmodel.Submission {
+	return dbmodel.Submission{
 		Base: dbmodel.Base{
 			ID:       submission.ID,
 			Metadata: core.NewModelMetadata(submission.TimestampMetadata),
 		},
-		AssignmentID: submission.Assignment.ID,
-		FileID:       submission.SourceFile.ID,
-		SubmittedBy:  submission.Submitter.ID,
-		Grade:        submission.Grade,
-		Feedback:     submission.Feedback,
+		AssignmentID:     submission.Assignment.ID,
+		FileID:           submission.SourceFile.ID,
+		SubmittedBy:      submission.Submitter.ID,
+		Grade:            submission.Grade,
+		MaxScore:         submission.MaxScore,
+		AutoGrade:        submission.AutoGrade,
+		AutoMaxScore:     submission.AutoMaxScore,
+		RubricPoints:     submission.Manual.RubricPoints,
+		RubricMaxPoints:  submission.Manual.RubricMaxPoints,
+		IsOverridden:     intBool(submission.Manual.IsOverridden),
+		OverrideGrade:    submission.Manual.OverrideGrade,
+		OverrideReason:   submission.Manual.OverrideReason,
+		Feedback:         submission.Feedback,
+		CurrentAttemptID: submission.CurrentAttempt.ID,
+		ScoredAttemptID:  submission.CurrentAttempt.ID,
 	}
-	return tx.WithContext(ctx).Create(model).Error
 }
 
-func (SubmissionWriter) Save(ctx context.Context, tx *gorm.DB, submission *Submission) error {
-	model := dbmodel.Submission{
+// SaveGrading saves the manual grade of the submission,
+// its rubric scores are replaced & the audit is appended
+func (SubmissionWriter) SaveGrading(ctx context.Context, tx *gorm.DB, graded GradedSubmission) error {
+	tx = tx.WithContext(ctx)
+	submission := graded.Submission
+
+	err := tx.Model(&dbmodel.Submission{}).
+		Where("id = ?", submission.ID).
+		UpdateColumns(map[string]any{
+			"grade":             submission.Grade,
+			"max_score":         submission.MaxScore,
+			"rubric_points":     submission.Manual.RubricPoints,
+			"rubric_max_points": submission.Manual.RubricMaxPoints,
+			"is_overridden":     intBool(submission.Manual.IsOverridden),
+			"override_grade":    submission.Manual.OverrideGrade,
+			"override_reason":   submission.Manual.OverrideReason,
+			"feedback":          submission.Feedback,
+			"updated_at":        submission.UpdatedAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("update submission: %w", err)
+	}
+
+	err = tx.Unscoped().Where("submission_id = ?", submission.ID).Delete(&dbmodel.SubmissionRubricScore{}).Error
+	if err != nil {
+		return fmt.Errorf("delete rubric scores: %w", err)
+	}
+
+	if len(graded.Scores) > 0 {
+		scoreModels := lo.Map(graded.Scores, func(score RubricScore, _ int) dbmodel.SubmissionRubricScore {
+			return dbmodel.SubmissionRubricScore{
+				Base: dbmodel.Base{
+					ID:       uuid.New(),
+					Metadata: core.NewModelMetadata(core.NewTimestampMeta(score.GradedAt)),
+				},
+				SubmissionID: submission.ID,
+				CriterionID:  score.CriterionID,
+				Points:       score.Points,
+				Comment:      score.Comment,
+				GradedBy:     score.GradedBy.ID,
+			}
+		})
+		if err = tx.Create(&scoreModels).Error; err != nil {
+			return fmt.Errorf("create rubric scores: %w", err)
+		}
+	}
+
+	audit := graded.Audit
+	err = tx.Create(&dbmodel.SubmissionGradeAudit{
 		Base: dbmodel.Base{
-			ID:       submission.ID,
-			Metadata: core.NewModelMetadata(submission.TimestampMetadata),
+			ID:       audit.ID,
+			Metadata: core.NewModelMetadata(audit.TimestampMetadata),
 		},
-		AssignmentID: submission.Assignment.ID,
-		FileID:       submission.SourceFile.ID,
-		SubmittedBy:  submission.Submitter.ID,
-		Grade:        submission.Grade,
-		Feedback:     submission.Feedback,
+		SubmissionID:     audit.SubmissionID,
+		GradedBy:         audit.GradedBy.ID,
+		PreviousGrade:    audit.PreviousGrade,
+		PreviousMaxScore: audit.PreviousMaxScore,
+		Grade:            audit.Grade,
+		MaxScore:         audit.MaxScore,
+		AutoGrade:        audit.AutoGrade,
+		RubricPoints:     audit.RubricPoints,
+		IsOverridden:     intBool(audit.IsOverridden),
+		Reason:           audit.Reason,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("create grade audit: %w", err)
 	}
-	return tx.WithContext(ctx).Save(model).Error
+
+	return nil
+}
+
+func createAttempt(ctx context.Context, tx *gorm.DB, submission *Submission) error {
+	return tx.WithContext(ctx).Create(&dbmodel.SubmissionAttempt{
+		Base: dbmodel.Base{
+			ID: submission.CurrentAttempt.ID,
+			// the attempt is created when the submission is last updated
+			Metadata: core.NewModelMetadata(core.NewTimestampMeta(submission.UpdatedAt)),
+		},
+		SubmissionID:  submission.ID,
+		AttemptNumber: submission.CurrentAttempt.Number,
+		FileID:        submission.SourceFile.ID,
+	}).Error
 }
 
 func (SubmissionWriter) Delete(ctx context.Context, tx *gorm.DB, submission *Submission) error {
 	return tx.WithContext(ctx).UpdateColumn("deleted_at", submission.DeletedAt).Error
 }
+
+type RubricScoreReader struct{}
+
+func (RubricScoreReader) FindAllBySubmissionID(ctx context.Context, tx *gorm.DB, submissionID uuid.UUID) ([]RubricScore, error) {
+	models := []dbmodel.SubmissionRubricScore{}
+	err := tx.WithContext(ctx).Where("submission_id = ?", submissionID).Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("find rubric scores: %w", err)
+	}
+
+	graders, err := findGraders(ctx, tx, lo.Map(models, func(model dbmodel.SubmissionRubricScore, _ int) uuid.UUID {
+		return model.GradedBy
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(models, func(model dbmodel.SubmissionRubricScore, _ int) RubricScore {
+		return RubricScore{
+			CriterionID: model.CriterionID,
+			Points:      model.Points,
+			Comment:     model.Comment,
+			GradedBy:    graders[model.GradedBy],
+			GradedAt:    model.CreatedAt.Time,
+		}
+	}), nil
+}
+
+type GradeAuditReader struct{}
+
+// FindAllBySubmissionID finds the grade audits of the submission, the latest first
+func (GradeAuditReader) FindAllBySubmissionID(ctx context.Context, tx *gorm.DB, submissionID uuid.UUID) ([]GradeAudit, error) {
+	models := []dbmodel.SubmissionGradeAudit{}
+	err := tx.WithContext(ctx).
+		Where("submission_id = ?", submissionID).
+		Order("created_at desc").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("find grade audits: %w", err)
+	}
+
+	graders, err := findGraders(ctx, tx, lo.Map(models, func(model dbmodel.SubmissionGradeAudit, _ int) uuid.UUID {
+		return model.GradedBy
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(models, func(model dbmodel.SubmissionGradeAudit, _ int) GradeAudit {
+		return GradeAudit{
+			ID:                model.ID,
+			SubmissionID:      model.SubmissionID,
+			GradedBy:          graders[model.GradedBy],
+			PreviousGrade:     model.PreviousGrade,
+			PreviousMaxScore:  model.PreviousMaxScore,
+			Grade:             model.Grade,
+			MaxScore:          model.MaxScore,
+			AutoGrade:         model.AutoGrade,
+			RubricPoints:      model.RubricPoints,
+			IsOverridden:      model.IsOverridden == 1,
+			Reason:            model.Reason,
+			TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+		}
+	}), nil
+}
+
+// findGraders finds the graders by id, a removed grader is kept in the audit trail
+func findGraders(ctx context.Context, tx *gorm.DB, ids []uuid.UUID) (map[uuid.UUID]Assigner, error) {
+	users := []dbmodel.User{}
+	err := tx.WithContext(ctx).Unscoped().Where("id IN ?", lo.Uniq(ids)).Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("find graders: %w", err)
+	}
+
+	graders := make(map[uuid.UUID]Assigner, len(users))
+	for _, user := range users {
+		graders[user.ID] = toAssigner(user)
+	}
+
+	return graders, nil
+}
+
+func intBool(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -3,6 +3,7 @@ package assignments
 import (
 	"context"
 
+	"github.com/fahmifan/autograd/pkg/core/auth"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -19,3 +20,57 @@ func (SubmitterReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 		Active: user.Active == 1,
 	}, err
 }
+
+// IsEnrolled checks the submitter is enrolled as a student in the course,
+// every submitter is enrolled when the course id is uuid.Nil
+func (SubmitterReader) IsEnrolled(ctx context.Context, tx *gorm.DB, courseID, submitterID uuid.UUID) (bool, error) {
+	if courseID == uuid.Nil {
+		return true, nil
+	}
+
+	count := int64(0)
+	err := tx.WithContext(ctx).Model(&dbmodel.CourseEnrollment{}).
+		Where("course_id = ? and user_id = ? and role = ?", courseID, submitterID, dbmodel.EnrollmentRoleStudent).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+type RecipientReader struct{}
+
+// FindAllActiveStudents finds the students enrolled in the course,
+// every student is found when the course id is uuid.Nil
+func (RecipientReader) FindAllActiveStudents(ctx context.Context, tx *gorm.DB, courseID uuid.UUID) ([]Recipient, error) {
+	courseScope := func(db *gorm.DB) *gorm.DB {
+		if courseID == uuid.Nil {
+			return db
+		}
+		return db.Where("id IN (?)", tx.Model(&dbmodel.CourseEnrollment{}).
+			Select("user_id").
+			Where("course_id = ? and role = ?", courseID, dbmodel.EnrollmentRoleStudent),
+		)
+	}
+
+	users := []dbmodel.User{}
+	err := tx.WithContext(ctx).
+		Where("role = ? and active = 1", auth.RoleStudent).
+		Scopes(courseScope).
+		Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]Recipient, len(users))
+	for i, user := range users {
+		recipients[i] = Recipient{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+		}
+	}
+
+	return recipients, nil
+}
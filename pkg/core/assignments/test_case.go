@@ -0,0 +1,125 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// DefaultTestCaseWeight is the weight of a test case that doesn't set one
+const DefaultTestCaseWeight int32 = 1
+
+// TestCase is a single stdin & expected stdout pair of an assignment.
+// Test cases are graded in ascending Position order.
+type TestCase struct {
+	ID             uuid.UUID
+	AssignmentID   uuid.UUID
+	Name           string
+	Position       int32
+	Input          string
+	ExpectedOutput string
+	// Weight zero means the case is not scored, e.g. a sample case
+	Weight   int32
+	IsHidden bool
+	// Group is the subtask of the case, the cases of a group
+	// are scored all or nothing, see grading.ScoreCases
+	Group string
+
+	core.TimestampMetadata
+}
+
+type CreateTestCaseRequest struct {
+	NewID          uuid.UUID
+	Now            time.Time
+	Assignment     Assignment
+	Name           string
+	Position       int32
+	Input          string
+	ExpectedOutput string
+	Weight         int32
+	IsHidden       bool
+	Group          string
+}
+
+func CreateTestCase(req CreateTestCaseRequest) (TestCase, error) {
+	if req.Assignment.DeletedAt.Valid {
+		return TestCase{}, errors.New("assignment is deleted")
+	}
+
+	if err := validateTestCase(req.Name, req.Position, req.Weight, req.Group); err != nil {
+		return TestCase{}, err
+	}
+
+	return TestCase{
+		ID:                req.NewID,
+		AssignmentID:      req.Assignment.ID,
+		Name:              req.Name,
+		Position:          req.Position,
+		Input:             req.Input,
+		ExpectedOutput:    req.ExpectedOutput,
+		Weight:            req.Weight,
+		IsHidden:          req.IsHidden,
+		Group:             strings.TrimSpace(req.Group),
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+type UpdateTestCaseRequest struct {
+	Now            time.Time
+	Name           string
+	Position       int32
+	Input          string
+	ExpectedOutput string
+	Weight         int32
+	IsHidden       bool
+	Group          string
+}
+
+func (testCase TestCase) Update(req UpdateTestCaseRequest) (TestCase, error) {
+	if err := validateTestCase(req.Name, req.Position, req.Weight, req.Group); err != nil {
+		return TestCase{}, err
+	}
+
+	testCase.Name = req.Name
+	testCase.Position = req.Position
+	testCase.Input = req.Input
+	testCase.ExpectedOutput = req.ExpectedOutput
+	testCase.Weight = req.Weight
+	testCase.IsHidden = req.IsHidden
+	testCase.Group = strings.TrimSpace(req.Group)
+	testCase.UpdatedAt = req.Now
+
+	return testCase, nil
+}
+
+func (testCase TestCase) Delete(now time.Time) (TestCase, error) {
+	testCase.DeletedAt = null.TimeFrom(now)
+	return testCase, nil
+}
+
+const maxGroupLength = 64
+
+func validateTestCase(name string, position, weight int32, group string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("name is required")
+	}
+
+	if position < 0 {
+		return errors.New("position must not be negative")
+	}
+
+	if weight < 0 {
+		return errors.New("weight must not be negative")
+	}
+
+	if len(strings.TrimSpace(group)) > maxGroupLength {
+		return fmt.Errorf("group must be at most %d characters", maxGroupLength)
+	}
+
+	return nil
+}
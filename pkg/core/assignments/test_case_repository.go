@@ -0,0 +1,102 @@
+package assignments
+
+import (
+	"context"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TestCaseWriter struct{}
+
+func (TestCaseWriter) Create(ctx context.Context, tx *gorm.DB, testCase TestCase) error {
+	model := toTestCaseModel(testCase)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (TestCaseWriter) Update(ctx context.Context, tx *gorm.DB, testCase TestCase) error {
+	model := toTestCaseModel(testCase)
+
+	return tx.WithContext(ctx).Model(&dbmodel.AssignmentTestCase{}).
+		Where("id = ?", testCase.ID).
+		UpdateColumns(map[string]any{
+			"name":            model.Name,
+			"position":        model.Position,
+			"input":           model.Input,
+			"expected_output": model.ExpectedOutput,
+			"weight":          model.Weight,
+			"is_hidden":       model.IsHidden,
+			"group_name":      model.GroupName,
+			"updated_at":      model.UpdatedAt,
+			"deleted_at":      model.DeletedAt,
+		}).Error
+}
+
+type TestCaseReader struct{}
+
+func (TestCaseReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (TestCase, error) {
+	model := dbmodel.AssignmentTestCase{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return TestCase{}, err
+	}
+
+	return toTestCase(model), nil
+}
+
+func (TestCaseReader) FindAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID) ([]TestCase, error) {
+	models := []dbmodel.AssignmentTestCase{}
+	err := tx.WithContext(ctx).
+		Where("assignment_id = ?", assignmentID).
+		Order("position asc, created_at asc").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	testCases := make([]TestCase, len(models))
+	for i, model := range models {
+		testCases[i] = toTestCase(model)
+	}
+
+	return testCases, nil
+}
+
+func toTestCaseModel(testCase TestCase) dbmodel.AssignmentTestCase {
+	isHidden := 0
+	if testCase.IsHidden {
+		isHidden = 1
+	}
+
+	return dbmodel.AssignmentTestCase{
+		Base: dbmodel.Base{
+			ID:       testCase.ID,
+			Metadata: core.NewModelMetadata(testCase.TimestampMetadata),
+		},
+		AssignmentID:   testCase.AssignmentID,
+		Name:           testCase.Name,
+		Position:       testCase.Position,
+		Input:          testCase.Input,
+		ExpectedOutput: testCase.ExpectedOutput,
+		Weight:         testCase.Weight,
+		IsHidden:       isHidden,
+		GroupName:      testCase.Group,
+	}
+}
+
+func toTestCase(model dbmodel.AssignmentTestCase) TestCase {
+	return TestCase{
+		ID:                model.ID,
+		AssignmentID:      model.AssignmentID,
+		Name:              model.Name,
+		Position:          model.Position,
+		Input:             model.Input,
+		ExpectedOutput:    model.ExpectedOutput,
+		Weight:            model.Weight,
+		IsHidden:          model.IsHidden == 1,
+		Group:             model.GroupName,
+		TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -24,3 +25,60 @@ func (AuthReader) FindUserByEmail(ctx context.Context, tx *gorm.DB, email string
 	}
 	return authUser, CipherPassword(userModel.Password), nil
 }
+
+type CourseRoleReader struct{}
+
+// FindByCourse finds the role of the user in the course,
+// empty role when the user is not assigned to the course
+func (CourseRoleReader) FindByCourse(ctx context.Context, tx *gorm.DB, userID, courseID uuid.UUID) (Role, error) {
+	if courseID == uuid.Nil {
+		return "", nil
+	}
+
+	enrollments := []dbmodel.CourseEnrollment{}
+	err := tx.WithContext(ctx).
+		Where("course_id = ? and user_id = ?", courseID, userID).
+		Limit(1).
+		Find(&enrollments).Error
+	if err != nil {
+		return "", err
+	}
+
+	if len(enrollments) == 0 {
+		return "", nil
+	}
+
+	return Role(enrollments[0].Role), nil
+}
+
+func (CourseRoleReader) FindAll(ctx context.Context, tx *gorm.DB, userID uuid.UUID) (CourseRoles, error) {
+	enrollments := []dbmodel.CourseEnrollment{}
+	err := tx.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&enrollments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(CourseRoles, len(enrollments))
+	for _, enrollment := range enrollments {
+		roles[enrollment.CourseID] = Role(enrollment.Role)
+	}
+
+	return roles, nil
+}
+
+// Authorize checks the user is granted with the permissions on a resource owned by the course,
+// uuid.Nil course is a resource without a course
+func (reader CourseRoleReader) Authorize(ctx context.Context, tx *gorm.DB, user AuthUser, courseID uuid.UUID, perms ...Permission) (bool, error) {
+	if user.Role.Can(perms...) {
+		return true, nil
+	}
+
+	courseRole, err := reader.FindByCourse(ctx, tx, user.UserID, courseID)
+	if err != nil {
+		return false, err
+	}
+
+	return user.Role.CanInCourse(courseRole, perms...), nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
 
@@ -20,11 +21,17 @@ func ValidRole(role Role) bool {
 const (
 	RoleAdmin   = Role("admin")
 	RoleStudent = Role("student")
+	// RoleInstructor & RoleTA are only granted the course permissions
+	// in the courses they're assigned to
+	RoleInstructor = Role("instructor")
+	RoleTA         = Role("ta")
 )
 
 var _validRoles = []Role{
 	RoleAdmin,
 	RoleStudent,
+	RoleInstructor,
+	RoleTA,
 }
 
 const _ok = true
@@ -39,6 +46,8 @@ const (
 	DeleteAssignment
 	GradeAssignment
 	ViewAnySubmissions
+	// RegradeAssignment regrades every submission of an assignment
+	RegradeAssignment
 
 	CreateSubmission
 	CreateSubmissionForOther
@@ -53,6 +62,12 @@ const (
 	CreateUser
 
 	CreateMedia
+
+	// RunCode runs a program against a custom input
+	RunCode
+
+	ManageCourses
+	ViewAnyCourses
 )
 
 var policy = map[Role]map[Permission]bool{
@@ -64,12 +79,16 @@ var policy = map[Role]map[Permission]bool{
 		ViewAnyAssignments:       _ok,
 		DeleteAssignment:         _ok,
 		GradeAssignment:          _ok,
+		RegradeAssignment:        _ok,
 		CreateSubmission:         _ok,
 		ViewSubmission:           _ok,
 		ViewAnySubmissions:       _ok,
 		CreateAnyUser:            _ok,
 		CreateSubmissionForOther: _ok,
 		CreateMedia:              _ok,
+		RunCode:                  _ok,
+		ManageCourses:            _ok,
+		ViewAnyCourses:           _ok,
 	},
 	RoleStudent: {
 		ViewAssignment:   _ok,
@@ -78,6 +97,39 @@ var policy = map[Role]map[Permission]bool{
 		UpdateUser:       _ok,
 		CreateMedia:      _ok,
 		CreateSubmission: _ok,
+		RunCode:          _ok,
+	},
+	RoleInstructor: {
+		UpdateUser:  _ok,
+		CreateMedia: _ok,
+		RunCode:     _ok,
+	},
+	RoleTA: {
+		UpdateUser:  _ok,
+		CreateMedia: _ok,
+		RunCode:     _ok,
+	},
+}
+
+// coursePolicy is the policy of a role in a course,
+// applied to the resources owned by the course
+var coursePolicy = map[Role]map[Permission]bool{
+	RoleInstructor: {
+		CreateAssignment:   _ok,
+		UpdateAssignment:   _ok,
+		ViewAssignment:     _ok,
+		ViewAnyAssignments: _ok,
+		DeleteAssignment:   _ok,
+		GradeAssignment:    _ok,
+		ViewAnySubmissions: _ok,
+		ViewAnyCourses:     _ok,
+	},
+	RoleTA: {
+		ViewAssignment:     _ok,
+		ViewAnyAssignments: _ok,
+		GradeAssignment:    _ok,
+		ViewAnySubmissions: _ok,
+		ViewAnyCourses:     _ok,
 	},
 }
 
@@ -100,3 +152,42 @@ func (r Role) Can(perms ...Permission) bool {
 
 	return true
 }
+
+// CanInCourse check if role is granted with the permissions on a resource owned by a course,
+// courseRole is the role of the user in the course. A permission granted globally
+// is granted in every course. The course role is never above the role,
+// e.g. a student assigned as an instructor is still only a student.
+func (r Role) CanInCourse(courseRole Role, perms ...Permission) bool {
+	for _, perm := range perms {
+		if r.Granted(perm) {
+			continue
+		}
+
+		if !coursePolicy[courseRole][perm] || !coursePolicy[r][perm] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CanInAnyCourse check if role might be granted with the permissions in a course,
+// used to reject early before the course of the resource is known.
+func (r Role) CanInAnyCourse(perms ...Permission) bool {
+	return r.CanInCourse(r, perms...)
+}
+
+// CourseRoles is the role of a user in each course it's assigned to
+type CourseRoles map[uuid.UUID]Role
+
+// CourseIDs returns the courses the role is granted with the permissions in
+func (roles CourseRoles) CourseIDs(r Role, perms ...Permission) []uuid.UUID {
+	courseIDs := []uuid.UUID{}
+	for courseID, courseRole := range roles {
+		if r.CanInCourse(courseRole, perms...) {
+			courseIDs = append(courseIDs, courseID)
+		}
+	}
+
+	return courseIDs
+}
@@ -0,0 +1,51 @@
+package auth
+
+import "testing"
+
+func TestRoleCanInCourse(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       Role
+		courseRole Role
+		perm       Permission
+		granted    bool
+	}{
+		{"admin without course", RoleAdmin, "", DeleteAssignment, true},
+		{"instructor without course", RoleInstructor, "", CreateAssignment, false},
+		{"instructor in course", RoleInstructor, RoleInstructor, DeleteAssignment, true},
+		{"instructor as ta", RoleInstructor, RoleTA, DeleteAssignment, false},
+		{"ta grades", RoleTA, RoleTA, GradeAssignment, true},
+		{"ta views submissions", RoleTA, RoleTA, ViewAnySubmissions, true},
+		{"ta deletes assignment", RoleTA, RoleTA, DeleteAssignment, false},
+		{"student in course", RoleStudent, RoleStudent, ViewAnySubmissions, false},
+		{"student as instructor", RoleStudent, RoleInstructor, ViewAnySubmissions, false},
+		{"global permission", RoleTA, "", RunCode, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if granted := tt.role.CanInCourse(tt.courseRole, tt.perm); granted != tt.granted {
+				t.Errorf("granted %v, want %v", granted, tt.granted)
+			}
+		})
+	}
+}
+
+func TestCourseRolesCourseIDs(t *testing.T) {
+	roles := CourseRoles{
+		[16]byte{1}: RoleInstructor,
+		[16]byte{2}: RoleTA,
+	}
+
+	if courseIDs := roles.CourseIDs(RoleInstructor, DeleteAssignment); len(courseIDs) != 1 || courseIDs[0] != [16]byte{1} {
+		t.Errorf("course ids %v, want only the instructor course", courseIDs)
+	}
+
+	if courseIDs := roles.CourseIDs(RoleInstructor, ViewAnySubmissions); len(courseIDs) != 2 {
+		t.Errorf("course ids %v, want both courses", courseIDs)
+	}
+
+	if courseIDs := roles.CourseIDs(RoleStudent, ViewAnySubmissions); len(courseIDs) != 0 {
+		t.Errorf("course ids %v, want none", courseIDs)
+	}
+}
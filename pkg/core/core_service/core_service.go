@@ -10,8 +10,12 @@ import (
 	"github.com/fahmifan/autograd/pkg/core/assignments/assignments_cmd"
 	"github.com/fahmifan/autograd/pkg/core/assignments/assignments_query"
 	"github.com/fahmifan/autograd/pkg/core/auth/auth_cmd"
+	"github.com/fahmifan/autograd/pkg/core/course/course_cmd"
+	"github.com/fahmifan/autograd/pkg/core/course/course_query"
 	"github.com/fahmifan/autograd/pkg/core/grading/grading_cmd"
 	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_cmd"
+	"github.com/fahmifan/autograd/pkg/core/plagiarism/plagiarism_cmd"
+	"github.com/fahmifan/autograd/pkg/core/plagiarism/plagiarism_query"
 	"github.com/fahmifan/autograd/pkg/core/student_assignment/student_assignment_cmd"
 	"github.com/fahmifan/autograd/pkg/core/student_assignment/student_assignment_query"
 	"github.com/fahmifan/autograd/pkg/core/user_management/user_management_cmd"
@@ -36,6 +40,10 @@ type Service struct {
 	*student_assignment_query.StudentAssignmentQuery
 	*student_assignment_cmd.StudentAssignmentCmd
 	*grading_cmd.GradingCmd
+	*course_cmd.CourseCmd
+	*course_query.CourseQuery
+	*plagiarism_cmd.PlagiarismCmd
+	*plagiarism_query.PlagiarismQuery
 
 	outboxService *outbox.OutboxService
 }
@@ -79,6 +87,10 @@ func NewService(
 		StudentAssignmentQuery: &student_assignment_query.StudentAssignmentQuery{Ctx: coreCtx},
 		StudentAssignmentCmd:   &student_assignment_cmd.StudentAssignmentCmd{Ctx: coreCtx},
 		GradingCmd:             &grading_cmd.GradingCmd{Ctx: coreCtx},
+		CourseCmd:              &course_cmd.CourseCmd{Ctx: coreCtx},
+		CourseQuery:            &course_query.CourseQuery{Ctx: coreCtx},
+		PlagiarismCmd:          &plagiarism_cmd.PlagiarismCmd{Ctx: coreCtx},
+		PlagiarismQuery:        &plagiarism_query.PlagiarismQuery{Ctx: coreCtx},
 	}
 }
 
@@ -102,6 +114,8 @@ func (service *Service) RegisterJobHandlers() {
 	handlers := []jobqueue.JobHandler{
 		&user_management_cmd.SendRegistrationEmailHandler{Ctx: service.coreCtx},
 		&student_assignment_cmd.GradeStudentSubmissionHandler{Ctx: service.coreCtx},
+		&assignments_cmd.AssignmentPublishedHandler{Ctx: service.coreCtx},
+		&plagiarism_cmd.CheckPlagiarismHandler{Ctx: service.coreCtx},
 	}
 
 	outbox.RegisterHandlers(service.coreCtx.GormDB, service.coreCtx.SqlDB, service.coreCtx.Debug, handlers)
@@ -0,0 +1,263 @@
+package course
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Term is the academic period a course is offered in, e.g. "2024 Fall"
+type Term struct {
+	ID      uuid.UUID
+	Name    string
+	StartAt time.Time
+	EndAt   time.Time
+
+	core.TimestampMetadata
+}
+
+type CreateTermRequest struct {
+	NewID   uuid.UUID
+	Now     time.Time
+	Name    string
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+func CreateTerm(req CreateTermRequest) (Term, error) {
+	name := strings.TrimSpace(req.Name)
+	if len(name) < 3 {
+		return Term{}, errors.New("name must be at least 3 characters")
+	}
+
+	if !req.EndAt.After(req.StartAt) {
+		return Term{}, errors.New("term must end after it starts")
+	}
+
+	return Term{
+		ID:                req.NewID,
+		Name:              name,
+		StartAt:           req.StartAt,
+		EndAt:             req.EndAt,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+// Course groups the assignments of a term, its students & instructors
+// are enrolled in one of its sections
+type Course struct {
+	ID          uuid.UUID
+	Term        Term
+	Code        string
+	Name        string
+	Description string
+	Sections    []Section
+
+	core.TimestampMetadata
+}
+
+type CreateCourseRequest struct {
+	NewID       uuid.UUID
+	Now         time.Time
+	Term        Term
+	Code        string
+	Name        string
+	Description string
+}
+
+func CreateCourse(req CreateCourseRequest) (Course, error) {
+	if req.Term.DeletedAt.Valid {
+		return Course{}, errors.New("term is deleted")
+	}
+
+	code, name, err := validCourse(req.Code, req.Name)
+	if err != nil {
+		return Course{}, err
+	}
+
+	return Course{
+		ID:                req.NewID,
+		Term:              req.Term,
+		Code:              code,
+		Name:              name,
+		Description:       strings.TrimSpace(req.Description),
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+type UpdateCourseRequest struct {
+	Now         time.Time
+	Code        string
+	Name        string
+	Description string
+}
+
+func (course Course) Update(req UpdateCourseRequest) (Course, error) {
+	code, name, err := validCourse(req.Code, req.Name)
+	if err != nil {
+		return Course{}, err
+	}
+
+	course.Code = code
+	course.Name = name
+	course.Description = strings.TrimSpace(req.Description)
+	course.UpdatedAt = req.Now
+
+	return course, nil
+}
+
+func (course Course) Delete(now time.Time) (Course, error) {
+	course.DeletedAt = null.TimeFrom(now)
+	return course, nil
+}
+
+const maxCourseCodeLength = 20
+
+// validCourse upper cases the course code
+func validCourse(code, name string) (string, string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", "", errors.New("code is required")
+	}
+
+	if len(code) > maxCourseCodeLength {
+		return "", "", fmt.Errorf("code must be at most %d characters", maxCourseCodeLength)
+	}
+
+	name = strings.TrimSpace(name)
+	if len(name) < 3 {
+		return "", "", errors.New("name must be at least 3 characters")
+	}
+
+	return code, name, nil
+}
+
+// Section is a class of a course, e.g. "A" or "Monday morning"
+type Section struct {
+	ID       uuid.UUID
+	CourseID uuid.UUID
+	Name     string
+
+	core.TimestampMetadata
+}
+
+type CreateSectionRequest struct {
+	NewID  uuid.UUID
+	Now    time.Time
+	Course Course
+	Name   string
+}
+
+func CreateSection(req CreateSectionRequest) (Section, error) {
+	if req.Course.DeletedAt.Valid {
+		return Section{}, errors.New("course is deleted")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return Section{}, errors.New("name is required")
+	}
+
+	for _, section := range req.Course.Sections {
+		if strings.EqualFold(section.Name, name) {
+			return Section{}, fmt.Errorf("section %q already exists", name)
+		}
+	}
+
+	return Section{
+		ID:                req.NewID,
+		CourseID:          req.Course.ID,
+		Name:              name,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+// Delete the section, the section must not have any member
+func (section Section) Delete(now time.Time, memberCount int64) (Section, error) {
+	if memberCount > 0 {
+		return Section{}, errors.New("section still has members")
+	}
+
+	section.DeletedAt = null.TimeFrom(now)
+	return section, nil
+}
+
+type Member struct {
+	ID     uuid.UUID
+	Name   string
+	Email  string
+	Role   auth.Role
+	Active bool
+}
+
+// Enrollment is the membership of a user in a course section
+type Enrollment struct {
+	ID       uuid.UUID
+	CourseID uuid.UUID
+	Section  Section
+	Member   Member
+	Role     dbmodel.EnrollmentRole
+
+	core.TimestampMetadata
+}
+
+type EnrollRequest struct {
+	NewID   uuid.UUID
+	Now     time.Time
+	Course  Course
+	Section Section
+	Member  Member
+	Role    dbmodel.EnrollmentRole
+}
+
+func Enroll(req EnrollRequest) (Enrollment, error) {
+	if req.Course.DeletedAt.Valid {
+		return Enrollment{}, errors.New("course is deleted")
+	}
+
+	if req.Section.CourseID != req.Course.ID {
+		return Enrollment{}, errors.New("section is not in the course")
+	}
+
+	if !req.Member.Active {
+		return Enrollment{}, errors.New("member must active")
+	}
+
+	switch req.Role {
+	case dbmodel.EnrollmentRoleStudent:
+		if req.Member.Role != auth.RoleStudent {
+			return Enrollment{}, errors.New("only a student can be enrolled as a student")
+		}
+	case dbmodel.EnrollmentRoleInstructor:
+		if req.Member.Role != auth.RoleInstructor && req.Member.Role != auth.RoleAdmin {
+			return Enrollment{}, errors.New("only an instructor can be enrolled as an instructor")
+		}
+	case dbmodel.EnrollmentRoleTA:
+		if req.Member.Role != auth.RoleTA && req.Member.Role != auth.RoleInstructor && req.Member.Role != auth.RoleAdmin {
+			return Enrollment{}, errors.New("only a teaching assistant or an instructor can be enrolled as a teaching assistant")
+		}
+	default:
+		return Enrollment{}, fmt.Errorf("invalid enrollment role %q", req.Role)
+	}
+
+	return Enrollment{
+		ID:                req.NewID,
+		CourseID:          req.Course.ID,
+		Section:           req.Section,
+		Member:            req.Member,
+		Role:              req.Role,
+		TimestampMetadata: core.NewTimestampMeta(req.Now),
+	}, nil
+}
+
+func (enrollment Enrollment) Unenroll(now time.Time) (Enrollment, error) {
+	enrollment.DeletedAt = null.TimeFrom(now)
+	return enrollment, nil
+}
@@ -0,0 +1,469 @@
+package course_cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/course"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CourseCmd struct {
+	*core.Ctx
+}
+
+func (cmd *CourseCmd) CreateTerm(ctx context.Context, req *connect.Request[autogradv1.CreateTermRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	startAt, err := time.Parse(time.RFC3339, req.Msg.GetStartAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	endAt, err := time.Parse(time.RFC3339, req.Msg.GetEndAt())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	term, err := course.CreateTerm(course.CreateTermRequest{
+		NewID:   uuid.New(),
+		Now:     time.Now(),
+		Name:    req.Msg.GetName(),
+		StartAt: startAt,
+		EndAt:   endAt,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = course.TermWriter{}.Create(ctx, cmd.GormDB, term)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseCmd: CreateTerm: TermWriter{}.Create")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      term.ID.String(),
+			Message: "term created",
+		},
+	}, nil
+}
+
+func (cmd *CourseCmd) CreateCourse(ctx context.Context, req *connect.Request[autogradv1.CreateCourseRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	termID, err := uuid.Parse(req.Msg.GetTermId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	newCourse := course.Course{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		term, err := course.TermReader{}.FindByID(ctx, tx, termID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: CreateCourse: TermReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		newCourse, err = course.CreateCourse(course.CreateCourseRequest{
+			NewID:       uuid.New(),
+			Now:         time.Now(),
+			Term:        term,
+			Code:        req.Msg.GetCode(),
+			Name:        req.Msg.GetName(),
+			Description: req.Msg.GetDescription(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.CourseWriter{}.Create(ctx, tx, newCourse)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: CreateCourse: CourseWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      newCourse.ID.String(),
+			Message: "course created",
+		},
+	}, nil
+}
+
+func (cmd *CourseCmd) UpdateCourse(ctx context.Context, req *connect.Request[autogradv1.UpdateCourseRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		foundCourse, err := course.CourseReader{}.FindByID(ctx, tx, courseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: UpdateCourse: CourseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		foundCourse, err = foundCourse.Update(course.UpdateCourseRequest{
+			Now:         time.Now(),
+			Code:        req.Msg.GetCode(),
+			Name:        req.Msg.GetName(),
+			Description: req.Msg.GetDescription(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.CourseWriter{}.Update(ctx, tx, foundCourse)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: UpdateCourse: CourseWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *CourseCmd) DeleteCourse(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		foundCourse, err := course.CourseReader{}.FindByID(ctx, tx, courseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: DeleteCourse: CourseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		foundCourse, err = foundCourse.Delete(time.Now())
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.CourseWriter{}.Update(ctx, tx, foundCourse)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: DeleteCourse: CourseWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *CourseCmd) CreateCourseSection(ctx context.Context, req *connect.Request[autogradv1.CreateCourseSectionRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetCourseId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	section := course.Section{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		foundCourse, err := course.CourseReader{}.FindByID(ctx, tx, courseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: CreateCourseSection: CourseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		section, err = course.CreateSection(course.CreateSectionRequest{
+			NewID:  uuid.New(),
+			Now:    time.Now(),
+			Course: foundCourse,
+			Name:   req.Msg.GetName(),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.SectionWriter{}.Create(ctx, tx, section)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: CreateCourseSection: SectionWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      section.ID.String(),
+			Message: "section created",
+		},
+	}, nil
+}
+
+func (cmd *CourseCmd) DeleteCourseSection(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	sectionID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		section, err := course.SectionReader{}.FindByID(ctx, tx, sectionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: DeleteCourseSection: SectionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		memberCount, err := course.EnrollmentReader{}.CountBySectionID(ctx, tx, sectionID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: DeleteCourseSection: EnrollmentReader{}.CountBySectionID")
+			return core.ErrInternalServer
+		}
+
+		section, err = section.Delete(time.Now(), memberCount)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.SectionWriter{}.Update(ctx, tx, section)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: DeleteCourseSection: SectionWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
+
+func (cmd *CourseCmd) EnrollCourseMember(ctx context.Context, req *connect.Request[autogradv1.EnrollCourseMemberRequest]) (*connect.Response[autogradv1.CreatedResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetCourseId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	sectionID, err := uuid.Parse(req.Msg.GetSectionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := uuid.Parse(req.Msg.GetUserId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	enrollment := course.Enrollment{}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		foundCourse, err := course.CourseReader{}.FindByID(ctx, tx, courseID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: EnrollCourseMember: CourseReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		section, err := course.SectionReader{}.FindByID(ctx, tx, sectionID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: EnrollCourseMember: SectionReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		member, err := course.MemberReader{}.FindByID(ctx, tx, userID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: EnrollCourseMember: MemberReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		_, isEnrolled, err := course.EnrollmentReader{}.FindByMember(ctx, tx, courseID, userID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: EnrollCourseMember: EnrollmentReader{}.FindByMember")
+			return core.ErrInternalServer
+		}
+		if isEnrolled {
+			return connect.NewError(connect.CodeAlreadyExists, errors.New("user is already enrolled in the course"))
+		}
+
+		enrollment, err = course.Enroll(course.EnrollRequest{
+			NewID:   uuid.New(),
+			Now:     time.Now(),
+			Course:  foundCourse,
+			Section: section,
+			Member:  member,
+			Role:    dbmodel.EnrollmentRole(req.Msg.GetRole()),
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.EnrollmentWriter{}.Create(ctx, tx, enrollment)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: EnrollCourseMember: EnrollmentWriter{}.Create")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[autogradv1.CreatedResponse]{
+		Msg: &autogradv1.CreatedResponse{
+			Id:      enrollment.ID.String(),
+			Message: "member enrolled",
+		},
+	}, nil
+}
+
+func (cmd *CourseCmd) UnenrollCourseMember(ctx context.Context, req *connect.Request[autogradv1.DeleteByIDRequest]) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.Can(auth.ManageCourses) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	enrollmentID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		enrollment, err := course.EnrollmentReader{}.FindByID(ctx, tx, enrollmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: UnenrollCourseMember: EnrollmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		enrollment, err = enrollment.Unenroll(time.Now())
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		err = course.EnrollmentWriter{}.Update(ctx, tx, enrollment)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "CourseCmd: UnenrollCourseMember: EnrollmentWriter{}.Update")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
@@ -0,0 +1,260 @@
+package course_query
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/course"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type CourseQuery struct {
+	*core.Ctx
+}
+
+func (query *CourseQuery) FindAllTerms(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllTermsRequest],
+) (*connect.Response[autogradv1.FindAllTermsResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyCourses) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	terms, err := course.TermReader{}.FindAll(ctx, query.GormDB)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: FindAllTerms: FindAll")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.FindAllTermsResponse]{
+		Msg: &autogradv1.FindAllTermsResponse{
+			Terms: lo.Map(terms, func(term course.Term, _ int) *autogradv1.Term {
+				return toTermProto(term)
+			}),
+		},
+	}, nil
+}
+
+func (query *CourseQuery) FindAllCourses(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllCoursesRequest],
+) (*connect.Response[autogradv1.FindAllCoursesResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyCourses) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	termID, err := parseOptionalID(req.Msg.GetTermId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	courseIDs, err := query.findGrantedCourseIDs(ctx, authUser)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := course.CourseReader{}.FindAll(ctx, query.GormDB, course.FindAllCoursesRequest{
+		PaginationRequest: core.PaginationRequestFromProto(req.Msg.GetPaginationRequest()),
+		TermID:            termID,
+		IDs:               courseIDs,
+	})
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: FindAllCourses: FindAll")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.FindAllCoursesResponse]{
+		Msg: &autogradv1.FindAllCoursesResponse{
+			Courses: lo.Map(res.Courses, func(item course.Course, _ int) *autogradv1.Course {
+				return toCourseProto(item)
+			}),
+			PaginationMetadata: res.ProtoPagination(),
+		},
+	}, nil
+}
+
+func (query *CourseQuery) FindCourse(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindByIDRequest],
+) (*connect.Response[autogradv1.Course], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyCourses) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err = query.authorizeCourse(ctx, authUser, courseID); err != nil {
+		return nil, err
+	}
+
+	res, err := course.CourseReader{}.FindByID(ctx, query.GormDB, courseID)
+	if core.IsDBNotFoundErr(err) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: FindCourse: FindByID")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.Course]{
+		Msg: toCourseProto(res),
+	}, nil
+}
+
+func (query *CourseQuery) FindAllCourseEnrollments(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllCourseEnrollmentsRequest],
+) (*connect.Response[autogradv1.FindAllCourseEnrollmentsResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnyCourses) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	courseID, err := uuid.Parse(req.Msg.GetCourseId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	sectionID, err := parseOptionalID(req.Msg.GetSectionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err = query.authorizeCourse(ctx, authUser, courseID); err != nil {
+		return nil, err
+	}
+
+	res, err := course.EnrollmentReader{}.FindAll(ctx, query.GormDB, course.FindAllEnrollmentsRequest{
+		PaginationRequest: core.PaginationRequestFromProto(req.Msg.GetPaginationRequest()),
+		CourseID:          courseID,
+		SectionID:         sectionID,
+		Role:              dbmodel.EnrollmentRole(req.Msg.GetRole()),
+	})
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: FindAllCourseEnrollments: FindAll")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.FindAllCourseEnrollmentsResponse]{
+		Msg: &autogradv1.FindAllCourseEnrollmentsResponse{
+			Enrollments: lo.Map(res.Enrollments, func(enrollment course.Enrollment, _ int) *autogradv1.CourseEnrollment {
+				return toEnrollmentProto(enrollment)
+			}),
+			PaginationMetadata: res.ProtoPagination(),
+		},
+	}, nil
+}
+
+// authorizeCourse checks the user is assigned to the course or may view any course
+func (query *CourseQuery) authorizeCourse(ctx context.Context, authUser auth.AuthUser, courseID uuid.UUID) error {
+	granted, err := auth.CourseRoleReader{}.Authorize(ctx, query.GormDB, authUser, courseID, auth.ViewAnyCourses)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: authorizeCourse: Authorize")
+		return core.ErrInternalServer
+	}
+
+	if !granted {
+		return core.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// findGrantedCourseIDs finds the courses the user is assigned to,
+// nil when the user may view any course
+func (query *CourseQuery) findGrantedCourseIDs(ctx context.Context, authUser auth.AuthUser) ([]uuid.UUID, error) {
+	if authUser.Role.Can(auth.ViewAnyCourses) {
+		return nil, nil
+	}
+
+	courseRoles, err := auth.CourseRoleReader{}.FindAll(ctx, query.GormDB, authUser.UserID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "CourseQuery: findGrantedCourseIDs: FindAll")
+		return nil, core.ErrInternalServer
+	}
+
+	return courseRoles.CourseIDs(authUser.Role, auth.ViewAnyCourses), nil
+}
+
+// parseOptionalID parses the id of an optional filter, empty is uuid.Nil
+func parseOptionalID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(id)
+}
+
+func toTermProto(term course.Term) *autogradv1.Term {
+	return &autogradv1.Term{
+		Id:                term.ID.String(),
+		Name:              term.Name,
+		StartAt:           term.StartAt.Format(time.RFC3339),
+		EndAt:             term.EndAt.Format(time.RFC3339),
+		TimestampMetadata: term.ProtoTimestampMetadata(),
+	}
+}
+
+func toCourseProto(item course.Course) *autogradv1.Course {
+	return &autogradv1.Course{
+		Id:          item.ID.String(),
+		Term:        toTermProto(item.Term),
+		Code:        item.Code,
+		Name:        item.Name,
+		Description: item.Description,
+		Sections: lo.Map(item.Sections, func(section course.Section, _ int) *autogradv1.CourseSection {
+			return toSectionProto(section)
+		}),
+		TimestampMetadata: item.ProtoTimestampMetadata(),
+	}
+}
+
+func toSectionProto(section course.Section) *autogradv1.CourseSection {
+	return &autogradv1.CourseSection{
+		Id:                section.ID.String(),
+		CourseId:          section.CourseID.String(),
+		Name:              section.Name,
+		TimestampMetadata: section.ProtoTimestampMetadata(),
+	}
+}
+
+func toEnrollmentProto(enrollment course.Enrollment) *autogradv1.CourseEnrollment {
+	return &autogradv1.CourseEnrollment{
+		Id:                enrollment.ID.String(),
+		CourseId:          enrollment.CourseID.String(),
+		Section:           toSectionProto(enrollment.Section),
+		UserId:            enrollment.Member.ID.String(),
+		UserName:          enrollment.Member.Name,
+		UserEmail:         enrollment.Member.Email,
+		Role:              string(enrollment.Role),
+		TimestampMetadata: enrollment.ProtoTimestampMetadata(),
+	}
+}
@@ -0,0 +1,481 @@
+package course
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TermWriter struct{}
+
+func (TermWriter) Create(ctx context.Context, tx *gorm.DB, term Term) error {
+	model := dbmodel.Term{
+		Base: dbmodel.Base{
+			ID:       term.ID,
+			Metadata: core.NewModelMetadata(term.TimestampMetadata),
+		},
+		Name:    term.Name,
+		StartAt: term.StartAt,
+		EndAt:   term.EndAt,
+	}
+
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+type TermReader struct{}
+
+func (TermReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Term, error) {
+	model := dbmodel.Term{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return Term{}, err
+	}
+
+	return toTerm(model), nil
+}
+
+// FindAll finds the terms, the latest term first
+func (TermReader) FindAll(ctx context.Context, tx *gorm.DB) ([]Term, error) {
+	models := []dbmodel.Term{}
+	err := tx.WithContext(ctx).Order("start_at desc").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]Term, len(models))
+	for i, model := range models {
+		terms[i] = toTerm(model)
+	}
+
+	return terms, nil
+}
+
+func toTerm(model dbmodel.Term) Term {
+	return Term{
+		ID:                model.ID,
+		Name:              model.Name,
+		StartAt:           model.StartAt,
+		EndAt:             model.EndAt,
+		TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+	}
+}
+
+type CourseWriter struct{}
+
+func (CourseWriter) Create(ctx context.Context, tx *gorm.DB, course Course) error {
+	model := toCourseModel(course)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (CourseWriter) Update(ctx context.Context, tx *gorm.DB, course Course) error {
+	model := toCourseModel(course)
+
+	return tx.WithContext(ctx).Model(&dbmodel.Course{}).
+		Where("id = ?", course.ID).
+		UpdateColumns(map[string]any{
+			"code":        model.Code,
+			"name":        model.Name,
+			"description": model.Description,
+			"updated_at":  model.UpdatedAt,
+			"deleted_at":  model.DeletedAt,
+		}).Error
+}
+
+func toCourseModel(course Course) dbmodel.Course {
+	return dbmodel.Course{
+		Base: dbmodel.Base{
+			ID:       course.ID,
+			Metadata: core.NewModelMetadata(course.TimestampMetadata),
+		},
+		TermID:      course.Term.ID,
+		Code:        course.Code,
+		Name:        course.Name,
+		Description: course.Description,
+	}
+}
+
+type CourseReader struct{}
+
+// FindByID finds the course with its term & sections
+func (CourseReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Course, error) {
+	model := dbmodel.Course{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return Course{}, err
+	}
+
+	courses, err := toCourses(ctx, tx, []dbmodel.Course{model})
+	if err != nil {
+		return Course{}, err
+	}
+
+	return courses[0], nil
+}
+
+type FindAllCoursesRequest struct {
+	core.PaginationRequest
+	// TermID is optional
+	TermID uuid.UUID
+	// IDs restricts the courses when it's not nil
+	IDs []uuid.UUID
+}
+
+type FindAllCoursesResponse struct {
+	Courses []Course
+	core.Pagination
+}
+
+func (CourseReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllCoursesRequest) (FindAllCoursesResponse, error) {
+	termScope := func(db *gorm.DB) *gorm.DB {
+		if req.TermID == uuid.Nil {
+			return db
+		}
+		return db.Where("term_id = ?", req.TermID)
+	}
+	idsScope := func(db *gorm.DB) *gorm.DB {
+		if req.IDs == nil {
+			return db
+		}
+		return db.Where("id IN ?", req.IDs)
+	}
+
+	count := int64(0)
+	err := tx.WithContext(ctx).Model(&dbmodel.Course{}).Scopes(termScope, idsScope).Count(&count).Error
+	if err != nil {
+		return FindAllCoursesResponse{}, fmt.Errorf("count courses: %w", err)
+	}
+
+	models := []dbmodel.Course{}
+	err = tx.WithContext(ctx).
+		Scopes(termScope, idsScope, req.PaginateScope).
+		Order("code asc").
+		Find(&models).Error
+	if err != nil {
+		return FindAllCoursesResponse{}, fmt.Errorf("find courses: %w", err)
+	}
+
+	courses, err := toCourses(ctx, tx, models)
+	if err != nil {
+		return FindAllCoursesResponse{}, err
+	}
+
+	return FindAllCoursesResponse{
+		Courses: courses,
+		Pagination: core.Pagination{
+			Page:  req.Page,
+			Limit: req.Limit,
+			Total: int32(count),
+		},
+	}, nil
+}
+
+// toCourses finds the terms & sections of the courses
+func toCourses(ctx context.Context, tx *gorm.DB, models []dbmodel.Course) ([]Course, error) {
+	termIDs := make([]uuid.UUID, len(models))
+	courseIDs := make([]uuid.UUID, len(models))
+	for i, model := range models {
+		termIDs[i] = model.TermID
+		courseIDs[i] = model.ID
+	}
+
+	terms := []dbmodel.Term{}
+	err := tx.WithContext(ctx).Unscoped().Where("id IN (?)", termIDs).Find(&terms).Error
+	if err != nil {
+		return nil, fmt.Errorf("find terms: %w", err)
+	}
+
+	termMap := make(map[uuid.UUID]dbmodel.Term, len(terms))
+	for _, term := range terms {
+		termMap[term.ID] = term
+	}
+
+	sections := []dbmodel.CourseSection{}
+	err = tx.WithContext(ctx).
+		Where("course_id IN (?)", courseIDs).
+		Order("name asc").
+		Find(&sections).Error
+	if err != nil {
+		return nil, fmt.Errorf("find sections: %w", err)
+	}
+
+	sectionMap := make(map[uuid.UUID][]Section, len(models))
+	for _, section := range sections {
+		sectionMap[section.CourseID] = append(sectionMap[section.CourseID], toSection(section))
+	}
+
+	courses := make([]Course, len(models))
+	for i, model := range models {
+		courses[i] = Course{
+			ID:                model.ID,
+			Term:              toTerm(termMap[model.TermID]),
+			Code:              model.Code,
+			Name:              model.Name,
+			Description:       model.Description,
+			Sections:          sectionMap[model.ID],
+			TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+		}
+	}
+
+	return courses, nil
+}
+
+type SectionWriter struct{}
+
+func (SectionWriter) Create(ctx context.Context, tx *gorm.DB, section Section) error {
+	model := toSectionModel(section)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (SectionWriter) Update(ctx context.Context, tx *gorm.DB, section Section) error {
+	model := toSectionModel(section)
+
+	return tx.WithContext(ctx).Model(&dbmodel.CourseSection{}).
+		Where("id = ?", section.ID).
+		UpdateColumns(map[string]any{
+			"name":       model.Name,
+			"updated_at": model.UpdatedAt,
+			"deleted_at": model.DeletedAt,
+		}).Error
+}
+
+func toSectionModel(section Section) dbmodel.CourseSection {
+	return dbmodel.CourseSection{
+		Base: dbmodel.Base{
+			ID:       section.ID,
+			Metadata: core.NewModelMetadata(section.TimestampMetadata),
+		},
+		CourseID: section.CourseID,
+		Name:     section.Name,
+	}
+}
+
+type SectionReader struct{}
+
+func (SectionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Section, error) {
+	model := dbmodel.CourseSection{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return Section{}, err
+	}
+
+	return toSection(model), nil
+}
+
+func toSection(model dbmodel.CourseSection) Section {
+	return Section{
+		ID:                model.ID,
+		CourseID:          model.CourseID,
+		Name:              model.Name,
+		TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+	}
+}
+
+type MemberReader struct{}
+
+func (MemberReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Member, error) {
+	user := dbmodel.User{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&user).Error
+	if err != nil {
+		return Member{}, err
+	}
+
+	return toMember(user), nil
+}
+
+func toMember(user dbmodel.User) Member {
+	return Member{
+		ID:     user.ID,
+		Name:   user.Name,
+		Email:  user.Email,
+		Role:   auth.Role(user.Role),
+		Active: user.IsActive(),
+	}
+}
+
+type EnrollmentWriter struct{}
+
+func (EnrollmentWriter) Create(ctx context.Context, tx *gorm.DB, enrollment Enrollment) error {
+	model := toEnrollmentModel(enrollment)
+	return tx.WithContext(ctx).Create(&model).Error
+}
+
+func (EnrollmentWriter) Update(ctx context.Context, tx *gorm.DB, enrollment Enrollment) error {
+	model := toEnrollmentModel(enrollment)
+
+	return tx.WithContext(ctx).Model(&dbmodel.CourseEnrollment{}).
+		Where("id = ?", enrollment.ID).
+		UpdateColumns(map[string]any{
+			"section_id": model.SectionID,
+			"role":       model.Role,
+			"updated_at": model.UpdatedAt,
+			"deleted_at": model.DeletedAt,
+		}).Error
+}
+
+func toEnrollmentModel(enrollment Enrollment) dbmodel.CourseEnrollment {
+	return dbmodel.CourseEnrollment{
+		Base: dbmodel.Base{
+			ID:       enrollment.ID,
+			Metadata: core.NewModelMetadata(enrollment.TimestampMetadata),
+		},
+		CourseID:  enrollment.CourseID,
+		SectionID: enrollment.Section.ID,
+		UserID:    enrollment.Member.ID,
+		Role:      enrollment.Role,
+	}
+}
+
+type EnrollmentReader struct{}
+
+func (EnrollmentReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (Enrollment, error) {
+	model := dbmodel.CourseEnrollment{}
+	err := tx.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if err != nil {
+		return Enrollment{}, err
+	}
+
+	enrollments, err := toEnrollments(ctx, tx, []dbmodel.CourseEnrollment{model})
+	if err != nil {
+		return Enrollment{}, err
+	}
+
+	return enrollments[0], nil
+}
+
+// FindByMember finds the enrollment of the user in the course, ok is false when there is none
+func (EnrollmentReader) FindByMember(ctx context.Context, tx *gorm.DB, courseID, userID uuid.UUID) (
+	enrollment Enrollment, ok bool, err error,
+) {
+	model := dbmodel.CourseEnrollment{}
+	err = tx.WithContext(ctx).
+		Where("course_id = ? and user_id = ?", courseID, userID).
+		Take(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Enrollment{}, false, nil
+	}
+	if err != nil {
+		return Enrollment{}, false, err
+	}
+
+	enrollments, err := toEnrollments(ctx, tx, []dbmodel.CourseEnrollment{model})
+	if err != nil {
+		return Enrollment{}, false, err
+	}
+
+	return enrollments[0], true, nil
+}
+
+func (EnrollmentReader) CountBySectionID(ctx context.Context, tx *gorm.DB, sectionID uuid.UUID) (int64, error) {
+	count := int64(0)
+	err := tx.WithContext(ctx).Model(&dbmodel.CourseEnrollment{}).
+		Where("section_id = ?", sectionID).
+		Count(&count).Error
+	return count, err
+}
+
+type FindAllEnrollmentsRequest struct {
+	core.PaginationRequest
+	CourseID uuid.UUID
+	// SectionID & Role are optional
+	SectionID uuid.UUID
+	Role      dbmodel.EnrollmentRole
+}
+
+type FindAllEnrollmentsResponse struct {
+	Enrollments []Enrollment
+	core.Pagination
+}
+
+func (EnrollmentReader) FindAll(ctx context.Context, tx *gorm.DB, req FindAllEnrollmentsRequest) (FindAllEnrollmentsResponse, error) {
+	filterScope := func(db *gorm.DB) *gorm.DB {
+		db = db.Where("course_id = ?", req.CourseID)
+		if req.SectionID != uuid.Nil {
+			db = db.Where("section_id = ?", req.SectionID)
+		}
+		if req.Role != "" {
+			db = db.Where("role = ?", req.Role)
+		}
+		return db
+	}
+
+	count := int64(0)
+	err := tx.WithContext(ctx).Model(&dbmodel.CourseEnrollment{}).Scopes(filterScope).Count(&count).Error
+	if err != nil {
+		return FindAllEnrollmentsResponse{}, fmt.Errorf("count enrollments: %w", err)
+	}
+
+	models := []dbmodel.CourseEnrollment{}
+	err = tx.WithContext(ctx).
+		Scopes(filterScope, req.PaginateScope).
+		Order("created_at asc").
+		Find(&models).Error
+	if err != nil {
+		return FindAllEnrollmentsResponse{}, fmt.Errorf("find enrollments: %w", err)
+	}
+
+	enrollments, err := toEnrollments(ctx, tx, models)
+	if err != nil {
+		return FindAllEnrollmentsResponse{}, err
+	}
+
+	return FindAllEnrollmentsResponse{
+		Enrollments: enrollments,
+		Pagination: core.Pagination{
+			Page:  req.Page,
+			Limit: req.Limit,
+			Total: int32(count),
+		},
+	}, nil
+}
+
+// toEnrollments finds the sections & members of the enrollments
+func toEnrollments(ctx context.Context, tx *gorm.DB, models []dbmodel.CourseEnrollment) ([]Enrollment, error) {
+	sectionIDs := make([]uuid.UUID, len(models))
+	userIDs := make([]uuid.UUID, len(models))
+	for i, model := range models {
+		sectionIDs[i] = model.SectionID
+		userIDs[i] = model.UserID
+	}
+
+	sections := []dbmodel.CourseSection{}
+	err := tx.WithContext(ctx).Where("id IN (?)", sectionIDs).Find(&sections).Error
+	if err != nil {
+		return nil, fmt.Errorf("find sections: %w", err)
+	}
+
+	sectionMap := make(map[uuid.UUID]dbmodel.CourseSection, len(sections))
+	for _, section := range sections {
+		sectionMap[section.ID] = section
+	}
+
+	users := []dbmodel.User{}
+	err = tx.WithContext(ctx).Where("id IN (?)", userIDs).Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("find users: %w", err)
+	}
+
+	userMap := make(map[uuid.UUID]dbmodel.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	enrollments := make([]Enrollment, len(models))
+	for i, model := range models {
+		enrollments[i] = Enrollment{
+			ID:                model.ID,
+			CourseID:          model.CourseID,
+			Section:           toSection(sectionMap[model.SectionID]),
+			Member:            toMember(userMap[model.UserID]),
+			Role:              model.Role,
+			TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+		}
+	}
+
+	return enrollments, nil
+}
@@ -0,0 +1,328 @@
+package grading
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// CheckerType is how the output of a test case is judged
+type CheckerType string
+
+const (
+	CheckerTypeExact           CheckerType = "exact"
+	CheckerTypeToken           CheckerType = "token"
+	CheckerTypeCaseInsensitive CheckerType = "case_insensitive"
+	CheckerTypeFloat           CheckerType = "float"
+	CheckerTypeProgram         CheckerType = "program"
+	// CheckerTypeInteractor talks to the program instead of checking its output
+	CheckerTypeInteractor CheckerType = "interactor"
+)
+
+type CheckRequest struct {
+	Input    string
+	Expected string
+	Output   string
+}
+
+// Checker judges the output of a single test case.
+// A wrong output is reported as VerdictWrongAnswer, not as an error.
+type Checker interface {
+	Check(req CheckRequest) (Verdict, error)
+}
+
+type CheckerConfig struct {
+	Type       CheckerType
+	AbsEpsilon float64
+	RelEpsilon float64
+	// Source is the c++ source of the checker program,
+	// or of the interactor program for CheckerTypeInteractor
+	Source string
+	// InteractorLimits only sets the time & memory limits of the interactor
+	InteractorLimits Limits
+}
+
+// NewInteractor creates the interactor for the config,
+// nil when the cases are judged by a checker instead.
+// The runner is used to run the interactor program.
+func NewInteractor(cfg CheckerConfig, runner Runner) *ProgramInteractor {
+	if cfg.Type != CheckerTypeInteractor {
+		return nil
+	}
+	return &ProgramInteractor{Runner: runner, Source: cfg.Source, Limits: cfg.InteractorLimits}
+}
+
+// NewChecker creates the checker for the config.
+// The runner is used to run the checker program.
+func NewChecker(cfg CheckerConfig, runner Runner) (Checker, error) {
+	switch cfg.Type {
+	case CheckerTypeExact, "":
+		return ExactChecker{}, nil
+	case CheckerTypeToken:
+		return TokenChecker{}, nil
+	case CheckerTypeCaseInsensitive:
+		return CaseInsensitiveChecker{}, nil
+	case CheckerTypeFloat:
+		return FloatChecker{AbsEpsilon: cfg.AbsEpsilon, RelEpsilon: cfg.RelEpsilon}, nil
+	case CheckerTypeProgram:
+		return &ProgramChecker{Runner: runner, Source: cfg.Source}, nil
+	case CheckerTypeInteractor:
+		return nil, errors.New("interactor judges the program run, see NewInteractor")
+	default:
+		return nil, fmt.Errorf("unknown checker type %q", cfg.Type)
+	}
+}
+
+func verdictOf(accepted bool) Verdict {
+	if accepted {
+		return VerdictAccepted
+	}
+	return VerdictWrongAnswer
+}
+
+// ExactChecker accepts output that is equal to the expected output,
+// ignoring the trailing newlines.
+type ExactChecker struct{}
+
+func (ExactChecker) Check(req CheckRequest) (Verdict, error) {
+	return verdictOf(trimTrailingNewlines(req.Output) == trimTrailingNewlines(req.Expected)), nil
+}
+
+// LineChecker compares the output line by line, ignoring the trailing newlines & carriage returns.
+// It judges the legacy case files, see TestCaseFromCaseFiles.
+type LineChecker struct{}
+
+func (LineChecker) Check(req CheckRequest) (Verdict, error) {
+	outputs := strings.Split(trimTrailingNewlines(req.Output), "\n")
+	expecteds := strings.Split(trimTrailingNewlines(req.Expected), "\n")
+	if len(outputs) != len(expecteds) {
+		return VerdictWrongAnswer, nil
+	}
+
+	for i := range expecteds {
+		if strings.TrimRight(outputs[i], "\r") != strings.TrimRight(expecteds[i], "\r") {
+			return VerdictWrongAnswer, nil
+		}
+	}
+
+	return VerdictAccepted, nil
+}
+
+// TokenChecker compares whitespace separated tokens,
+// so the amount & kind of whitespaces doesn't matter.
+type TokenChecker struct{}
+
+func (TokenChecker) Check(req CheckRequest) (Verdict, error) {
+	outputs := strings.Fields(req.Output)
+	expecteds := strings.Fields(req.Expected)
+	if len(outputs) != len(expecteds) {
+		return VerdictWrongAnswer, nil
+	}
+
+	for i := range expecteds {
+		if outputs[i] != expecteds[i] {
+			return VerdictWrongAnswer, nil
+		}
+	}
+
+	return VerdictAccepted, nil
+}
+
+// CaseInsensitiveChecker compares tokens case insensitively.
+type CaseInsensitiveChecker struct{}
+
+func (CaseInsensitiveChecker) Check(req CheckRequest) (Verdict, error) {
+	outputs := strings.Fields(req.Output)
+	expecteds := strings.Fields(req.Expected)
+	if len(outputs) != len(expecteds) {
+		return VerdictWrongAnswer, nil
+	}
+
+	for i := range expecteds {
+		if !strings.EqualFold(outputs[i], expecteds[i]) {
+			return VerdictWrongAnswer, nil
+		}
+	}
+
+	return VerdictAccepted, nil
+}
+
+// FloatChecker compares tokens, a numeric token is accepted when it is within
+// the absolute or the relative epsilon of the expected one.
+// Non numeric tokens must be equal.
+type FloatChecker struct {
+	AbsEpsilon float64
+	RelEpsilon float64
+}
+
+func (checker FloatChecker) Check(req CheckRequest) (Verdict, error) {
+	outputs := strings.Fields(req.Output)
+	expecteds := strings.Fields(req.Expected)
+	if len(outputs) != len(expecteds) {
+		return VerdictWrongAnswer, nil
+	}
+
+	for i := range expecteds {
+		if !checker.equal(outputs[i], expecteds[i]) {
+			return VerdictWrongAnswer, nil
+		}
+	}
+
+	return VerdictAccepted, nil
+}
+
+func (checker FloatChecker) equal(output, expected string) bool {
+	expectedNum, err := strconv.ParseFloat(expected, 64)
+	if err != nil {
+		return output == expected
+	}
+
+	outputNum, err := strconv.ParseFloat(output, 64)
+	if err != nil || math.IsNaN(outputNum) {
+		return false
+	}
+
+	diff := math.Abs(outputNum - expectedNum)
+	return diff <= checker.AbsEpsilon || diff <= checker.RelEpsilon*math.Abs(expectedNum)
+}
+
+// testlib checker exit codes
+const (
+	checkerExitOK                = 0
+	checkerExitWrongAnswer       = 1
+	checkerExitPresentationError = 2
+)
+
+// ProgramChecker runs a teacher supplied c++ checker program in testlib style:
+//
+//	checker <input-file> <output-file> <answer-file>
+//
+// It exits with 0 when the output is accepted, 1 or 2 when it is wrong.
+// Any other outcome is a checker failure.
+type ProgramChecker struct {
+	Runner Runner
+	Source string
+
+	program teacherProgram
+}
+
+// Compile compiles the checker once for every test case, Cleanup must be called after
+func (checker *ProgramChecker) Compile() error {
+	if checker.Runner == nil {
+		return errors.New("program checker: runner is required")
+	}
+
+	program, err := compileTeacherProgram(checker.Runner, "checker.cpp", checker.Source)
+	checker.program = program
+	if err != nil {
+		return fmt.Errorf("program checker: %w", err)
+	}
+
+	return nil
+}
+
+func (checker *ProgramChecker) Cleanup() {
+	checker.program.cleanup()
+}
+
+func (checker *ProgramChecker) Check(req CheckRequest) (Verdict, error) {
+	if checker.program.dir == "" {
+		return "", errors.New("program checker: checker is not compiled")
+	}
+
+	files := map[string]string{
+		"input.txt":  req.Input,
+		"output.txt": req.Output,
+		"answer.txt": req.Expected,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(checker.program.dir, name), []byte(content), 0644); err != nil {
+			return "", fmt.Errorf("program checker: write %s: %w", name, err)
+		}
+	}
+
+	res, err := checker.Runner.Run(RunnerArg{
+		Input:           strings.NewReader(""),
+		MountDir:        checker.program.dir,
+		ProgramFileName: checker.program.fileName,
+		BuildDir:        checker.program.buildDir,
+		Args:            []string{"input.txt", "output.txt", "answer.txt"},
+		MemLimit:        256,
+		RunTimeout:      10,
+	})
+	if err != nil {
+		return "", fmt.Errorf("program checker: run: %w", err)
+	}
+
+	switch res.Status {
+	case RunStatusOK:
+		return VerdictAccepted, nil
+	case RunStatusRuntimeError:
+		if res.ExitCode == checkerExitWrongAnswer || res.ExitCode == checkerExitPresentationError {
+			return VerdictWrongAnswer, nil
+		}
+		return "", fmt.Errorf("program checker: failed with exit code %d: %s", res.ExitCode, Excerpt(res.Stderr, StderrExcerptLimit))
+	default:
+		return "", fmt.Errorf("program checker: %s: %s", res.Status, Excerpt(res.Stderr, StderrExcerptLimit))
+	}
+}
+
+// teacherProgram is a compiled checker or interactor program,
+// its case files are written into dir before each run
+type teacherProgram struct {
+	dir      string
+	buildDir string
+	fileName string
+}
+
+// compileTeacherProgram compiles the c++ source, the program must be cleaned up
+// even when the compile fails
+func compileTeacherProgram(runner Runner, fileName, source string) (teacherProgram, error) {
+	program := teacherProgram{fileName: fileName}
+
+	dir, err := os.MkdirTemp("", "autograd-teacher-*")
+	if err != nil {
+		return program, fmt.Errorf("create dir: %w", err)
+	}
+	program.dir = dir
+
+	buildDir, err := os.MkdirTemp("", "autograd-build-*")
+	if err != nil {
+		return program, fmt.Errorf("create build dir: %w", err)
+	}
+	program.buildDir = buildDir
+
+	err = os.WriteFile(filepath.Join(dir, fileName), []byte(source), 0644)
+	if err != nil {
+		return program, fmt.Errorf("write source: %w", err)
+	}
+
+	res, err := runner.Compile(CompileArg{
+		MountDir:        dir,
+		ProgramFileName: fileName,
+		BuildDir:        buildDir,
+		Timeout:         DefaultCompileTimeLimit,
+	})
+	if err != nil {
+		return program, fmt.Errorf("compile: %w", err)
+	}
+
+	if res.Status != RunStatusOK {
+		return program, fmt.Errorf("compile: %s: %s", res.Status, Excerpt(res.Stderr, StderrExcerptLimit))
+	}
+
+	return program, nil
+}
+
+func (program teacherProgram) cleanup() {
+	if program.dir != "" {
+		os.RemoveAll(program.dir)
+	}
+	if program.buildDir != "" {
+		os.RemoveAll(program.buildDir)
+	}
+}
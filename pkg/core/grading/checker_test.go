@@ -0,0 +1,96 @@
+package grading
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckers(t *testing.T) {
+	tests := []struct {
+		name     string
+		checker  Checker
+		expected string
+		output   string
+		verdict  Verdict
+	}{
+		{"exact ignore trailing newline", ExactChecker{}, "1 2\n", "1 2", VerdictAccepted},
+		{"exact trailing space", ExactChecker{}, "1 2", "1 2 ", VerdictWrongAnswer},
+		{"line carriage return", LineChecker{}, "1\n2\n", "1\r\n2", VerdictAccepted},
+		{"line missing line", LineChecker{}, "1\n2\n", "1\n", VerdictWrongAnswer},
+		{"line extra line", LineChecker{}, "1\n2", "1\n2\n3", VerdictWrongAnswer},
+		{"token whitespaces", TokenChecker{}, "1 2\n3", "1   2 3\n\n", VerdictAccepted},
+		{"token extra token", TokenChecker{}, "1 2", "1 2 3", VerdictWrongAnswer},
+		{"case insensitive", CaseInsensitiveChecker{}, "YES", "yes\n", VerdictAccepted},
+		{"float abs", FloatChecker{AbsEpsilon: 1e-6}, "0.3", "0.30000001", VerdictAccepted},
+		{"float abs too far", FloatChecker{AbsEpsilon: 1e-9}, "0.3", "0.30000001", VerdictWrongAnswer},
+		{"float rel", FloatChecker{RelEpsilon: 1e-6}, "1000000", "1000000.5", VerdictAccepted},
+		{"float non numeric", FloatChecker{AbsEpsilon: 1e-6}, "answer 0.5", "answer 0.5000001", VerdictAccepted},
+		{"float nan", FloatChecker{AbsEpsilon: 1e-6}, "0.5", "nan", VerdictWrongAnswer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := tt.checker.Check(CheckRequest{Expected: tt.expected, Output: tt.output})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if verdict != tt.verdict {
+				t.Errorf("want %s, got %s", tt.verdict, verdict)
+			}
+		})
+	}
+}
+
+// compileCounter counts the compiles of the checker program,
+// the checker accepts the output when it equals the answer file
+type compileCounter struct {
+	compiles int
+}
+
+func (counter *compileCounter) Compile(arg CompileArg) (CompileResult, error) {
+	counter.compiles++
+	return CompileResult{Status: RunStatusOK}, nil
+}
+
+func (counter *compileCounter) Run(arg RunnerArg) (RunResult, error) {
+	output, _ := os.ReadFile(filepath.Join(arg.MountDir, arg.Args[1]))
+	answer, _ := os.ReadFile(filepath.Join(arg.MountDir, arg.Args[2]))
+	if string(output) != string(answer) {
+		return RunResult{Status: RunStatusRuntimeError, ExitCode: checkerExitWrongAnswer}, nil
+	}
+	return RunResult{Status: RunStatusOK}, nil
+}
+
+func TestProgramCheckerCompilesOnce(t *testing.T) {
+	echo := funcRunner(func(arg RunnerArg) RunResult {
+		input, _ := io.ReadAll(arg.Input)
+		return RunResult{Status: RunStatusOK, Output: input}
+	})
+	counter := &compileCounter{}
+
+	res, err := Grade(GradeRequest{
+		Compiler: echo,
+		Checker:  &ProgramChecker{Runner: counter},
+		TestCases: []TestCase{
+			{Input: "1", Expected: "1"},
+			{Input: "2", Expected: "3"},
+			{Input: "3", Expected: "3"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if counter.compiles != 1 {
+		t.Errorf("want checker compiled once, got %d", counter.compiles)
+	}
+
+	verdicts := []Verdict{VerdictAccepted, VerdictWrongAnswer, VerdictAccepted}
+	for i, caseResult := range res.Cases {
+		if caseResult.Verdict != verdicts[i] {
+			t.Errorf("case %d: want %s, got %s", i+1, verdicts[i], caseResult.Verdict)
+		}
+	}
+}
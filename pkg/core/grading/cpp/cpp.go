@@ -2,79 +2,167 @@ package cpp
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
-	"path"
+	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/fahmifan/autograd/pkg/core/grading"
-	"github.com/fahmifan/autograd/pkg/logs"
 )
 
+var _ grading.Runner = &CPPCompiler{}
+
+// CPPCompiler compiles & runs c++ program directly on the host.
+// The memory & process limits are not enforced, on darwin the program is run
+// with the mac sandbox rules. A project is compiled from all of its .cpp files,
+// a build command is not supported as it's not run in a sandbox.
 type CPPCompiler struct {
 }
 
-func (c *CPPCompiler) compile(inputPath grading.SourceCodePath) (outPath string, err error) {
-	outPath = path.Join(fmt.Sprintf("%s.bin", inputPath))
-	cmd := exec.Command("g++", string(inputPath), "-o", outPath)
-	_, err = cmd.CombinedOutput()
+// binFileName is the name of the compiled program inside the build dir
+const binFileName = "app"
+
+func (c *CPPCompiler) Compile(arg grading.CompileArg) (grading.CompileResult, error) {
+	mountDir, err := filepath.Abs(arg.MountDir)
 	if err != nil {
-		return "", fmt.Errorf("compile: %w", err)
+		return grading.CompileResult{}, fmt.Errorf("CPPCompiler: mount dir: %w", err)
 	}
 
-	return outPath, nil
-}
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("CPPCompiler: build dir: %w", err)
+	}
 
-func (c *CPPCompiler) Run(srcCodePath grading.SourceCodePath, input io.Reader, output io.Writer) (err error) {
-	return c.run(srcCodePath, input, output)
+	if arg.BuildCmd != "" {
+		return grading.CompileResult{}, errors.New("CPPCompiler: build command is not supported")
+	}
+
+	sources := []string{filepath.Join(mountDir, arg.ProgramFileName)}
+	if arg.IsProject {
+		sources, err = projectSources(mountDir)
+		if err != nil {
+			return grading.CompileResult{}, fmt.Errorf("CPPCompiler: project sources: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(arg.Timeout)*time.Second)
+	defer cancel()
+
+	buffErr := bytes.NewBuffer(nil)
+	cmd := exec.CommandContext(ctx, "g++", append(sources, "-o", filepath.Join(buildDir, binFileName))...)
+	cmd.Stderr = buffErr
+
+	start := time.Now()
+	err = cmd.Run()
+
+	res := grading.CompileResult{
+		Status:   grading.RunStatusOK,
+		Stderr:   buffErr.Bytes(),
+		Duration: time.Since(start),
+	}
+
+	if cmd.ProcessState != nil {
+		res.ExitCode = cmd.ProcessState.ExitCode()
+	}
+
+	exitErr := &exec.ExitError{}
+	switch {
+	case err == nil:
+		return res, nil
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		res.Status = grading.RunStatusTimeLimitExceeded
+		return res, nil
+	case errors.As(err, &exitErr):
+		res.Status = grading.RunStatusCompileError
+		return res, nil
+	default:
+		return grading.CompileResult{}, fmt.Errorf("CPPCompiler: compile: %w", err)
+	}
 }
 
-func (c *CPPCompiler) run(srcCodePath grading.SourceCodePath, input io.Reader, output io.Writer) (err error) {
-	binPath, err := c.compile(srcCodePath)
+func (c *CPPCompiler) Run(arg grading.RunnerArg) (grading.RunResult, error) {
+	mountDir, err := filepath.Abs(arg.MountDir)
 	if err != nil {
-		return fmt.Errorf("compile: %w", err)
+		return grading.RunResult{}, fmt.Errorf("CPPCompiler: mount dir: %w", err)
 	}
 
-	defer func() {
-		if err := c.remove(binPath); err != nil {
-			logs.Err(err, "path", "binPath: ", string(binPath))
-		}
-		if err := c.remove(string(srcCodePath)); err != nil {
-			logs.Err(err, "path", "srcCodePath: ", string(srcCodePath))
-		}
-	}()
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("CPPCompiler: build dir: %w", err)
+	}
 
-	cmd := exec.Command(binPath)
+	binPath := filepath.Join(buildDir, binFileName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(arg.RunTimeout)*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, binPath, arg.Args...)
 
 	switch runtime.GOOS {
 	case "darwin":
 		sandboxRulePath := grading.RuleFilePath()
-		cmd = exec.Command("/usr/bin/sandbox-exec", "-f", sandboxRulePath, binPath)
+		sandboxArgs := append([]string{"-f", sandboxRulePath, binPath}, arg.Args...)
+		cmd = exec.CommandContext(ctx, "/usr/bin/sandbox-exec", sandboxArgs...)
 	case "linux":
 		//
 	}
 
+	cmd.Dir = mountDir
+
+	buffOut := arg.NewStdout()
 	buffErr := bytes.NewBuffer(nil)
 
-	cmd.Stdin = input
-	cmd.Stdout = output
+	cmd.Stdin = arg.Input
+	cmd.Stdout = buffOut
 	cmd.Stderr = buffErr
 
+	start := time.Now()
 	err = cmd.Run()
-	if err != nil {
-		return fmt.Errorf("CPPCompiler: run: %w", err)
+	wallTime := time.Since(start)
+
+	res := grading.RunResult{
+		Output:   buffOut.Bytes(),
+		Stderr:   buffErr.Bytes(),
+		Status:   grading.RunStatusOK,
+		WallTime: wallTime,
 	}
 
-	if buffErr.Len() > 0 {
-		err = fmt.Errorf(buffErr.String())
-		return fmt.Errorf("CPPCompiler: stderr: %w", err)
+	if cmd.ProcessState != nil {
+		res.ExitCode = cmd.ProcessState.ExitCode()
+		res.CPUTime = cmd.ProcessState.UserTime() + cmd.ProcessState.SystemTime()
 	}
 
-	return
+	exitErr := &exec.ExitError{}
+	switch {
+	case err == nil:
+		res.Status = buffOut.Status(res.Status)
+		return res, nil
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		res.Status = grading.RunStatusTimeLimitExceeded
+		return res, nil
+	case errors.As(err, &exitErr):
+		res.Status = buffOut.Status(grading.RunStatusRuntimeError)
+		return res, nil
+	default:
+		return grading.RunResult{}, fmt.Errorf("CPPCompiler: run: %w", err)
+	}
 }
 
-func (c *CPPCompiler) remove(source string) error {
-	return os.Remove(source)
+// projectSources finds the .cpp files of the project dir
+func projectSources(dir string) ([]string, error) {
+	sources := []string{}
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() && filepath.Ext(path) == ".cpp" {
+			sources = append(sources, path)
+		}
+		return nil
+	})
+	return sources, err
 }
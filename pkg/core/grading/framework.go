@@ -0,0 +1,122 @@
+package grading
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// TestFramework is the unit test framework of an assignment graded with teacher harness files,
+// the empty framework grades the stdin & stdout of the test cases
+type TestFramework string
+
+const (
+	TestFrameworkNone       TestFramework = ""
+	TestFrameworkGoogleTest TestFramework = "gtest"
+	TestFrameworkPytest     TestFramework = "pytest"
+	TestFrameworkJUnit      TestFramework = "junit"
+)
+
+// FrameworkSpec describes how a student source is compiled with the harness files & tested.
+//
+// The student source is copied into /build as SourceFileName next to the harness files,
+// the harness refers to it by that name. The RunCmd prints the JUnit XML report to stdout,
+// the output of the tests is redirected to stderr so it doesn't corrupt the report.
+//
+// The framework is not part of the language images,
+// the Image is the language image with the framework installed.
+type FrameworkSpec struct {
+	Framework      TestFramework
+	Language       Language
+	Image          string
+	SourceFileName string
+	CompileCmd     string
+	RunCmd         string
+	DefaultLimits  Limits
+}
+
+var frameworkSpecs = []FrameworkSpec{
+	{
+		// gcc image with libgtest-dev installed, the student implements
+		// the declarations of the harness headers
+		Framework:      TestFrameworkGoogleTest,
+		Language:       LanguageCPP,
+		Image:          "localhost/autograd-gtest:latest",
+		SourceFileName: "solution.cpp",
+		CompileCmd:     "g++ -std=c++17 -o /build/tests /build/*.cpp -lgtest -lgtest_main -pthread",
+		RunCmd:         `bash -c '/build/tests --gtest_output=xml:/tmp/report.xml 1>&2; cat /tmp/report.xml'`,
+		DefaultLimits:  Limits{TimeLimit: 10, MemoryLimit: 256, CompileTimeLimit: 60},
+	},
+	{
+		// python image with pytest installed, the harness imports the solution module
+		Framework:      TestFrameworkPytest,
+		Language:       LanguagePython,
+		Image:          "localhost/autograd-pytest:latest",
+		SourceFileName: "solution.py",
+		CompileCmd:     `python3 -c 'import sys; compile(open(sys.argv[1]).read(), sys.argv[1], "exec")' /build/solution.py`,
+		RunCmd: `bash -c 'cd /build && python3 -B -m pytest -q -p no:cacheprovider ` +
+			`--junitxml=/tmp/report.xml 1>&2; cat /tmp/report.xml'`,
+		DefaultLimits: Limits{TimeLimit: 10, MemoryLimit: 256, CompileTimeLimit: 10},
+	},
+	{
+		// temurin image with the junit platform console standalone jar at /opt/junit,
+		// the student implements class Solution
+		Framework:      TestFrameworkJUnit,
+		Language:       LanguageJava,
+		Image:          "localhost/autograd-junit:latest",
+		SourceFileName: "Solution.java",
+		CompileCmd:     "javac -cp /opt/junit/junit-platform-console-standalone.jar -d /build /build/*.java",
+		RunCmd: `bash -c 'java -jar /opt/junit/junit-platform-console-standalone.jar execute ` +
+			`-cp /build --scan-classpath --reports-dir=/tmp/report 1>&2; cat /tmp/report/TEST-junit-jupiter.xml'`,
+		DefaultLimits: Limits{TimeLimit: 20, MemoryLimit: 512, CompileTimeLimit: 60},
+	},
+}
+
+// FrameworkSpecs returns the spec of all supported test frameworks
+func FrameworkSpecs() []FrameworkSpec {
+	specs := make([]FrameworkSpec, len(frameworkSpecs))
+	copy(specs, frameworkSpecs)
+	return specs
+}
+
+func LookupFrameworkSpec(framework TestFramework) (FrameworkSpec, bool) {
+	for _, spec := range frameworkSpecs {
+		if spec.Framework == framework {
+			return spec, true
+		}
+	}
+	return FrameworkSpec{}, false
+}
+
+// ValidTestFramework reports whether the framework is supported, TestFrameworkNone is valid
+func ValidTestFramework(framework TestFramework) bool {
+	if framework == TestFrameworkNone {
+		return true
+	}
+	_, ok := LookupFrameworkSpec(framework)
+	return ok
+}
+
+// LanguageSpec converts the spec into a language spec,
+// so the language runners can compile & run the tests
+func (spec FrameworkSpec) LanguageSpec() LanguageSpec {
+	langSpec, _ := LookupLanguageSpec(spec.Language)
+	langSpec.Image = spec.Image
+	langSpec.SourceFileName = spec.SourceFileName
+	langSpec.CompileCmd = spec.CompileCmd
+	langSpec.RunCmd = spec.RunCmd
+	langSpec.DefaultLimits = spec.DefaultLimits
+	langSpec.ValidateSource = nil
+	return langSpec
+}
+
+// ValidateHarnessFileName checks the harness file can be copied into the build dir
+// without replacing the student source
+func (spec FrameworkSpec) ValidateHarnessFileName(name string) error {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return fmt.Errorf("invalid harness file name %q", name)
+	}
+	if name == spec.SourceFileName {
+		return fmt.Errorf("harness file must not be named %q, it's the student source", name)
+	}
+	return nil
+}
@@ -3,16 +3,12 @@ package grading
 import (
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
-)
-
-type Language string
-
-const (
-	LanguageCPP Language = "cpp"
+	"github.com/samber/lo"
 )
 
 type Second int
@@ -27,100 +23,582 @@ func (mib Mib) String() string {
 	return fmt.Sprintf("%dm", mib)
 }
 
+type Kib int64
+
+func (kib Kib) String() string {
+	return fmt.Sprintf("%dk", kib)
+}
+
+func (kib Kib) Bytes() int64 {
+	return int64(kib) << 10
+}
+
+// Limits is the resource limits of a program run
+type Limits struct {
+	TimeLimit        Second
+	MemoryLimit      Mib
+	CompileTimeLimit Second
+	// OutputLimit is the max size of the stdout
+	OutputLimit Kib
+	// ProcessLimit is the max number of processes & threads
+	ProcessLimit int
+}
+
+// limits shared by all languages, used when neither
+// the assignment nor the language set them
+const (
+	DefaultOutputLimit  Kib = 64 << 10
+	DefaultProcessLimit     = 64
+)
+
+// WithDefault fills the unset limits with the defaults
+func (limits Limits) WithDefault(defaults Limits) Limits {
+	if limits.TimeLimit <= 0 {
+		limits.TimeLimit = defaults.TimeLimit
+	}
+	if limits.MemoryLimit <= 0 {
+		limits.MemoryLimit = defaults.MemoryLimit
+	}
+	if limits.CompileTimeLimit <= 0 {
+		limits.CompileTimeLimit = defaults.CompileTimeLimit
+	}
+	if limits.OutputLimit <= 0 {
+		limits.OutputLimit = defaults.OutputLimit
+	}
+	if limits.OutputLimit <= 0 {
+		limits.OutputLimit = DefaultOutputLimit
+	}
+	if limits.ProcessLimit <= 0 {
+		limits.ProcessLimit = defaults.ProcessLimit
+	}
+	if limits.ProcessLimit <= 0 {
+		limits.ProcessLimit = DefaultProcessLimit
+	}
+	return limits
+}
+
+type Verdict string
+
+const (
+	VerdictAccepted            Verdict = "AC"
+	VerdictWrongAnswer         Verdict = "WA"
+	VerdictTimeLimitExceeded   Verdict = "TLE"
+	VerdictMemoryLimitExceeded Verdict = "MLE"
+	VerdictOutputLimitExceeded Verdict = "OLE"
+	VerdictRuntimeError        Verdict = "RE"
+	VerdictCompileError        Verdict = "CE"
+	// VerdictPartiallyCorrect is judged by an interactor, see ProgramInteractor
+	VerdictPartiallyCorrect Verdict = "PC"
+)
+
+// RunStatus is how a program run ended, as reported by the Runner
+type RunStatus string
+
+const (
+	RunStatusOK                  RunStatus = "ok"
+	RunStatusCompileError        RunStatus = "compile_error"
+	RunStatusTimeLimitExceeded   RunStatus = "time_limit_exceeded"
+	RunStatusMemoryLimitExceeded RunStatus = "memory_limit_exceeded"
+	RunStatusOutputLimitExceeded RunStatus = "output_limit_exceeded"
+	RunStatusRuntimeError        RunStatus = "runtime_error"
+)
+
+// Verdict returns the verdict for an unsuccessful run.
+// A successful run has no verdict on its own, it is judged by its output.
+func (status RunStatus) Verdict() Verdict {
+	switch status {
+	case RunStatusCompileError:
+		return VerdictCompileError
+	case RunStatusTimeLimitExceeded:
+		return VerdictTimeLimitExceeded
+	case RunStatusMemoryLimitExceeded:
+		return VerdictMemoryLimitExceeded
+	case RunStatusOutputLimitExceeded:
+		return VerdictOutputLimitExceeded
+	case RunStatusRuntimeError:
+		return VerdictRuntimeError
+	default:
+		return ""
+	}
+}
+
+// StderrExcerptLimit is the max bytes of stderr kept for a test case
+const StderrExcerptLimit = 1024
+
+// CaseExcerptLimit is the max bytes of the input, expected & actual output kept for a sample case
+const CaseExcerptLimit = 8192
+
+// DefaultCompileTimeLimit is used when the compile time limit is not set
+const DefaultCompileTimeLimit Second = 30
+
+// CompileOutputExcerptLimit is the max bytes of compiler diagnostics kept for a submission
+const CompileOutputExcerptLimit = 8192
+
+// Excerpt truncates buf to at most limit bytes
+func Excerpt(buf []byte, limit int) string {
+	if len(buf) <= limit {
+		return string(buf)
+	}
+
+	return string(buf[:limit]) + "..."
+}
+
+type CompileArg struct {
+	MountDir        string
+	ProgramFileName string
+	// BuildDir is where the compiled program is written to,
+	// it is owned by the caller
+	BuildDir string
+	Timeout  Second
+	// SupportFileNames are copied from MountDir into the build dir next to the source,
+	// e.g. the harness files of a unit test assignment
+	SupportFileNames []string
+	// IsProject copies the whole MountDir file tree into the build dir instead of
+	// ProgramFileName, e.g. a multi file submission. ProgramFileName is its entry file.
+	IsProject bool
+	// BuildCmd replaces the language compile command when it's set, e.g. make
+	BuildCmd string
+}
+
+// CompileCmd returns the BuildCmd, or the language compileCmd when it's not set
+func (arg CompileArg) CompileCmd(compileCmd string) string {
+	if arg.BuildCmd != "" {
+		return arg.BuildCmd
+	}
+	return compileCmd
+}
+
+// CompileResult is the result of a compilation.
+// A compile error or timeout is not an error, it is reported in Status instead.
+type CompileResult struct {
+	Status   RunStatus
+	Stderr   []byte
+	ExitCode int
+	Duration time.Duration
+}
+
 type RunnerArg struct {
-	Input           io.Reader
+	Input io.Reader
+	// MountDir is the dir of the source code
 	MountDir        string
 	ProgramFileName string
-	MemLimit        Mib
-	RunTimeout      Second
+	// BuildDir is the dir of the compiled program, see CompileArg
+	BuildDir string
+	// Output receives the stdout while the program runs instead of RunResult.Output,
+	// e.g. the stdin pipe of an interactor. Input must be an *os.File for an interactive run,
+	// so the runner doesn't wait on it once the program exits.
+	Output io.Writer
+	// Args is passed to the program, the program is run inside MountDir
+	Args       []string
+	MemLimit   Mib
+	RunTimeout Second
+	// OutputLimit & ProcessLimit are unlimited when zero
+	OutputLimit  Kib
+	ProcessLimit int
+	// Interactive is set on the runs of an interactor & its program once reserved, see PairReserver
+	Interactive bool
 }
 
+// Runner compiles a program once, then runs the compiled program for each input
 type Runner interface {
+	Compile(arg CompileArg) (CompileResult, error)
 	Run(arg RunnerArg) (RunResult, error)
 }
 
+// PairReserver is a runner whose runs wait on a limited resource, e.g. the isolate boxes.
+// The interactor & its program run at the same time, so the resource of both runs
+// is reserved at once before the Interactive runs, release must be called once both are done.
+type PairReserver interface {
+	ReservePair() (release func(), err error)
+}
+
+// RunResult is the result of a program run.
+// A run that ends with timeout, OOM or non zero exit code
+// is not an error, it is reported in Status instead.
 type RunResult struct {
-	Output []byte
+	Output   []byte
+	Stderr   []byte
+	Status   RunStatus
+	ExitCode int
+	WallTime time.Duration
+	CPUTime  time.Duration
+	// Memory is the peak memory usage, zero when the runner can't measure it
+	Memory Kib
+}
+
+// CompileAndRun compiles the program into a temporary build dir and runs it once
+func CompileAndRun(runner Runner, compileTimeout Second, arg RunnerArg) (CompileResult, RunResult, error) {
+	buildDir, err := os.MkdirTemp("", "autograd-build-*")
+	if err != nil {
+		return CompileResult{}, RunResult{}, fmt.Errorf("create build dir: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	compileRes, err := runner.Compile(CompileArg{
+		MountDir:        arg.MountDir,
+		ProgramFileName: arg.ProgramFileName,
+		BuildDir:        buildDir,
+		Timeout:         compileTimeout,
+	})
+	if err != nil {
+		return CompileResult{}, RunResult{}, fmt.Errorf("compile: %w", err)
+	}
+
+	if compileRes.Status != RunStatusOK {
+		return compileRes, RunResult{}, nil
+	}
+
+	arg.BuildDir = buildDir
+	runRes, err := runner.Run(arg)
+	if err != nil {
+		return CompileResult{}, RunResult{}, fmt.Errorf("run: %w", err)
+	}
+
+	return compileRes, runRes, nil
 }
 
 type SourceCodePath string
 type SourceCodeDir string
 type RelativeFilename string
 
+type TestCase struct {
+	ID       uuid.UUID
+	Name     string
+	Input    string
+	Expected string
+	Weight   int32
+	IsHidden bool
+	// Group is the subtask of the case, see ScoreCases
+	Group string
+}
+
+// TestCaseFromCaseFiles converts the legacy case input & output files into a single test case,
+// the program reads the whole input file and its output is judged by the LineChecker
+func TestCaseFromCaseFiles(inputs, expecteds io.Reader) (TestCase, error) {
+	inputBuf, err := io.ReadAll(inputs)
+	if err != nil {
+		return TestCase{}, fmt.Errorf("read inputs: %w", err)
+	}
+
+	expectedBuf, err := io.ReadAll(expecteds)
+	if err != nil {
+		return TestCase{}, fmt.Errorf("read expecteds: %w", err)
+	}
+
+	// the case files were never shown to the students
+	return TestCase{
+		Name:     "case files",
+		Input:    string(inputBuf),
+		Expected: string(expectedBuf),
+		Weight:   1,
+		IsHidden: true,
+	}, nil
+}
+
+type CaseResult struct {
+	Number int32
+	// Name is the test name of a harness graded case, see GradeHarness
+	Name       string
+	TestCaseID uuid.UUID
+	Verdict    Verdict
+	Output     string
+	ExitCode   int
+	Stderr     string
+	WallTime   time.Duration
+	CPUTime    time.Duration
+	Weight     int32
+	Group      string
+	// PartialPercent is the percent of the weight earned by a partially correct case
+	PartialPercent int32
+	// EarnedWeight is set by ScoreCases
+	EarnedWeight int32
+	IsHidden     bool
+	// Input & Expected are only set for the sample cases
+	Input    string
+	Expected string
+}
+
+func (res CaseResult) IsAccepted() bool {
+	return res.Verdict == VerdictAccepted
+}
+
 type GradeResult struct {
-	Outputs  []string
-	Corrects []bool
+	Cases         []CaseResult
+	CompileOutput string
 }
 
 type GradeRequest struct {
-	Compiler         Runner
+	Compiler Runner
+	// Checker defaults to ExactChecker
+	Checker Checker
+	// Interactor judges the cases instead of the Checker when it is set,
+	// the program talks to it instead of reading the case input
+	Interactor       *ProgramInteractor
+	Limits           Limits
 	RelativeFilename RelativeFilename
 	SourceCodeDir    SourceCodeDir
-	Expecteds        io.Reader
-	Inputs           io.Reader
-	Submission       Submission
+	// IsProject compiles the SourceCodeDir file tree, RelativeFilename is its entry file
+	IsProject  bool
+	BuildCmd   string
+	TestCases  []TestCase
+	Submission Submission
 }
 
+// Grade runs the program once for each test case.
 func Grade(arg GradeRequest) (GradeResult, error) {
 	compiler := arg.Compiler
+	checker := arg.Checker
+	if checker == nil {
+		checker = ExactChecker{}
+	}
+
+	buildDir, err := os.MkdirTemp("", "autograd-build-*")
+	if err != nil {
+		return GradeResult{}, fmt.Errorf("grade: create build dir: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
 
-	runRes, err := compiler.Run(RunnerArg{
+	compileTimeLimit := arg.Limits.CompileTimeLimit
+	if compileTimeLimit <= 0 {
+		compileTimeLimit = DefaultCompileTimeLimit
+	}
+
+	compileRes, err := compiler.Compile(CompileArg{
 		MountDir:        string(arg.SourceCodeDir),
 		ProgramFileName: string(arg.RelativeFilename),
-		Input:           arg.Inputs,
-		MemLimit:        100,
-		RunTimeout:      10,
+		BuildDir:        buildDir,
+		Timeout:         compileTimeLimit,
+		IsProject:       arg.IsProject,
+		BuildCmd:        arg.BuildCmd,
 	})
 	if err != nil {
-		return GradeResult{}, fmt.Errorf("grade: run: %w", err)
+		return GradeResult{}, fmt.Errorf("grade: compile: %w", err)
 	}
 
-	outputs := strings.Split(string(runRes.Output), "\n")
-	expectedbuf, err := io.ReadAll(arg.Expecteds)
-	if err != nil {
-		return GradeResult{}, fmt.Errorf("grade: read expecteds: %w", err)
+	result := GradeResult{
+		CompileOutput: compileOutput(compileRes),
+	}
+
+	// the program is never going to run
+	if compileRes.Status != RunStatusOK {
+		for i, testCase := range arg.TestCases {
+			caseResult := newCaseResult(i, testCase)
+			caseResult.Verdict = VerdictCompileError
+			caseResult.ExitCode = compileRes.ExitCode
+			result.Cases = append(result.Cases, caseResult)
+		}
+		return result, nil
 	}
 
-	expecteds := strings.Split(string(expectedbuf), "\n")
-	if len(outputs) != len(expecteds) {
-		return GradeResult{}, fmt.Errorf("grade: expecteds and outputs length mismatch")
+	if arg.Interactor != nil {
+		if err := arg.Interactor.Compile(); err != nil {
+			return GradeResult{}, fmt.Errorf("grade: %w", err)
+		}
+		defer arg.Interactor.Cleanup()
 	}
 
-	result := GradeResult{}
-	for i, output := range outputs {
-		result.Outputs = append(result.Outputs, output)
-		result.Corrects = append(result.Corrects, output == expecteds[i])
+	if programChecker, ok := checker.(*ProgramChecker); ok {
+		if err := programChecker.Compile(); err != nil {
+			return GradeResult{}, fmt.Errorf("grade: %w", err)
+		}
+		defer programChecker.Cleanup()
+	}
+
+	for i, testCase := range arg.TestCases {
+		caseResult := newCaseResult(i, testCase)
+
+		runArg := RunnerArg{
+			MountDir:        string(arg.SourceCodeDir),
+			ProgramFileName: string(arg.RelativeFilename),
+			BuildDir:        buildDir,
+			Input:           strings.NewReader(testCase.Input),
+			MemLimit:        arg.Limits.MemoryLimit,
+			RunTimeout:      arg.Limits.TimeLimit,
+			OutputLimit:     arg.Limits.OutputLimit,
+			ProcessLimit:    arg.Limits.ProcessLimit,
+		}
+
+		if arg.Interactor != nil {
+			interactRes, err := arg.Interactor.Interact(compiler, runArg, testCase)
+			if err != nil {
+				return GradeResult{}, fmt.Errorf("grade: interact case %d: %w", i+1, err)
+			}
+
+			caseResult.ExitCode = interactRes.Run.ExitCode
+			caseResult.Stderr = Excerpt(interactRes.Run.Stderr, StderrExcerptLimit)
+			caseResult.WallTime = interactRes.Run.WallTime
+			caseResult.CPUTime = interactRes.Run.CPUTime
+			caseResult.Verdict = interactRes.Verdict
+			caseResult.PartialPercent = interactRes.PartialPercent
+			result.Cases = append(result.Cases, caseResult)
+			continue
+		}
+
+		runRes, err := compiler.Run(runArg)
+		if err != nil {
+			return GradeResult{}, fmt.Errorf("grade: run case %d: %w", i+1, err)
+		}
+
+		caseResult.Output = string(runRes.Output)
+		caseResult.ExitCode = runRes.ExitCode
+		caseResult.Stderr = Excerpt(runRes.Stderr, StderrExcerptLimit)
+		caseResult.WallTime = runRes.WallTime
+		caseResult.CPUTime = runRes.CPUTime
+
+		if runRes.Status != RunStatusOK {
+			caseResult.Verdict = runRes.Status.Verdict()
+			result.Cases = append(result.Cases, caseResult)
+			continue
+		}
+
+		caseResult.Verdict, err = checker.Check(CheckRequest{
+			Input:    testCase.Input,
+			Expected: testCase.Expected,
+			Output:   caseResult.Output,
+		})
+		if err != nil {
+			return GradeResult{}, fmt.Errorf("grade: check case %d: %w", i+1, err)
+		}
+
+		result.Cases = append(result.Cases, caseResult)
 	}
 
 	return result, nil
 }
 
+func newCaseResult(i int, testCase TestCase) CaseResult {
+	caseResult := CaseResult{
+		Number:     int32(i + 1),
+		TestCaseID: testCase.ID,
+		Weight:     testCase.Weight,
+		Group:      testCase.Group,
+		IsHidden:   testCase.IsHidden,
+	}
+
+	if !testCase.IsHidden {
+		caseResult.Input = testCase.Input
+		caseResult.Expected = testCase.Expected
+	}
+
+	return caseResult
+}
+
+// compileOutput is the compiler diagnostics shown to the student,
+// warnings of a successful compilation are kept too.
+func compileOutput(res CompileResult) string {
+	output := Excerpt(res.Stderr, CompileOutputExcerptLimit)
+	if res.Status == RunStatusTimeLimitExceeded {
+		output += "\ncompilation time limit exceeded"
+	}
+	return strings.TrimSpace(output)
+}
+
+func trimTrailingNewlines(s string) string {
+	return strings.TrimRight(s, "\r\n")
+}
+
 type Submission struct {
-	ID             uuid.UUID
+	ID uuid.UUID
+	// AttemptID is the graded attempt of the submission
+	AttemptID        uuid.UUID
+	CurrentAttemptID uuid.UUID
+	// Attempts are the grades of every attempt of the submission
+	Attempts []AttemptGrade
+	// Scored is the attempt picked by the assignment score policy, see ScoredAttempt
+	Scored AttemptGrade
+	// SubmittedAt is when the graded attempt is submitted
+	SubmittedAt    time.Time
 	Student        Student
 	Assigner       Assigner
 	Assignment     Assignment
 	SubmissionFile SubmissionFile
 	Grade          int32
+	RawGrade       int32
+	Lateness       Lateness
 	Feedback       string
+	Language       Language
 	UpdatedAt      time.Time
 	IsGraded       bool
+	CaseResults    []CaseResult
+	CompileOutput  string
+	MaxScore       int32
+	// Manual is kept on every grading, see FinalGrade
+	Manual ManualGrade
+}
+
+// GradingState is the part of a submission that may change while its attempt is graded,
+// it's read again right before the grade is saved, see Submission.WithGradingState
+type GradingState struct {
+	CurrentAttemptID uuid.UUID
+	Attempts         []AttemptGrade
+	Manual           ManualGrade
+	TestVersion      int32
+	ScorePolicy      ScorePolicy
 }
 
+// WithGradingState replaces the state read before the grading with the latest one,
+// isCurrent is false when the grade must not be saved: the tests changed,
+// so a regrade is enqueued, or the graded attempt is gone.
+func (submission Submission) WithGradingState(state GradingState) (updated Submission, isCurrent bool) {
+	if state.TestVersion != submission.Assignment.TestVersion {
+		return submission, false
+	}
+
+	_, hasAttempt := lo.Find(state.Attempts, func(attempt AttemptGrade) bool {
+		return attempt.ID == submission.AttemptID
+	})
+	if !hasAttempt {
+		return submission, false
+	}
+
+	submission.CurrentAttemptID = state.CurrentAttemptID
+	submission.Attempts = state.Attempts
+	submission.Manual = state.Manual
+	submission.Assignment.ScorePolicy = state.ScorePolicy
+	return submission, true
+}
+
+// FinalGrade is the grade of the submission, the grade of the scored attempt
+// combined with the manual grade
+func (submission Submission) FinalGrade() (grade int32, maxScore int32) {
+	return submission.Manual.Combine(submission.Scored.Grade, submission.Scored.MaxScore)
+}
+
+// SaveGrade scores the grade result out of the assignment max score, see ScoreCases.
+// The late penalty of the attempt is deducted from the score.
+// The submission grade is the grade of the attempt picked by the assignment score policy.
 func (submission Submission) SaveGrade(now time.Time, grade GradeResult) Submission {
-	var sum int32
-	for _, correct := range grade.Corrects {
-		if correct {
-			sum++
-		}
+	maxScore := submission.Assignment.MaxScore
+	if maxScore <= 0 {
+		maxScore = DefaultMaxScore
 	}
 
-	gradeScore := sum * 100 / int32(len(grade.Corrects))
+	caseResults, score := ScoreCases(grade.Cases, maxScore)
+	lateness := submission.Assignment.LatePolicy.Lateness(submission.Assignment.DeadlineAt, submission.SubmittedAt)
 
-	submission.Grade = gradeScore
+	submission.RawGrade = score
+	submission.Grade = lateness.Apply(score)
+	submission.Lateness = lateness
+	submission.MaxScore = maxScore
+	submission.CaseResults = caseResults
+	submission.CompileOutput = grade.CompileOutput
 	submission.UpdatedAt = now
 	submission.IsGraded = true
 
+	attempts := make([]AttemptGrade, len(submission.Attempts))
+	for i, attempt := range submission.Attempts {
+		if attempt.ID == submission.AttemptID {
+			attempt.Grade = submission.Grade
+			attempt.RawGrade = submission.RawGrade
+			attempt.Lateness = lateness
+			attempt.MaxScore = maxScore
+			attempt.IsGraded = true
+		}
+		attempts[i] = attempt
+	}
+	submission.Attempts = attempts
+	submission.Scored = ScoredAttempt(submission.Assignment.ScorePolicy, submission.CurrentAttemptID, attempts)
+
 	return submission
 }
 
@@ -136,10 +614,37 @@ type Student struct {
 }
 
 type Assignment struct {
-	ID             uuid.UUID
-	DeadlineAt     time.Time
+	ID         uuid.UUID
+	DeadlineAt time.Time
+	TestCases  []TestCase
+	Checker    CheckerConfig
+	// Limits overrides the language default limits, see Limits.WithDefault
+	Limits         Limits
+	MaxScore       int32
+	ScorePolicy    ScorePolicy
+	LatePolicy     LatePolicy
 	CaseInputFile  CaseInputFile
 	CaseOutputFile CaseOutputFile
+	// TestVersion is bumped on every test change of the assignment
+	TestVersion int32
+	// TestFramework grades the submission with the HarnessFiles, see GradeHarness
+	TestFramework TestFramework
+	HarnessFiles  []HarnessFile
+	// BuildCommand replaces the language compile command, see CompileArg.BuildCmd
+	BuildCommand string
+}
+
+// WithExtendedDeadline resolves the deadline of a student with an extension
+func (assignment Assignment) WithExtendedDeadline(deadlineAt time.Time) Assignment {
+	assignment.LatePolicy = assignment.LatePolicy.Extend(assignment.DeadlineAt, deadlineAt)
+	assignment.DeadlineAt = deadlineAt
+	return assignment
+}
+
+// HasCaseFiles reports whether the assignment still uses
+// the legacy case input & output files
+func (assignment Assignment) HasCaseFiles() bool {
+	return assignment.CaseInputFile.File != nil && assignment.CaseOutputFile.File != nil
 }
 
 type SubmissionFile struct {
@@ -148,6 +653,11 @@ type SubmissionFile struct {
 	File     io.ReadCloser
 }
 
+type HarnessFile struct {
+	Name string
+	File io.ReadCloser
+}
+
 type CaseInputFile struct {
 	File io.ReadCloser
 }
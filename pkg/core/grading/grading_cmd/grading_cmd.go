@@ -18,14 +18,39 @@ import (
 
 type GradingCmd struct {
 	*core.Ctx
+	// Registry defaults to podman.DefaultRegistry
+	Registry *grading.Registry
+}
+
+var defaultRegistry = podman.DefaultRegistry()
+
+// SetDefaultRegistry replaces the registry used when GradingCmd.Registry is nil,
+// it must be called before any grading starts
+func SetDefaultRegistry(registry *grading.Registry) {
+	defaultRegistry = registry
+}
+
+func (cmd *GradingCmd) registry() *grading.Registry {
+	if cmd.Registry != nil {
+		return cmd.Registry
+	}
+	return defaultRegistry
 }
 
 type InternalGradeSubmissionRequest struct {
 	SubmissionID uuid.UUID
+	// AttemptID defaults to the current attempt
+	AttemptID uuid.UUID
+	// TestVersion is the assignment test version a regrade is requested for,
+	// the regrade is skipped once the tests changed again. Zero always grades.
+	TestVersion int32
 }
 
 type InternalGradeSubmissionResult struct {
 	SubmissionID uuid.UUID
+	// IsStale is true when the regrade is skipped or the grade isn't saved,
+	// as the tests changed or the attempt is gone while grading
+	IsStale bool
 }
 
 // InternalCreateMacSandBoxRules creates mac sandbox rules
@@ -75,35 +100,56 @@ func (cmd *GradingCmd) InternalGradeSubmissionTx(
 	tx *gorm.DB,
 	req InternalGradeSubmissionRequest,
 ) (InternalGradeSubmissionResult, error) {
-	submission, err := grading.SubmissionReader{}.FindByID(ctx, cmd.GormDB, cmd.ObjectStorer, cmd.RootDir, req.SubmissionID)
+	submission, err := grading.SubmissionReader{}.FindByID(ctx, tx, cmd.ObjectStorer, cmd.RootDir, req.SubmissionID, req.AttemptID)
 	if err != nil {
 		return InternalGradeSubmissionResult{}, fmt.Errorf("InternalGradeSubmissionTx: find submission: %w", err)
 	}
 	defer func() {
 		submission.SubmissionFile.File.Close()
-		submission.Assignment.CaseInputFile.File.Close()
-		submission.Assignment.CaseOutputFile.File.Close()
+		if submission.Assignment.HasCaseFiles() {
+			submission.Assignment.CaseInputFile.File.Close()
+			submission.Assignment.CaseOutputFile.File.Close()
+		}
+		for _, harnessFile := range submission.Assignment.HarnessFiles {
+			harnessFile.File.Close()
+		}
 	}()
 
-	submissionFilePath := submission.SubmissionFile.FilePath
-	fileDir, _ := path.Split(path.Join(cmd.RootDir, submissionFilePath))
+	// a newer regrade of the assignment is enqueued
+	if req.TestVersion != 0 && req.TestVersion != submission.Assignment.TestVersion {
+		return InternalGradeSubmissionResult{
+			SubmissionID: submission.ID,
+			IsStale:      true,
+		}, nil
+	}
 
-	compiler := &podman.CPP{}
+	var gradeRes grading.GradeResult
+	if submission.Assignment.TestFramework != grading.TestFrameworkNone {
+		gradeRes, err = cmd.gradeHarness(submission)
+	} else {
+		gradeRes, err = cmd.gradeTestCases(submission)
+	}
+	if err != nil {
+		return InternalGradeSubmissionResult{}, fmt.Errorf("InternalGradeSubmissionTx: %w", err)
+	}
 
-	gradeRes, err := grading.Grade(grading.GradeRequest{
-		Compiler:         compiler,
-		RelativeFilename: grading.RelativeFilename(submission.SubmissionFile.FileName),
-		SourceCodeDir:    grading.SourceCodeDir(fileDir),
-		Inputs:           submission.Assignment.CaseInputFile.File,
-		Expecteds:        submission.Assignment.CaseOutputFile.File,
-	})
+	// the row is only locked to save the grade, not while the cases run
+	state, err := grading.SubmissionReader{}.FindGradingStateForUpdate(ctx, tx, submission.ID)
+	if core.IsDBNotFoundErr(err) {
+		return InternalGradeSubmissionResult{SubmissionID: submission.ID, IsStale: true}, nil
+	}
 	if err != nil {
-		return InternalGradeSubmissionResult{}, fmt.Errorf("InternalGradeSubmissionTx: grade: %w", err)
+		return InternalGradeSubmissionResult{}, fmt.Errorf("InternalGradeSubmissionTx: find grading state: %w", err)
+	}
+
+	submission, isCurrent := submission.WithGradingState(state)
+	if !isCurrent {
+		return InternalGradeSubmissionResult{SubmissionID: submission.ID, IsStale: true}, nil
 	}
 
 	submission = submission.SaveGrade(time.Now(), gradeRes)
 
-	err = grading.SubmissionWriter{}.Update(ctx, cmd.GormDB, &submission)
+	err = grading.SubmissionWriter{}.Update(ctx, tx, &submission)
 	if err != nil {
 		return InternalGradeSubmissionResult{}, fmt.Errorf("InternalGradeSubmissionTx: update submission: %w", err)
 	}
@@ -112,3 +158,106 @@ func (cmd *GradingCmd) InternalGradeSubmissionTx(
 		SubmissionID: submission.ID,
 	}, nil
 }
+
+// gradeTestCases grades the submission with the test cases,
+// or with the legacy case files when the assignment has no test case
+func (cmd *GradingCmd) gradeTestCases(submission grading.Submission) (grading.GradeResult, error) {
+	testCases := submission.Assignment.TestCases
+	isCaseFiles := len(testCases) == 0 && submission.Assignment.HasCaseFiles()
+	if isCaseFiles {
+		testCase, err := grading.TestCaseFromCaseFiles(
+			submission.Assignment.CaseInputFile.File,
+			submission.Assignment.CaseOutputFile.File,
+		)
+		if err != nil {
+			return grading.GradeResult{}, fmt.Errorf("read case files: %w", err)
+		}
+		testCases = []grading.TestCase{testCase}
+	}
+
+	if len(testCases) == 0 {
+		return grading.GradeResult{}, fmt.Errorf("assignment has no test case")
+	}
+
+	submissionFilePath := submission.SubmissionFile.FilePath
+	fileDir, _ := path.Split(path.Join(cmd.RootDir, submissionFilePath))
+	fileName := submission.SubmissionFile.FileName
+
+	entry, err := cmd.registry().Lookup(submission.Language)
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("lookup runner: %w", err)
+	}
+
+	// a multi file submission is compiled from its extracted files
+	isProject := grading.IsSourceArchive(fileName)
+	if isProject {
+		fileDir, err = extractSourceArchive(submission.SubmissionFile.File)
+		if err != nil {
+			return grading.GradeResult{}, fmt.Errorf("extract source archive: %w", err)
+		}
+		defer os.RemoveAll(fileDir)
+		fileName = entry.Spec.SourceFileName
+	}
+
+	// checker program is always written in c++
+	checkerEntry, err := cmd.registry().Lookup(grading.LanguageCPP)
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("lookup checker runner: %w", err)
+	}
+
+	// an interactor judges the cases on its own,
+	// the output of the legacy case files is judged line by line
+	var checker grading.Checker
+	interactor := grading.NewInteractor(submission.Assignment.Checker, checkerEntry.Runner)
+	if interactor == nil && isCaseFiles {
+		checker = grading.LineChecker{}
+	} else if interactor == nil {
+		checker, err = grading.NewChecker(submission.Assignment.Checker, checkerEntry.Runner)
+		if err != nil {
+			return grading.GradeResult{}, fmt.Errorf("new checker: %w", err)
+		}
+	}
+
+	gradeRes, err := grading.Grade(grading.GradeRequest{
+		Compiler:         entry.Runner,
+		Checker:          checker,
+		Interactor:       interactor,
+		Limits:           submission.Assignment.Limits.WithDefault(entry.Spec.DefaultLimits),
+		RelativeFilename: grading.RelativeFilename(fileName),
+		SourceCodeDir:    grading.SourceCodeDir(fileDir),
+		IsProject:        isProject,
+		BuildCmd:         submission.Assignment.BuildCommand,
+		TestCases:        testCases,
+	})
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("grade: %w", err)
+	}
+
+	return gradeRes, nil
+}
+
+// extractSourceArchive writes the files of the stored source archive into a new temp dir,
+// the caller removes the dir
+func extractSourceArchive(file io.Reader) (string, error) {
+	archive, err := io.ReadAll(io.LimitReader(file, grading.MaxSourceArchiveSize+1))
+	if err != nil {
+		return "", fmt.Errorf("read archive: %w", err)
+	}
+
+	files, err := grading.ReadSourceArchive(archive)
+	if err != nil {
+		return "", fmt.Errorf("read source files: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "autograd-project-*")
+	if err != nil {
+		return "", fmt.Errorf("create source dir: %w", err)
+	}
+
+	if err := grading.WriteSourceFiles(dir, files); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("write source files: %w", err)
+	}
+
+	return dir, nil
+}
@@ -0,0 +1,77 @@
+package grading_cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+)
+
+// gradeHarness grades the submission with the harness files of the assignment test framework.
+// The submission & the harness files are copied into a temporary dir mounted by the runner.
+func (cmd *GradingCmd) gradeHarness(submission grading.Submission) (grading.GradeResult, error) {
+	entry, err := cmd.registry().LookupFramework(submission.Assignment.TestFramework)
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("lookup framework runner: %w", err)
+	}
+
+	if entry.Spec.Language != submission.Language {
+		return grading.GradeResult{}, fmt.Errorf("%s submission can't be graded with %s", submission.Language, submission.Assignment.TestFramework)
+	}
+
+	if grading.IsSourceArchive(submission.SubmissionFile.FileName) {
+		return grading.GradeResult{}, fmt.Errorf("multi file submission can't be graded with %s", submission.Assignment.TestFramework)
+	}
+
+	sourceDir, err := os.MkdirTemp("", "autograd-harness-*")
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("create source dir: %w", err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	fileName := submission.SubmissionFile.FileName
+	if err := writeFile(filepath.Join(sourceDir, fileName), submission.SubmissionFile.File); err != nil {
+		return grading.GradeResult{}, fmt.Errorf("write submission: %w", err)
+	}
+
+	harnessFileNames := []string{}
+	for _, harnessFile := range submission.Assignment.HarnessFiles {
+		if harnessFile.Name == fileName {
+			return grading.GradeResult{}, fmt.Errorf("harness file %s has the submission file name", harnessFile.Name)
+		}
+
+		if err := writeFile(filepath.Join(sourceDir, harnessFile.Name), harnessFile.File); err != nil {
+			return grading.GradeResult{}, fmt.Errorf("write harness %s: %w", harnessFile.Name, err)
+		}
+		harnessFileNames = append(harnessFileNames, harnessFile.Name)
+	}
+
+	gradeRes, err := grading.GradeHarness(grading.GradeHarnessRequest{
+		Compiler:         entry.Runner,
+		Limits:           submission.Assignment.Limits.WithDefault(entry.Spec.DefaultLimits),
+		RelativeFilename: grading.RelativeFilename(fileName),
+		SourceCodeDir:    grading.SourceCodeDir(sourceDir),
+		HarnessFileNames: harnessFileNames,
+	})
+	if err != nil {
+		return grading.GradeResult{}, fmt.Errorf("grade harness: %w", err)
+	}
+
+	return gradeRes, nil
+}
+
+func writeFile(name string, src io.Reader) error {
+	dst, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return err
+	}
+
+	return dst.Close()
+}
@@ -0,0 +1,73 @@
+package grading_cmd
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+// userRateLimiter is a token bucket for each user
+type userRateLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[uuid.UUID]*rate.Limiter
+}
+
+func newUserRateLimiter(limit rate.Limit, burst int) *userRateLimiter {
+	return &userRateLimiter{
+		limit:    limit,
+		burst:    burst,
+		limiters: make(map[uuid.UUID]*rate.Limiter),
+	}
+}
+
+// Allow takes a token from the user bucket, it reports false when the bucket is empty
+func (l *userRateLimiter) Allow(userID uuid.UUID, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[userID]
+	if !ok {
+		l.removeFull(now)
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[userID] = limiter
+	}
+
+	return limiter.AllowN(now, 1)
+}
+
+// removeFull removes the refilled buckets, they are the same as a new one
+func (l *userRateLimiter) removeFull(now time.Time) {
+	for userID, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(l.burst) {
+			delete(l.limiters, userID)
+		}
+	}
+}
+
+// concurrencyLimiter caps the number of runs in progress across every user
+type concurrencyLimiter struct {
+	slots chan struct{}
+}
+
+func newConcurrencyLimiter(max int) *concurrencyLimiter {
+	return &concurrencyLimiter{slots: make(chan struct{}, max)}
+}
+
+// TryAcquire takes a slot without waiting, it reports false when every slot is taken.
+// Release must be called once the run is done.
+func (l *concurrencyLimiter) TryAcquire() bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *concurrencyLimiter) Release() {
+	<-l.slots
+}
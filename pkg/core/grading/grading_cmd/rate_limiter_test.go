@@ -0,0 +1,44 @@
+package grading_cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+func TestUserRateLimiter(t *testing.T) {
+	limiter := newUserRateLimiter(rate.Every(time.Second), 2)
+	now := time.Now()
+	user, otherUser := uuid.New(), uuid.New()
+
+	if !limiter.Allow(user, now) || !limiter.Allow(user, now) {
+		t.Fatalf("want the burst allowed")
+	}
+	if limiter.Allow(user, now) {
+		t.Errorf("want a run over the burst rejected")
+	}
+	if !limiter.Allow(otherUser, now) {
+		t.Errorf("want another user allowed")
+	}
+	if !limiter.Allow(user, now.Add(time.Second)) {
+		t.Errorf("want a run allowed once the bucket refills")
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	limiter := newConcurrencyLimiter(2)
+
+	if !limiter.TryAcquire() || !limiter.TryAcquire() {
+		t.Fatalf("want the slots acquired")
+	}
+	if limiter.TryAcquire() {
+		t.Errorf("want a run over the limit rejected")
+	}
+
+	limiter.Release()
+	if !limiter.TryAcquire() {
+		t.Errorf("want a released slot acquired")
+	}
+}
@@ -0,0 +1,142 @@
+package grading_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"golang.org/x/time/rate"
+)
+
+// limits of a custom input run
+const (
+	maxRunCodeSourceSize = 64 << 10
+	maxRunCodeStdinSize  = 1 << 20
+	// runCodeOutputLimit is lower than the grading default,
+	// the output is sent back in the response
+	runCodeOutputLimit grading.Kib = 1 << 10
+	runCodeStderrLimit             = 64 << 10
+)
+
+// a user may run 10 programs per minute, with a burst of 5
+var runCodeLimiter = newUserRateLimiter(rate.Every(6*time.Second), 5)
+
+// at most 8 programs run at once, so the runs of many users don't saturate the grading runners
+var runCodeSlots = newConcurrencyLimiter(8)
+
+// RunCode compiles & runs the source against a custom input,
+// it's for trying out the code, no submission is created.
+func (cmd *GradingCmd) RunCode(ctx context.Context, req *connect.Request[autogradv1.RunCodeRequest]) (
+	*connect.Response[autogradv1.RunCodeResponse], error,
+) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.Can(auth.RunCode) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	lang := grading.Language(req.Msg.GetLanguage())
+	if !grading.ValidLanguage(lang) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid language %q", lang))
+	}
+
+	source := req.Msg.GetSourceCode()
+	if strings.TrimSpace(source) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source code is empty"))
+	}
+
+	if len(source) > maxRunCodeSourceSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source code is too large"))
+	}
+
+	if len(req.Msg.GetStdin()) > maxRunCodeStdinSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stdin is too large"))
+	}
+
+	if err := lang.ValidateSource(source); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// a run rejected for the busy runners doesn't take the user token
+	if !runCodeSlots.TryAcquire() {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many runs in progress, try again later"))
+	}
+	defer runCodeSlots.Release()
+
+	if !runCodeLimiter.Allow(authUser.UserID, time.Now()) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many runs, try again later"))
+	}
+
+	entry, err := cmd.registry().Lookup(lang)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "GradingCmd: RunCode: lookup runner")
+		return nil, core.ErrInternalServer
+	}
+
+	sourceDir, err := os.MkdirTemp("", "autograd-run-*")
+	if err != nil {
+		logs.ErrCtx(ctx, err, "GradingCmd: RunCode: create source dir")
+		return nil, core.ErrInternalServer
+	}
+	defer os.RemoveAll(sourceDir)
+
+	programFileName := "main" + lang.Ext()
+	err = os.WriteFile(filepath.Join(sourceDir, programFileName), []byte(source), 0644)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "GradingCmd: RunCode: write source")
+		return nil, core.ErrInternalServer
+	}
+
+	limits := grading.Limits{OutputLimit: runCodeOutputLimit}.WithDefault(entry.Spec.DefaultLimits)
+	compileTimeLimit := limits.CompileTimeLimit
+	if compileTimeLimit <= 0 {
+		compileTimeLimit = grading.DefaultCompileTimeLimit
+	}
+
+	compileRes, runRes, err := grading.CompileAndRun(entry.Runner, compileTimeLimit, grading.RunnerArg{
+		MountDir:        sourceDir,
+		ProgramFileName: programFileName,
+		Input:           strings.NewReader(req.Msg.GetStdin()),
+		MemLimit:        limits.MemoryLimit,
+		RunTimeout:      limits.TimeLimit,
+		OutputLimit:     limits.OutputLimit,
+		ProcessLimit:    limits.ProcessLimit,
+	})
+	if err != nil {
+		logs.ErrCtx(ctx, err, "GradingCmd: RunCode: CompileAndRun")
+		return nil, core.ErrInternalServer
+	}
+
+	res := &autogradv1.RunCodeResponse{
+		CompileOutput: strings.TrimSpace(grading.Excerpt(compileRes.Stderr, grading.CompileOutputExcerptLimit)),
+	}
+
+	if compileRes.Status != grading.RunStatusOK {
+		res.Status = string(grading.RunStatusCompileError)
+		res.ExitCode = int32(compileRes.ExitCode)
+		return &connect.Response[autogradv1.RunCodeResponse]{Msg: res}, nil
+	}
+
+	res.Status = string(runRes.Status)
+	res.Stdout = string(runRes.Output)
+	res.Stderr = grading.Excerpt(runRes.Stderr, runCodeStderrLimit)
+	res.ExitCode = int32(runRes.ExitCode)
+	res.WallTimeMs = runRes.WallTime.Milliseconds()
+	res.CpuTimeMs = runRes.CPUTime.Milliseconds()
+	res.MemoryKib = int64(runRes.Memory)
+
+	return &connect.Response[autogradv1.RunCodeResponse]{Msg: res}, nil
+}
@@ -0,0 +1,131 @@
+package grading
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// stdinRecorder runs a program whose output is fixed, it records the stdin of the run
+type stdinRecorder struct {
+	output string
+	stdin  string
+}
+
+func (recorder *stdinRecorder) Compile(arg CompileArg) (CompileResult, error) {
+	return CompileResult{Status: RunStatusOK}, nil
+}
+
+func (recorder *stdinRecorder) Run(arg RunnerArg) (RunResult, error) {
+	stdin, err := io.ReadAll(arg.Input)
+	if err != nil {
+		return RunResult{}, err
+	}
+	recorder.stdin = string(stdin)
+	return RunResult{Status: RunStatusOK, Output: []byte(recorder.output)}, nil
+}
+
+func TestGradeCaseFiles(t *testing.T) {
+	const inputPath, expectedPath = "../../../testdata/input_output/1_a.in.txt", "../../../testdata/input_output/1_a.out.txt"
+	input, err := os.ReadFile(inputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		verdict Verdict
+	}{
+		{"accepted", "2\n4\n27\n", VerdictAccepted},
+		{"accepted without trailing newline", "2\r\n4\r\n27", VerdictAccepted},
+		{"wrong line", "2\n4\n28\n", VerdictWrongAnswer},
+		{"missing line", "2\n4\n", VerdictWrongAnswer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs, err := os.Open(inputPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer inputs.Close()
+
+			expecteds, err := os.Open(expectedPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer expecteds.Close()
+
+			testCase, err := TestCaseFromCaseFiles(inputs, expecteds)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !testCase.IsHidden {
+				t.Errorf("want the case files hidden")
+			}
+
+			runner := &stdinRecorder{output: tt.output}
+			res, err := Grade(GradeRequest{
+				Compiler:  runner,
+				Checker:   LineChecker{},
+				TestCases: []TestCase{testCase},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if runner.stdin != string(input) {
+				t.Errorf("want the whole input file as stdin, got %q", runner.stdin)
+			}
+			if len(res.Cases) != 1 {
+				t.Fatalf("want a single case, got %d", len(res.Cases))
+			}
+			if res.Cases[0].Verdict != tt.verdict {
+				t.Errorf("want %s, got %s", tt.verdict, res.Cases[0].Verdict)
+			}
+		})
+	}
+}
+
+func TestSubmissionWithGradingState(t *testing.T) {
+	graded, newer := uuid.New(), uuid.New()
+	submission := Submission{
+		AttemptID:        graded,
+		CurrentAttemptID: graded,
+		Attempts:         []AttemptGrade{{ID: graded, Number: 1}},
+		Assignment:       Assignment{TestVersion: 2, ScorePolicy: ScorePolicyLast},
+	}
+	attempts := []AttemptGrade{{ID: graded, Number: 1}, {ID: newer, Number: 2, Grade: 10, MaxScore: 10, IsGraded: true}}
+
+	tests := []struct {
+		name      string
+		state     GradingState
+		isCurrent bool
+	}{
+		{"same tests", GradingState{CurrentAttemptID: newer, Attempts: attempts, TestVersion: 2, ScorePolicy: ScorePolicyBest}, true},
+		{"tests changed", GradingState{CurrentAttemptID: newer, Attempts: attempts, TestVersion: 3}, false},
+		{"attempt is gone", GradingState{CurrentAttemptID: newer, Attempts: attempts[1:], TestVersion: 2}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, isCurrent := submission.WithGradingState(tt.state)
+			if isCurrent != tt.isCurrent {
+				t.Fatalf("want is current %t, got %t", tt.isCurrent, isCurrent)
+			}
+			if !isCurrent {
+				return
+			}
+
+			if updated.CurrentAttemptID != newer || len(updated.Attempts) != 2 || updated.Assignment.ScorePolicy != ScorePolicyBest {
+				t.Errorf("want the latest grading state, got %+v", updated)
+			}
+			if updated.AttemptID != graded {
+				t.Errorf("want graded attempt %s, got %s", graded, updated.AttemptID)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package grading
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type GradeHarnessRequest struct {
+	// Compiler is the runner of the test framework, see Registry.LookupFramework
+	Compiler         Runner
+	Limits           Limits
+	RelativeFilename RelativeFilename
+	SourceCodeDir    SourceCodeDir
+	// HarnessFileNames are the teacher test files inside SourceCodeDir
+	HarnessFileNames []string
+}
+
+// GradeHarness compiles the submission with the harness files & runs the tests once.
+// Each test of the JUnit XML report printed by the run is a case with weight 1.
+// When the tests can't run to the end, the submission gets a single case of the run verdict.
+func GradeHarness(arg GradeHarnessRequest) (GradeResult, error) {
+	buildDir, err := os.MkdirTemp("", "autograd-build-*")
+	if err != nil {
+		return GradeResult{}, fmt.Errorf("grade harness: create build dir: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	compileTimeLimit := arg.Limits.CompileTimeLimit
+	if compileTimeLimit <= 0 {
+		compileTimeLimit = DefaultCompileTimeLimit
+	}
+
+	compileRes, err := arg.Compiler.Compile(CompileArg{
+		MountDir:         string(arg.SourceCodeDir),
+		ProgramFileName:  string(arg.RelativeFilename),
+		BuildDir:         buildDir,
+		Timeout:          compileTimeLimit,
+		SupportFileNames: arg.HarnessFileNames,
+	})
+	if err != nil {
+		return GradeResult{}, fmt.Errorf("grade harness: compile: %w", err)
+	}
+
+	result := GradeResult{
+		CompileOutput: compileOutput(compileRes),
+	}
+
+	if compileRes.Status != RunStatusOK {
+		result.Cases = []CaseResult{harnessCaseResult(VerdictCompileError, compileRes.ExitCode, "")}
+		return result, nil
+	}
+
+	runRes, err := arg.Compiler.Run(RunnerArg{
+		MountDir:        string(arg.SourceCodeDir),
+		ProgramFileName: string(arg.RelativeFilename),
+		BuildDir:        buildDir,
+		Input:           strings.NewReader(""),
+		MemLimit:        arg.Limits.MemoryLimit,
+		RunTimeout:      arg.Limits.TimeLimit,
+		OutputLimit:     arg.Limits.OutputLimit,
+		ProcessLimit:    arg.Limits.ProcessLimit,
+	})
+	if err != nil {
+		return GradeResult{}, fmt.Errorf("grade harness: run: %w", err)
+	}
+
+	stderr := Excerpt(runRes.Stderr, StderrExcerptLimit)
+
+	if runRes.Status != RunStatusOK && runRes.Status != RunStatusRuntimeError {
+		result.Cases = []CaseResult{harnessCaseResult(runRes.Status.Verdict(), runRes.ExitCode, stderr)}
+		return result, nil
+	}
+
+	// the failed tests make the run exit with non zero code,
+	// a crash of the tests is told apart by its missing or broken report
+	tests, err := ParseJUnitReport(runRes.Output)
+	if err != nil || len(tests) == 0 {
+		result.Cases = []CaseResult{harnessCaseResult(VerdictRuntimeError, runRes.ExitCode, stderr)}
+		return result, nil
+	}
+
+	for i, test := range tests {
+		result.Cases = append(result.Cases, CaseResult{
+			Number:   int32(i + 1),
+			Name:     test.Name,
+			Verdict:  test.Verdict,
+			ExitCode: runRes.ExitCode,
+			Stderr:   Excerpt([]byte(test.Message), StderrExcerptLimit),
+			WallTime: test.WallTime,
+			Weight:   1,
+		})
+	}
+
+	return result, nil
+}
+
+func harnessCaseResult(verdict Verdict, exitCode int, stderr string) CaseResult {
+	return CaseResult{
+		Number:   1,
+		Name:     "tests",
+		Verdict:  verdict,
+		ExitCode: exitCode,
+		Stderr:   stderr,
+		Weight:   1,
+	}
+}
@@ -0,0 +1,211 @@
+package grading
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultInteractorLimits is used when the assignment doesn't set the interactor limits
+var DefaultInteractorLimits = Limits{TimeLimit: 10, MemoryLimit: 256}
+
+// interactor exit codes, in testlib style
+const (
+	interactorExitWrongAnswer       = 1
+	interactorExitPresentationError = 2
+	interactorExitPoints            = 7
+)
+
+// ProgramInteractor runs a teacher supplied c++ interactor alongside the graded program,
+// the interactor stdout is the program stdin and the program stdout is the interactor stdin:
+//
+//	interactor <input-file> <answer-file>
+//
+// The input & answer files are the test case input & expected output.
+// The interactor exits with 0 when the program is accepted, 1 or 2 when it is wrong,
+// or 7 with "points <fraction>" as the first stderr line when it's partially correct,
+// e.g. "points 0.5" earns half the case weight. Any other outcome is an interactor failure.
+type ProgramInteractor struct {
+	Runner Runner
+	Source string
+	// Limits of the interactor, only the time & memory limits are used
+	Limits Limits
+
+	program teacherProgram
+}
+
+// InteractResult is the outcome of a test case judged by the interactor
+type InteractResult struct {
+	// Run is the graded program run, its output is streamed to the interactor
+	Run            RunResult
+	Verdict        Verdict
+	PartialPercent int32
+}
+
+// Compile compiles the interactor once for every test case, Cleanup must be called after
+func (interactor *ProgramInteractor) Compile() error {
+	if interactor.Runner == nil {
+		return errors.New("interactor: runner is required")
+	}
+
+	program, err := compileTeacherProgram(interactor.Runner, "interactor.cpp", interactor.Source)
+	interactor.program = program
+	if err != nil {
+		return fmt.Errorf("interactor: %w", err)
+	}
+
+	return nil
+}
+
+func (interactor *ProgramInteractor) Cleanup() {
+	interactor.program.cleanup()
+}
+
+// Interact runs the compiled program with its stdin & stdout wired to the interactor.
+// Both runs end when either side exits, the other side reads EOF.
+func (interactor *ProgramInteractor) Interact(program Runner, arg RunnerArg, testCase TestCase) (InteractResult, error) {
+	files := map[string]string{
+		"input.txt":  testCase.Input,
+		"answer.txt": testCase.Expected,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(interactor.program.dir, name), []byte(content), 0644); err != nil {
+			return InteractResult{}, fmt.Errorf("interactor: write %s: %w", name, err)
+		}
+	}
+
+	// the interactor shares the runner resource of the program, e.g. the isolate box pool
+	reserver, isReserved := program.(PairReserver)
+	if isReserved {
+		release, err := reserver.ReservePair()
+		if err != nil {
+			return InteractResult{}, fmt.Errorf("interactor: %w", err)
+		}
+		defer release()
+	}
+
+	programIn, interactorOut, err := os.Pipe()
+	if err != nil {
+		return InteractResult{}, fmt.Errorf("interactor: create pipe: %w", err)
+	}
+
+	interactorIn, programOut, err := os.Pipe()
+	if err != nil {
+		programIn.Close()
+		interactorOut.Close()
+		return InteractResult{}, fmt.Errorf("interactor: create pipe: %w", err)
+	}
+
+	limits := interactor.Limits.WithDefault(DefaultInteractorLimits)
+
+	var interactorRes RunResult
+	var interactorErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		interactorRes, interactorErr = interactor.Runner.Run(RunnerArg{
+			Input:           interactorIn,
+			Output:          interactorOut,
+			MountDir:        interactor.program.dir,
+			ProgramFileName: interactor.program.fileName,
+			BuildDir:        interactor.program.buildDir,
+			Args:            []string{"input.txt", "answer.txt"},
+			Interactive:     isReserved,
+			MemLimit:        limits.MemoryLimit,
+			// the interactor waits for the program, so it gets the program time too
+			RunTimeout: limits.TimeLimit + arg.RunTimeout,
+		})
+		interactorOut.Close()
+		interactorIn.Close()
+	}()
+
+	arg.Input = programIn
+	arg.Output = programOut
+	arg.Interactive = isReserved
+	programRes, programErr := program.Run(arg)
+	programOut.Close()
+	programIn.Close()
+
+	wg.Wait()
+
+	if programErr != nil {
+		return InteractResult{}, fmt.Errorf("interactor: run program: %w", programErr)
+	}
+
+	if interactorErr != nil {
+		return InteractResult{}, fmt.Errorf("interactor: run: %w", interactorErr)
+	}
+
+	verdict, partialPercent, err := interactorVerdict(interactorRes, programRes)
+	if err != nil {
+		return InteractResult{}, err
+	}
+
+	return InteractResult{
+		Run:            programRes,
+		Verdict:        verdict,
+		PartialPercent: partialPercent,
+	}, nil
+}
+
+// interactorVerdict judges the program by the interactor exit code.
+// A program over its limits is judged by its status, the interactor reads EOF once it's killed.
+// Then a wrong answer from the interactor comes first, the program may crash after the interactor quits.
+// Otherwise a failed program run is judged by its status, the interactor may fail waiting on it.
+func interactorVerdict(interactorRes, programRes RunResult) (Verdict, int32, error) {
+	switch programRes.Status {
+	case RunStatusTimeLimitExceeded, RunStatusMemoryLimitExceeded, RunStatusOutputLimitExceeded:
+		return programRes.Status.Verdict(), 0, nil
+	}
+
+	exitCode := interactorRes.ExitCode
+	isWrongAnswer := interactorRes.Status == RunStatusRuntimeError &&
+		(exitCode == interactorExitWrongAnswer || exitCode == interactorExitPresentationError)
+	if isWrongAnswer {
+		return VerdictWrongAnswer, 0, nil
+	}
+
+	if programRes.Status != RunStatusOK {
+		return programRes.Status.Verdict(), 0, nil
+	}
+
+	switch {
+	case interactorRes.Status == RunStatusOK:
+		return VerdictAccepted, 0, nil
+	case interactorRes.Status == RunStatusRuntimeError && exitCode == interactorExitPoints:
+		percent, err := parsePoints(interactorRes.Stderr)
+		if err != nil {
+			return "", 0, fmt.Errorf("interactor: %w", err)
+		}
+		if percent >= 100 {
+			return VerdictAccepted, 0, nil
+		}
+		return VerdictPartiallyCorrect, percent, nil
+	default:
+		return "", 0, fmt.Errorf("interactor: %s with exit code %d: %s",
+			interactorRes.Status, exitCode, Excerpt(interactorRes.Stderr, StderrExcerptLimit))
+	}
+}
+
+// parsePoints parses "points <fraction>" of the first stderr line into a percent
+func parsePoints(stderr []byte) (int32, error) {
+	line, _ := bufio.NewReader(bytes.NewReader(stderr)).ReadString('\n')
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "points" {
+		return 0, fmt.Errorf("points not reported: %q", Excerpt([]byte(line), StderrExcerptLimit))
+	}
+
+	fraction, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil || fraction < 0 || fraction > 1 {
+		return 0, fmt.Errorf("points must be between 0 and 1: %q", fields[1])
+	}
+
+	return int32(fraction * 100), nil
+}
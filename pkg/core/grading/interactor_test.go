@@ -0,0 +1,130 @@
+package grading
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// funcRunner runs a go func as the compiled program
+type funcRunner func(arg RunnerArg) RunResult
+
+func (funcRunner) Compile(arg CompileArg) (CompileResult, error) {
+	return CompileResult{Status: RunStatusOK}, nil
+}
+
+func (run funcRunner) Run(arg RunnerArg) (RunResult, error) {
+	return run(arg), nil
+}
+
+// guessInteractor answers the guesses of the secret number in the input file with <, > or =
+func guessInteractor(arg RunnerArg) RunResult {
+	input, err := os.ReadFile(filepath.Join(arg.MountDir, arg.Args[0]))
+	if err != nil {
+		return RunResult{Status: RunStatusRuntimeError, ExitCode: 3, Stderr: []byte(err.Error())}
+	}
+	secret, _ := strconv.Atoi(string(input))
+
+	scanner := bufio.NewScanner(arg.Input)
+	for guesses := 1; scanner.Scan(); guesses++ {
+		guess, _ := strconv.Atoi(scanner.Text())
+		switch {
+		case guess < secret:
+			fmt.Fprintln(arg.Output, "<")
+		case guess > secret:
+			fmt.Fprintln(arg.Output, ">")
+		case guesses > 7:
+			fmt.Fprintln(arg.Output, "=")
+			return RunResult{Status: RunStatusRuntimeError, ExitCode: 7, Stderr: []byte("points 0.5\ntoo many guesses")}
+		default:
+			fmt.Fprintln(arg.Output, "=")
+			return RunResult{Status: RunStatusOK}
+		}
+	}
+
+	return RunResult{Status: RunStatusRuntimeError, ExitCode: 1, Stderr: []byte("number not guessed")}
+}
+
+// guessProgram guesses the number with the next guess func
+func guessProgram(next func(lo, hi int) int) funcRunner {
+	return func(arg RunnerArg) RunResult {
+		reader := bufio.NewReader(arg.Input)
+		lo, hi := 1, 100
+		for lo <= hi {
+			guess := next(lo, hi)
+			fmt.Fprintln(arg.Output, guess)
+
+			var reply string
+			if _, err := fmt.Fscanln(reader, &reply); err != nil {
+				return RunResult{Status: RunStatusRuntimeError, ExitCode: 1}
+			}
+
+			switch reply {
+			case "<":
+				lo = guess + 1
+			case ">":
+				hi = guess - 1
+			default:
+				return RunResult{Status: RunStatusOK}
+			}
+		}
+		return RunResult{Status: RunStatusOK}
+	}
+}
+
+func TestProgramInteractor(t *testing.T) {
+	binarySearch := guessProgram(func(lo, hi int) int { return (lo + hi) / 2 })
+	linearSearch := guessProgram(func(lo, _ int) int { return lo })
+	wrongGuess := funcRunner(func(arg RunnerArg) RunResult {
+		fmt.Fprintln(arg.Output, 1)
+		return RunResult{Status: RunStatusOK}
+	})
+	timeout := funcRunner(func(arg RunnerArg) RunResult {
+		return RunResult{Status: RunStatusTimeLimitExceeded}
+	})
+
+	tests := []struct {
+		name           string
+		program        Runner
+		verdict        Verdict
+		partialPercent int32
+	}{
+		{"accepted", binarySearch, VerdictAccepted, 0},
+		{"partially correct", linearSearch, VerdictPartiallyCorrect, 50},
+		{"wrong answer", wrongGuess, VerdictWrongAnswer, 0},
+		{"program verdict", timeout, VerdictTimeLimitExceeded, 0},
+	}
+
+	interactor := &ProgramInteractor{Runner: funcRunner(guessInteractor)}
+	if err := interactor.Compile(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer interactor.Cleanup()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := interactor.Interact(tt.program, RunnerArg{RunTimeout: 1}, TestCase{Input: "42"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Verdict != tt.verdict || res.PartialPercent != tt.partialPercent {
+				t.Errorf("want %s %d%%, got %s %d%%", tt.verdict, tt.partialPercent, res.Verdict, res.PartialPercent)
+			}
+		})
+	}
+
+	broken := &ProgramInteractor{Runner: funcRunner(func(arg RunnerArg) RunResult {
+		return RunResult{Status: RunStatusRuntimeError, ExitCode: 3}
+	})}
+	if err := broken.Compile(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer broken.Cleanup()
+
+	if _, err := broken.Interact(wrongGuess, RunnerArg{}, TestCase{Input: "42"}); err == nil {
+		t.Errorf("want interactor error")
+	}
+}
@@ -0,0 +1,137 @@
+package grading
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TestReport is the outcome of a single test of a JUnit XML report
+type TestReport struct {
+	// Name is the classname & name of the test, e.g. StackTest.Push
+	Name    string
+	Verdict Verdict
+	// Message is the failure message, empty when the test passed
+	Message  string
+	WallTime time.Duration
+}
+
+type junitSuites struct {
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Time      string         `xml:"time,attr"`
+	Status    string         `xml:"status,attr"`
+	Failures  []junitProblem `xml:"failure"`
+	Errors    []junitProblem `xml:"error"`
+	Skipped   *junitProblem  `xml:"skipped"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (problem junitProblem) String() string {
+	text := strings.TrimSpace(problem.Text)
+	if text == "" {
+		return problem.Message
+	}
+	return text
+}
+
+// ParseJUnitReport parses the tests of a JUnit XML report, the format written by
+// GoogleTest, pytest & the JUnit platform. A failed test is a wrong answer,
+// an errored one is a runtime error & a skipped one is not counted as passed.
+func ParseJUnitReport(report []byte) ([]TestReport, error) {
+	report = bytes.TrimSpace(report)
+	if len(report) == 0 {
+		return nil, errors.New("junit report is empty")
+	}
+
+	suites := junitSuites{}
+	// the root is either <testsuites> or a single <testsuite>
+	root := struct {
+		XMLName xml.Name
+	}{}
+	if err := xml.Unmarshal(report, &root); err != nil {
+		return nil, fmt.Errorf("parse junit report: %w", err)
+	}
+
+	switch root.XMLName.Local {
+	case "testsuites":
+		if err := xml.Unmarshal(report, &suites); err != nil {
+			return nil, fmt.Errorf("parse junit report: %w", err)
+		}
+	case "testsuite":
+		suite := junitSuite{}
+		if err := xml.Unmarshal(report, &suite); err != nil {
+			return nil, fmt.Errorf("parse junit report: %w", err)
+		}
+		suites.Suites = []junitSuite{suite}
+	default:
+		return nil, fmt.Errorf("parse junit report: unexpected root <%s>", root.XMLName.Local)
+	}
+
+	tests := []TestReport{}
+	for _, suite := range suites.Suites {
+		tests = suite.appendTests(tests)
+	}
+
+	return tests, nil
+}
+
+func (suite junitSuite) appendTests(tests []TestReport) []TestReport {
+	for _, child := range suite.Suites {
+		tests = child.appendTests(tests)
+	}
+
+	for _, testCase := range suite.Cases {
+		tests = append(tests, testCase.report())
+	}
+
+	return tests
+}
+
+func (testCase junitCase) report() TestReport {
+	name := testCase.Name
+	if testCase.ClassName != "" {
+		name = testCase.ClassName + "." + testCase.Name
+	}
+
+	seconds, _ := time.ParseDuration(strings.TrimSpace(testCase.Time) + "s")
+	report := TestReport{
+		Name:     name,
+		Verdict:  VerdictAccepted,
+		WallTime: seconds,
+	}
+
+	switch {
+	case len(testCase.Errors) > 0:
+		report.Verdict = VerdictRuntimeError
+		report.Message = testCase.Errors[0].String()
+	case len(testCase.Failures) > 0:
+		report.Verdict = VerdictWrongAnswer
+		report.Message = testCase.Failures[0].String()
+	// gtest reports the disabled tests as not run
+	case testCase.Skipped != nil || testCase.Status == "notrun":
+		report.Verdict = VerdictWrongAnswer
+		report.Message = "skipped"
+		if testCase.Skipped != nil && testCase.Skipped.String() != "" {
+			report.Message = "skipped: " + testCase.Skipped.String()
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,72 @@
+package grading
+
+import (
+	"testing"
+	"time"
+)
+
+const gtestReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" failures="1" disabled="1" errors="0" name="AllTests">
+  <testsuite name="StackTest" tests="3" failures="1" disabled="1" errors="0">
+    <testcase name="Push" status="run" result="completed" time="0.001" classname="StackTest" />
+    <testcase name="Pop" status="run" result="completed" time="0" classname="StackTest">
+      <failure message="solution_test.cpp:12&#x0A;Expected equality" type=""><![CDATA[solution_test.cpp:12
+Expected equality of these values:
+  s.pop()
+    Which is: 1
+  2]]></failure>
+    </testcase>
+    <testcase name="DISABLED_Peek" status="notrun" result="suppressed" time="0" classname="StackTest" />
+  </testsuite>
+</testsuites>`
+
+const pytestReport = `<?xml version="1.0" encoding="utf-8"?><testsuites><testsuite name="pytest" errors="1" failures="0" skipped="1" tests="3">` +
+	`<testcase classname="test_solution" name="test_add" time="0.250" />` +
+	`<testcase classname="test_solution" name="test_div" time="0.001"><error message="ZeroDivisionError">trace</error></testcase>` +
+	`<testcase classname="test_solution" name="test_todo" time="0.000"><skipped message="todo" /></testcase>` +
+	`</testsuite></testsuites>`
+
+func TestParseJUnitReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		report string
+		want   []TestReport
+	}{
+		{"gtest", gtestReport, []TestReport{
+			{Name: "StackTest.Push", Verdict: VerdictAccepted, WallTime: time.Millisecond},
+			{Name: "StackTest.Pop", Verdict: VerdictWrongAnswer, Message: "solution_test.cpp:12\nExpected equality of these values:\n  s.pop()\n    Which is: 1\n  2"},
+			{Name: "StackTest.DISABLED_Peek", Verdict: VerdictWrongAnswer, Message: "skipped"},
+		}},
+		{"pytest", pytestReport, []TestReport{
+			{Name: "test_solution.test_add", Verdict: VerdictAccepted, WallTime: 250 * time.Millisecond},
+			{Name: "test_solution.test_div", Verdict: VerdictRuntimeError, Message: "trace", WallTime: time.Millisecond},
+			{Name: "test_solution.test_todo", Verdict: VerdictWrongAnswer, Message: "skipped: todo"},
+		}},
+		{"single testsuite root", `<testsuite><testcase name="ok" classname="SolutionTest"/></testsuite>`, []TestReport{
+			{Name: "SolutionTest.ok", Verdict: VerdictAccepted},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJUnitReport([]byte(tt.report))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("want %d tests, got %+v", len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("test %d: want %+v, got %+v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+
+	for _, report := range []string{"", "Segmentation fault", "<html></html>"} {
+		if _, err := ParseJUnitReport([]byte(report)); err == nil {
+			t.Errorf("want error for report %q", report)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package grading
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+type Language string
+
+const (
+	LanguageCPP    Language = "cpp"
+	LanguagePython Language = "python"
+	LanguageJava   Language = "java"
+	LanguageGo     Language = "go"
+	LanguageRust   Language = "rust"
+)
+
+// LanguageSpec describes how a program of a language is compiled & run in a container.
+//
+// On compile, the submitted source is copied into the /build dir as SourceFileName,
+// the commands may refer to it with /build/<SourceFileName>.
+// The compiled program should be written to /build too, the same /build dir
+// is mounted when the program is run.
+//
+// A project, see CompileArg.IsProject, is copied into /build as is. The RunCmd is kept,
+// so an assignment build command must write the program where the RunCmd expects it, e.g. /build/app.
+type LanguageSpec struct {
+	Language Language
+	Image    string
+	Ext      string
+	// SourceFileName is the file name the source must be compiled as,
+	// some languages require a specific one, e.g. Main.java
+	SourceFileName string
+	// CompileCmd is a shell command, an empty one skips the compile step
+	CompileCmd string
+	// RunCmd is a shell command, it is run in the source code dir
+	RunCmd        string
+	DefaultLimits Limits
+	// ValidateSource checks the naming rules of the language, it is optional
+	ValidateSource func(source string) error
+}
+
+var javaMainClassRegex = regexp.MustCompile(`\bclass\s+Main\b`)
+
+var languageSpecs = []LanguageSpec{
+	{
+		Language:       LanguageCPP,
+		Image:          "docker.io/library/gcc:latest",
+		Ext:            ".cpp",
+		SourceFileName: "main.cpp",
+		CompileCmd:     "g++ -o /build/app /build/main.cpp",
+		RunCmd:         "/build/app",
+		DefaultLimits:  Limits{TimeLimit: 10, MemoryLimit: 100, CompileTimeLimit: 30},
+	},
+	{
+		Language:       LanguagePython,
+		Image:          "docker.io/library/python:3-slim",
+		Ext:            ".py",
+		SourceFileName: "main.py",
+		// syntax errors are reported as compile error
+		CompileCmd:    `python3 -c 'import sys; compile(open(sys.argv[1]).read(), sys.argv[1], "exec")' /build/main.py`,
+		RunCmd:        "python3 -B /build/main.py",
+		DefaultLimits: Limits{TimeLimit: 10, MemoryLimit: 100, CompileTimeLimit: 10},
+	},
+	{
+		Language:       LanguageJava,
+		Image:          "docker.io/library/eclipse-temurin:21",
+		Ext:            ".java",
+		SourceFileName: "Main.java",
+		CompileCmd:     "javac -d /build /build/Main.java",
+		RunCmd:         "java -cp /build Main",
+		DefaultLimits:  Limits{TimeLimit: 10, MemoryLimit: 512, CompileTimeLimit: 30},
+		ValidateSource: func(source string) error {
+			if !javaMainClassRegex.MatchString(source) {
+				return errors.New("java source must declare class Main")
+			}
+			return nil
+		},
+	},
+	{
+		Language:       LanguageGo,
+		Image:          "docker.io/library/golang:1.22",
+		Ext:            ".go",
+		SourceFileName: "main.go",
+		CompileCmd:     "GOCACHE=/tmp/gocache GOPATH=/tmp/gopath go build -o /build/app /build/main.go",
+		RunCmd:         "/build/app",
+		DefaultLimits:  Limits{TimeLimit: 10, MemoryLimit: 512, CompileTimeLimit: 60},
+		ValidateSource: func(source string) error {
+			if !strings.Contains(source, "package main") {
+				return errors.New("go source must be in package main")
+			}
+			return nil
+		},
+	},
+	{
+		Language:       LanguageRust,
+		Image:          "docker.io/library/rust:1-slim",
+		Ext:            ".rs",
+		SourceFileName: "main.rs",
+		CompileCmd:     "rustc -O -o /build/app /build/main.rs",
+		RunCmd:         "/build/app",
+		DefaultLimits:  Limits{TimeLimit: 10, MemoryLimit: 512, CompileTimeLimit: 60},
+	},
+}
+
+// LanguageSpecs returns the spec of all supported languages
+func LanguageSpecs() []LanguageSpec {
+	specs := make([]LanguageSpec, len(languageSpecs))
+	copy(specs, languageSpecs)
+	return specs
+}
+
+func LookupLanguageSpec(lang Language) (LanguageSpec, bool) {
+	for _, spec := range languageSpecs {
+		if spec.Language == lang {
+			return spec, true
+		}
+	}
+	return LanguageSpec{}, false
+}
+
+func ValidLanguage(lang Language) bool {
+	_, ok := LookupLanguageSpec(lang)
+	return ok
+}
+
+// Ext returns the source file extension of the language
+func (lang Language) Ext() string {
+	spec, _ := LookupLanguageSpec(lang)
+	return spec.Ext
+}
+
+// ValidateSource checks the source against the language naming rules
+func (lang Language) ValidateSource(source string) error {
+	spec, ok := LookupLanguageSpec(lang)
+	if !ok {
+		return errors.New("unknown language")
+	}
+
+	if spec.ValidateSource == nil {
+		return nil
+	}
+
+	return spec.ValidateSource(source)
+}
+
+// JoinLanguages & SplitLanguages convert languages to and from
+// a comma separated string, it's how they are stored
+func JoinLanguages(langs []Language) string {
+	strs := make([]string, len(langs))
+	for i, lang := range langs {
+		strs[i] = string(lang)
+	}
+	return strings.Join(strs, ",")
+}
+
+func SplitLanguages(str string) []Language {
+	var langs []Language
+	for _, s := range strings.Split(str, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			langs = append(langs, Language(s))
+		}
+	}
+	return langs
+}
+
+// ShellQuote single quotes the arg, so it is passed as is to the program
+func ShellQuote(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+func ShellQuoteAll(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = ShellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,66 @@
+package grading
+
+import (
+	"time"
+)
+
+// LatePolicy accepts submissions after the assignment deadline
+// until the hard deadline with a penalty for each started day late
+type LatePolicy struct {
+	// HardDeadlineAt zero means no late submission is accepted
+	HardDeadlineAt time.Time
+	// GracePeriod after the deadline is not late
+	GracePeriod          time.Duration
+	PenaltyPercentPerDay int32
+}
+
+// CutoffAt is when submissions are no longer accepted
+func (policy LatePolicy) CutoffAt(deadlineAt time.Time) time.Time {
+	graceEndAt := deadlineAt.Add(policy.GracePeriod)
+	if policy.HardDeadlineAt.After(graceEndAt) {
+		return policy.HardDeadlineAt
+	}
+	return graceEndAt
+}
+
+func (policy LatePolicy) IsOpen(deadlineAt, at time.Time) bool {
+	return !at.After(policy.CutoffAt(deadlineAt))
+}
+
+// Lateness of a submission, the penalty percent is deducted from its grade
+type Lateness struct {
+	IsLate         bool
+	Days           int32
+	PenaltyPercent int32
+}
+
+// Lateness counts the started days after the deadline,
+// a submission within the grace period is not late
+func (policy LatePolicy) Lateness(deadlineAt, submittedAt time.Time) Lateness {
+	if !submittedAt.After(deadlineAt.Add(policy.GracePeriod)) {
+		return Lateness{}
+	}
+
+	late := submittedAt.Sub(deadlineAt)
+	days := int32((late + 24*time.Hour - 1) / (24 * time.Hour))
+
+	return Lateness{
+		IsLate:         true,
+		Days:           days,
+		PenaltyPercent: min(days*policy.PenaltyPercentPerDay, 100),
+	}
+}
+
+// Apply deducts the penalty from the grade, rounded down
+func (lateness Lateness) Apply(grade int32) int32 {
+	return int32(int64(grade) * int64(100-lateness.PenaltyPercent) / 100)
+}
+
+// Extend moves the late policy to a student extended deadline,
+// the hard deadline keeps its distance from the deadline
+func (policy LatePolicy) Extend(deadlineAt, extendedDeadlineAt time.Time) LatePolicy {
+	if !policy.HardDeadlineAt.IsZero() {
+		policy.HardDeadlineAt = policy.HardDeadlineAt.Add(extendedDeadlineAt.Sub(deadlineAt))
+	}
+	return policy
+}
@@ -0,0 +1,65 @@
+package grading
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatePolicy(t *testing.T) {
+	deadlineAt := time.Date(2024, 9, 1, 23, 59, 0, 0, time.UTC)
+	policy := LatePolicy{
+		HardDeadlineAt:       deadlineAt.Add(3 * 24 * time.Hour),
+		GracePeriod:          10 * time.Minute,
+		PenaltyPercentPerDay: 10,
+	}
+
+	tests := []struct {
+		name        string
+		policy      LatePolicy
+		submittedAt time.Time
+		isOpen      bool
+		lateness    Lateness
+		grade       int32
+	}{
+		{"on time", policy, deadlineAt, true, Lateness{}, 90},
+		{"within grace period", policy, deadlineAt.Add(10 * time.Minute), true, Lateness{}, 90},
+		{
+			"after grace period",
+			policy, deadlineAt.Add(11 * time.Minute), true,
+			Lateness{IsLate: true, Days: 1, PenaltyPercent: 10}, 81,
+		},
+		{
+			"started days",
+			policy, deadlineAt.Add(2*24*time.Hour + time.Second), true,
+			Lateness{IsLate: true, Days: 3, PenaltyPercent: 30}, 63,
+		},
+		{
+			"after hard deadline",
+			policy, deadlineAt.Add(3*24*time.Hour + time.Second), false,
+			Lateness{IsLate: true, Days: 4, PenaltyPercent: 40}, 54,
+		},
+		{
+			"penalty is at most 100 percent",
+			LatePolicy{PenaltyPercentPerDay: 60}, deadlineAt.Add(36 * time.Hour), false,
+			Lateness{IsLate: true, Days: 2, PenaltyPercent: 100}, 0,
+		},
+		{"no late policy", LatePolicy{}, deadlineAt.Add(time.Second), false, Lateness{IsLate: true, Days: 1}, 90},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if isOpen := tt.policy.IsOpen(deadlineAt, tt.submittedAt); isOpen != tt.isOpen {
+				t.Errorf("is open %v, want %v", isOpen, tt.isOpen)
+			}
+
+			lateness := tt.policy.Lateness(deadlineAt, tt.submittedAt)
+			if lateness != tt.lateness {
+				t.Errorf("lateness %+v, want %+v", lateness, tt.lateness)
+			}
+
+			if grade := lateness.Apply(90); grade != tt.grade {
+				t.Errorf("grade %d, want %d", grade, tt.grade)
+			}
+		})
+	}
+}
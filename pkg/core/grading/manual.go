@@ -0,0 +1,22 @@
+package grading
+
+// ManualGrade is the grade a grader gives on top of the automated grade.
+// The rubric points are added to the automated grade, an override replaces both.
+type ManualGrade struct {
+	RubricPoints int32
+	// RubricMaxPoints is the sum of the rubric criteria max points when it was graded
+	RubricMaxPoints int32
+	IsOverridden    bool
+	OverrideGrade   int32
+	OverrideReason  string
+}
+
+// Combine combines the automated grade out of autoMaxScore with the manual grade,
+// the max score is the automated max score plus the rubric max points
+func (manual ManualGrade) Combine(autoGrade, autoMaxScore int32) (grade int32, maxScore int32) {
+	maxScore = autoMaxScore + manual.RubricMaxPoints
+	if manual.IsOverridden {
+		return manual.OverrideGrade, maxScore
+	}
+	return autoGrade + manual.RubricPoints, maxScore
+}
@@ -0,0 +1,27 @@
+package grading
+
+import "testing"
+
+func TestManualGradeCombine(t *testing.T) {
+	tests := []struct {
+		name     string
+		manual   ManualGrade
+		grade    int32
+		maxScore int32
+	}{
+		{"no manual grade", ManualGrade{}, 70, 100},
+		{"rubric", ManualGrade{RubricPoints: 15, RubricMaxPoints: 20}, 85, 120},
+		{"ungraded rubric", ManualGrade{RubricMaxPoints: 20}, 70, 120},
+		{"override", ManualGrade{RubricPoints: 15, RubricMaxPoints: 20, IsOverridden: true, OverrideGrade: 110}, 110, 120},
+		{"override to zero", ManualGrade{IsOverridden: true}, 0, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grade, maxScore := tt.manual.Combine(70, 100)
+			if grade != tt.grade || maxScore != tt.maxScore {
+				t.Errorf("grade %d/%d, want %d/%d", grade, maxScore, tt.grade, tt.maxScore)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package grading
+
+import (
+	"bytes"
+	"io"
+)
+
+// OutputBuffer keeps up to Limit bytes of a program output, the rest is discarded.
+// It never fails a write, so the program is not blocked on a full pipe.
+type OutputBuffer struct {
+	// Limit is unlimited when zero
+	Limit    Kib
+	buf      bytes.Buffer
+	written  int64
+	exceeded bool
+	// stream receives the output instead of buf, see RunnerArg.Output
+	stream io.Writer
+	broken bool
+}
+
+func NewOutputBuffer(limit Kib) *OutputBuffer {
+	return &OutputBuffer{Limit: limit}
+}
+
+// NewStdout creates the stdout of the program run,
+// it streams to arg.Output when it's set
+func (arg RunnerArg) NewStdout() *OutputBuffer {
+	return &OutputBuffer{Limit: arg.OutputLimit, stream: arg.Output}
+}
+
+func (out *OutputBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if out.Limit > 0 {
+		remaining := out.Limit.Bytes() - out.written
+		if int64(len(p)) > remaining {
+			out.exceeded = true
+			p = p[:max(remaining, 0)]
+		}
+	}
+	out.written += int64(len(p))
+
+	if out.stream == nil {
+		out.buf.Write(p)
+		return n, nil
+	}
+
+	// the reader is gone, e.g. the interactor exited, the rest is discarded
+	if !out.broken {
+		if _, err := out.stream.Write(p); err != nil {
+			out.broken = true
+		}
+	}
+
+	return n, nil
+}
+
+// Bytes returns the kept output, it's empty when the output is streamed
+func (out *OutputBuffer) Bytes() []byte {
+	return out.buf.Bytes()
+}
+
+func (out *OutputBuffer) Exceeded() bool {
+	return out.exceeded
+}
+
+// Status reports an otherwise successful or crashed run
+// as output limit exceeded when the output is over the limit
+func (out *OutputBuffer) Status(status RunStatus) RunStatus {
+	if out.exceeded && (status == RunStatusOK || status == RunStatusRuntimeError) {
+		return RunStatusOutputLimitExceeded
+	}
+	return status
+}
@@ -0,0 +1,261 @@
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+)
+
+// exit codes of the in container script
+const (
+	exitCodeTimeout = 124
+	exitCodeKilled  = 137
+	// podman itself failed to run the container
+	exitCodePodmanError = 125
+)
+
+// compileMemLimit is the memory limit of the compilation,
+// compilers need more memory than the graded program
+const compileMemLimit grading.Mib = 1024
+
+var _ grading.Runner = &Container{}
+
+// Container compiles & runs a program of the spec language in a podman container.
+// The source code dir is mounted at /src and the build dir at /build.
+type Container struct {
+	Spec grading.LanguageSpec
+}
+
+func NewContainer(spec grading.LanguageSpec) *Container {
+	return &Container{Spec: spec}
+}
+
+func (c *Container) Compile(arg grading.CompileArg) (grading.CompileResult, error) {
+	mountDir, err := filepath.Abs(arg.MountDir)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("compile %s: mount dir: %w", c.Spec.Language, err)
+	}
+
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("compile %s: build dir: %w", c.Spec.Language, err)
+	}
+
+	compileCmd := arg.CompileCmd(c.Spec.CompileCmd)
+	if compileCmd == "" {
+		compileCmd = "true"
+	}
+
+	copySource := fmt.Sprintf(`cp /src/%s /build/%s`, grading.ShellQuote(arg.ProgramFileName), c.Spec.SourceFileName)
+	if arg.IsProject {
+		copySource = `cp -r /src/. /build/`
+	}
+
+	copySupportFiles := ""
+	for _, name := range arg.SupportFileNames {
+		copySupportFiles += fmt.Sprintf(`cp /src/%s /build/ && `, grading.ShellQuote(name))
+	}
+
+	script := fmt.Sprintf(
+		`%s%s && timeout %s bash -c %s`,
+		copySupportFiles, copySource, arg.Timeout, grading.ShellQuote(compileCmd),
+	)
+
+	args := []string{
+		"run", "--rm",
+		fmt.Sprintf("--memory=%s", compileMemLimit),
+		"--network=none",
+		"-v", fmt.Sprintf(`%s:/src:ro`, mountDir),
+		"-v", fmt.Sprintf(`%s:/build`, buildDir),
+		"-w", "/build",
+		c.Spec.Image,
+		"bash", "-c", script,
+	}
+
+	start := time.Now()
+	stderr, exitCode, err := podmanRun(args, nil, io.Discard)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("compile %s: %w", c.Spec.Language, err)
+	}
+
+	res := grading.CompileResult{
+		Stderr:   stderr,
+		ExitCode: exitCode,
+		Duration: time.Since(start),
+	}
+
+	switch exitCode {
+	case 0:
+		res.Status = grading.RunStatusOK
+	case exitCodeTimeout:
+		res.Status = grading.RunStatusTimeLimitExceeded
+	default:
+		res.Status = grading.RunStatusCompileError
+	}
+
+	return res, nil
+}
+
+func (c *Container) Run(arg grading.RunnerArg) (grading.RunResult, error) {
+	res, err := c.run(arg)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("run %s: %w", c.Spec.Language, err)
+	}
+
+	return res, nil
+}
+
+func (c *Container) run(arg grading.RunnerArg) (grading.RunResult, error) {
+	metaDir, err := os.MkdirTemp("", "autograd-podman-*")
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("create meta dir: %w", err)
+	}
+	defer os.RemoveAll(metaDir)
+
+	mountDir, err := filepath.Abs(arg.MountDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("mount dir: %w", err)
+	}
+
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("build dir: %w", err)
+	}
+
+	// the program stderr is kept on fd 3, so the time report
+	// can be written to the meta dir without mixing with it.
+	// The container cgroup peak memory is reported after the program exits.
+	script := fmt.Sprintf(
+		`TIMEFORMAT='%%R %%U %%S'; { time timeout %s %s %s 2>&3; } 3>&2 2>/meta/time; `+
+			`code=$?; cat /sys/fs/cgroup/memory.peak > /meta/memory 2>/dev/null; exit $code`,
+		arg.RunTimeout, c.Spec.RunCmd, grading.ShellQuoteAll(arg.Args),
+	)
+
+	args := []string{
+		"run", "--rm",
+		"-i",
+		fmt.Sprintf("--memory=%s", arg.MemLimit),
+		"--network=none",
+	}
+
+	if arg.ProcessLimit > 0 {
+		args = append(args, fmt.Sprintf("--pids-limit=%d", arg.ProcessLimit))
+	}
+
+	args = append(args,
+		"-v", fmt.Sprintf(`%s:/src:ro`, mountDir),
+		"-v", fmt.Sprintf(`%s:/build:ro`, buildDir),
+		"-v", fmt.Sprintf(`%s:/meta`, metaDir),
+		"-w", "/src",
+		c.Spec.Image,
+		"bash", "-c", script,
+	)
+
+	stdout := arg.NewStdout()
+
+	// an interactive pipe is passed as is, a buffered stdin is copied by another goroutine
+	// which keeps the run waiting for the interactor after the program exits
+	var stdin io.Reader = arg.Input
+	if _, ok := arg.Input.(*os.File); !ok {
+		stdin = bufio.NewReader(arg.Input)
+	}
+
+	start := time.Now()
+	stderr, exitCode, err := podmanRun(args, stdin, stdout)
+	if err != nil {
+		return grading.RunResult{}, err
+	}
+	wallTime := time.Since(start)
+
+	res := grading.RunResult{
+		Output:   stdout.Bytes(),
+		Stderr:   stderr,
+		ExitCode: exitCode,
+		WallTime: wallTime,
+		Status:   stdout.Status(runStatus(exitCode)),
+	}
+
+	if timeReport, err := os.ReadFile(filepath.Join(metaDir, "time")); err == nil {
+		res.WallTime, res.CPUTime = parseTimeReport(string(timeReport), wallTime)
+	}
+
+	if memoryReport, err := os.ReadFile(filepath.Join(metaDir, "memory")); err == nil {
+		peak, _ := strconv.ParseInt(strings.TrimSpace(string(memoryReport)), 10, 64)
+		res.Memory = grading.Kib(peak >> 10)
+	}
+
+	return res, nil
+}
+
+// podmanRun runs podman with the args, a non zero exit code of the container is not an error
+func podmanRun(args []string, stdin io.Reader, stdout io.Writer) (stderr []byte, exitCode int, err error) {
+	stderrBuf := bytes.NewBuffer(nil)
+
+	cmd := exec.Command("podman", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderrBuf
+
+	err = cmd.Run()
+	if err != nil {
+		exitErr := &exec.ExitError{}
+		if !errors.As(err, &exitErr) {
+			return nil, 0, err
+		}
+		exitCode = exitErr.ExitCode()
+	}
+
+	if exitCode == exitCodePodmanError {
+		return nil, 0, fmt.Errorf("podman: %s", stderrBuf.String())
+	}
+
+	return stderrBuf.Bytes(), exitCode, nil
+}
+
+func runStatus(exitCode int) grading.RunStatus {
+	switch exitCode {
+	case 0:
+		return grading.RunStatusOK
+	case exitCodeTimeout:
+		return grading.RunStatusTimeLimitExceeded
+	case exitCodeKilled:
+		// the container only get SIGKILL-ed by the OOM killer
+		return grading.RunStatusMemoryLimitExceeded
+	default:
+		return grading.RunStatusRuntimeError
+	}
+}
+
+// parseTimeReport parses bash time report in "%R %U %S" format.
+// It fallbacks to the given wall time when the report is malformed.
+func parseTimeReport(report string, fallbackWallTime time.Duration) (wallTime, cpuTime time.Duration) {
+	fields := strings.Fields(report)
+	if len(fields) != 3 {
+		return fallbackWallTime, 0
+	}
+
+	secs := make([]float64, len(fields))
+	for i, field := range fields {
+		sec, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return fallbackWallTime, 0
+		}
+		secs[i] = sec
+	}
+
+	toDuration := func(sec float64) time.Duration {
+		return time.Duration(sec * float64(time.Second))
+	}
+
+	return toDuration(secs[0]), toDuration(secs[1] + secs[2])
+}
@@ -0,0 +1,15 @@
+package podman
+
+import "github.com/fahmifan/autograd/pkg/core/grading"
+
+// DefaultRegistry registers a podman container runner for every supported language & test framework
+func DefaultRegistry() *grading.Registry {
+	registry := grading.NewRegistry()
+	for _, spec := range grading.LanguageSpecs() {
+		registry.Register(spec, NewContainer(spec))
+	}
+	for _, spec := range grading.FrameworkSpecs() {
+		registry.RegisterFramework(spec, NewContainer(spec.LanguageSpec()))
+	}
+	return registry
+}
@@ -0,0 +1,73 @@
+package grading
+
+import (
+	"fmt"
+	"sync"
+)
+
+type RegistryEntry struct {
+	Spec   LanguageSpec
+	Runner Runner
+}
+
+// Registry maps a language to the runner that compiles & runs it,
+// and a test framework to the runner that compiles & runs the harness tests
+type Registry struct {
+	mu         sync.RWMutex
+	entries    map[Language]RegistryEntry
+	frameworks map[TestFramework]RegistryEntry
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		entries:    make(map[Language]RegistryEntry),
+		frameworks: make(map[TestFramework]RegistryEntry),
+	}
+}
+
+// Register adds or replaces the runner of the spec language
+func (registry *Registry) Register(spec LanguageSpec, runner Runner) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.entries[spec.Language] = RegistryEntry{
+		Spec:   spec,
+		Runner: runner,
+	}
+}
+
+func (registry *Registry) Lookup(lang Language) (RegistryEntry, error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	entry, ok := registry.entries[lang]
+	if !ok {
+		return RegistryEntry{}, fmt.Errorf("no runner registered for language %q", lang)
+	}
+
+	return entry, nil
+}
+
+// RegisterFramework adds or replaces the runner of the spec framework,
+// the runner is built from FrameworkSpec.LanguageSpec
+func (registry *Registry) RegisterFramework(spec FrameworkSpec, runner Runner) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.frameworks[spec.Framework] = RegistryEntry{
+		Spec:   spec.LanguageSpec(),
+		Runner: runner,
+	}
+}
+
+func (registry *Registry) LookupFramework(framework TestFramework) (RegistryEntry, error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	entry, ok := registry.frameworks[framework]
+	if !ok {
+		return RegistryEntry{}, fmt.Errorf("no runner registered for test framework %q", framework)
+	}
+
+	return entry, nil
+}
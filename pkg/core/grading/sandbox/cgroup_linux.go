@@ -0,0 +1,135 @@
+package sandbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+)
+
+type cgroupLimits struct {
+	Memory grading.Mib
+	Pids   int
+}
+
+// cgroup is a cgroup v2 dir holding a single sandboxed program
+type cgroup struct {
+	path string
+	dir  *os.File
+}
+
+// enableControllers enables the controllers used by the sandbox for the children of the parent
+func enableControllers(parent string) error {
+	err := os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte("+memory +pids +cpu"), 0)
+	if err != nil {
+		return fmt.Errorf("enable controllers: %w", err)
+	}
+	return nil
+}
+
+func newCgroup(parent string, limits cgroupLimits) (*cgroup, error) {
+	path, err := os.MkdirTemp(parent, "autograd-*")
+	if err != nil {
+		return nil, fmt.Errorf("create cgroup: %w", err)
+	}
+
+	cg := &cgroup{path: path}
+
+	files := map[string]string{
+		"memory.max":      strconv.FormatInt(int64(limits.Memory)<<20, 10),
+		"memory.swap.max": "0",
+		"pids.max":        strconv.Itoa(limits.Pids),
+		// a single cpu
+		"cpu.max": "100000 100000",
+	}
+	for name, value := range files {
+		err = os.WriteFile(filepath.Join(path, name), []byte(value), 0)
+		// swap accounting may be disabled
+		if errors.Is(err, os.ErrNotExist) && name == "memory.swap.max" {
+			continue
+		}
+		if err != nil {
+			cg.remove()
+			return nil, fmt.Errorf("write %s: %w", name, err)
+		}
+	}
+
+	cg.dir, err = os.Open(path)
+	if err != nil {
+		cg.remove()
+		return nil, fmt.Errorf("open cgroup: %w", err)
+	}
+
+	return cg, nil
+}
+
+// fd is passed to clone, so the program starts inside the cgroup
+func (cg *cgroup) fd() int {
+	return int(cg.dir.Fd())
+}
+
+// oomKilled reports whether a process of the cgroup was killed by the OOM killer
+func (cg *cgroup) oomKilled() bool {
+	return cg.readStat("memory.events", "oom_kill") > 0
+}
+
+func (cg *cgroup) cpuTime() time.Duration {
+	return time.Duration(cg.readStat("cpu.stat", "usage_usec")) * time.Microsecond
+}
+
+// memoryPeak is zero when the kernel doesn't report memory.peak
+func (cg *cgroup) memoryPeak() grading.Kib {
+	buf, err := os.ReadFile(filepath.Join(cg.path, "memory.peak"))
+	if err != nil {
+		return 0
+	}
+
+	peak, _ := strconv.ParseInt(strings.TrimSpace(string(buf)), 10, 64)
+	return grading.Kib(peak >> 10)
+}
+
+// readStat reads a "key value" line of a cgroup file, it returns 0 when it's missing
+func (cg *cgroup) readStat(file, key string) int64 {
+	f, err := os.Open(filepath.Join(cg.path, file))
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == key {
+			val, _ := strconv.ParseInt(fields[1], 10, 64)
+			return val
+		}
+	}
+
+	return 0
+}
+
+// remove kills the processes left in the cgroup and removes it
+func (cg *cgroup) remove() error {
+	if cg.dir != nil {
+		cg.dir.Close()
+	}
+
+	_ = os.WriteFile(filepath.Join(cg.path, "cgroup.kill"), []byte("1"), 0)
+
+	// the killed processes may not be reaped yet
+	var err error
+	for i := 0; i < 10; i++ {
+		if err = os.Remove(cg.path); err == nil || errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return fmt.Errorf("remove cgroup: %w", err)
+}
@@ -0,0 +1,320 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	initArg       = "__autograd_sandbox_init"
+	initConfigEnv = "AUTOGRAD_SANDBOX_CONFIG"
+	// initErrFd is the pipe to report setup failures, see Runner.exec
+	initErrFd = 3
+)
+
+// programEnv is the whole environment of the sandboxed program
+var programEnv = []string{
+	"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+	"HOME=/tmp",
+	"LANG=C.UTF-8",
+}
+
+type mount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+}
+
+type initConfig struct {
+	RootDir       string
+	ReadOnlyPaths []string
+	Mounts        []mount
+	WorkDir       string
+	Command       string
+	CPUTimeout    uint64
+}
+
+// Init sets up the sandbox & executes the program when the binary
+// is re-executed by the runner, otherwise it returns immediately.
+func Init() {
+	if len(os.Args) < 2 || os.Args[1] != initArg {
+		return
+	}
+
+	// capabilities, no_new_privs & seccomp are per thread
+	runtime.LockOSThread()
+
+	err := initSandbox()
+
+	errPipe := os.NewFile(initErrFd, "sandbox-err")
+	fmt.Fprint(errPipe, err)
+	os.Exit(1)
+}
+
+// initSandbox only returns on failure
+func initSandbox() error {
+	syscall.CloseOnExec(initErrFd)
+
+	cfg := initConfig{}
+	if err := json.Unmarshal([]byte(os.Getenv(initConfigEnv)), &cfg); err != nil {
+		return fmt.Errorf("unmarshal config: %w", err)
+	}
+
+	if err := setupRootfs(cfg); err != nil {
+		return fmt.Errorf("setup rootfs: %w", err)
+	}
+
+	if err := unix.Sethostname([]byte("sandbox")); err != nil {
+		return fmt.Errorf("set hostname: %w", err)
+	}
+
+	if err := os.Chdir(cfg.WorkDir); err != nil {
+		return fmt.Errorf("chdir: %w", err)
+	}
+
+	if err := setRlimits(cfg); err != nil {
+		return fmt.Errorf("set rlimits: %w", err)
+	}
+
+	if err := dropCapabilities(); err != nil {
+		return fmt.Errorf("drop capabilities: %w", err)
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("set no new privs: %w", err)
+	}
+
+	if err := installSeccomp(); err != nil {
+		return fmt.Errorf("install seccomp: %w", err)
+	}
+
+	err := syscall.Exec("/bin/sh", []string{"sh", "-c", cfg.Command}, programEnv)
+	return fmt.Errorf("exec: %w", err)
+}
+
+// setupRootfs makes a tmpfs with the mounts the new root
+func setupRootfs(cfg initConfig) error {
+	root := cfg.RootDir
+
+	// don't propagate the mounts to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("make / private: %w", err)
+	}
+
+	if err := unix.Mount("tmpfs", root, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "size=16m,mode=755"); err != nil {
+		return fmt.Errorf("mount root: %w", err)
+	}
+
+	for _, path := range cfg.ReadOnlyPaths {
+		if err := mountHostPath(root, path); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range cfg.Mounts {
+		if err := bindMount(m.Source, filepath.Join(root, m.Target), m.ReadOnly); err != nil {
+			return err
+		}
+	}
+
+	if err := mkdirMount("tmpfs", filepath.Join(root, "tmp"), "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "size=64m,mode=1777"); err != nil {
+		return err
+	}
+
+	if err := mkdirMount("proc", filepath.Join(root, "proc"), "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err != nil {
+		return err
+	}
+
+	if err := setupDev(filepath.Join(root, "dev")); err != nil {
+		return err
+	}
+
+	// pivot_root(".", ".") stacks the old root on top of the new one,
+	// so it can be detached without a put_old dir
+	if err := os.Chdir(root); err != nil {
+		return fmt.Errorf("chdir root: %w", err)
+	}
+	if err := unix.PivotRoot(".", "."); err != nil {
+		return fmt.Errorf("pivot root: %w", err)
+	}
+	if err := unix.Unmount(".", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("detach old root: %w", err)
+	}
+	if err := os.Chdir("/"); err != nil {
+		return fmt.Errorf("chdir /: %w", err)
+	}
+
+	if err := unix.Mount("", "/", "", unix.MS_REMOUNT|unix.MS_RDONLY|unix.MS_NOSUID|unix.MS_NODEV, ""); err != nil {
+		return fmt.Errorf("remount root read only: %w", err)
+	}
+
+	return nil
+}
+
+// mountHostPath mounts the host path read only at the same path in the root,
+// a symlink is copied instead, e.g. /bin -> usr/bin on merged /usr systems
+func mountHostPath(root, path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", path, err)
+	}
+
+	if info.Mode()&os.ModeSymlink == 0 {
+		return bindMount(path, filepath.Join(root, path), true)
+	}
+
+	target, err := os.Readlink(path)
+	if err != nil {
+		return fmt.Errorf("read link %s: %w", path, err)
+	}
+
+	dst := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("mkdir %s: %w", path, err)
+	}
+
+	if err := os.Symlink(target, dst); err != nil {
+		return fmt.Errorf("symlink %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func bindMount(src, dst string, readOnly bool) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("bind mount %s: %w", src, err)
+	}
+
+	if err := createMountPoint(dst, info.IsDir()); err != nil {
+		return fmt.Errorf("bind mount %s: %w", src, err)
+	}
+
+	if err := unix.Mount(src, dst, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("bind mount %s: %w", src, err)
+	}
+
+	if !readOnly {
+		return nil
+	}
+
+	// the flags of the host mount are locked in a user namespace,
+	// the remount fails unless they are kept
+	stat := unix.Statfs_t{}
+	if err := unix.Statfs(dst, &stat); err != nil {
+		return fmt.Errorf("statfs %s: %w", dst, err)
+	}
+	lockedFlags := uintptr(stat.Flags) & (unix.MS_NOEXEC | unix.MS_NOATIME | unix.MS_NODIRATIME | unix.MS_RELATIME)
+
+	flags := unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV | lockedFlags
+	if err := unix.Mount("", dst, "", flags, ""); err != nil {
+		return fmt.Errorf("remount %s read only: %w", src, err)
+	}
+
+	return nil
+}
+
+func mkdirMount(source, target, fstype string, flags uintptr, data string) error {
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return fmt.Errorf("mkdir %s: %w", target, err)
+	}
+
+	if err := unix.Mount(source, target, fstype, flags, data); err != nil {
+		return fmt.Errorf("mount %s: %w", target, err)
+	}
+
+	return nil
+}
+
+// setupDev creates a minimal /dev, device nodes can't be created
+// in a user namespace, so the host ones are bind mounted
+func setupDev(dev string) error {
+	if err := mkdirMount("tmpfs", dev, "tmpfs", unix.MS_NOSUID|unix.MS_NOEXEC, "size=64k,mode=755"); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"null", "zero", "random", "urandom"} {
+		if err := bindMount(filepath.Join("/dev", name), filepath.Join(dev, name), false); err != nil {
+			return err
+		}
+	}
+
+	links := map[string]string{
+		"fd":     "/proc/self/fd",
+		"stdin":  "/proc/self/fd/0",
+		"stdout": "/proc/self/fd/1",
+		"stderr": "/proc/self/fd/2",
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(dev, name)); err != nil {
+			return fmt.Errorf("symlink /dev/%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func createMountPoint(path string, isDir bool) error {
+	if isDir {
+		return os.MkdirAll(path, 0755)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func setRlimits(cfg initConfig) error {
+	limits := map[int]uint64{
+		unix.RLIMIT_CORE:   0,
+		unix.RLIMIT_FSIZE:  fileSizeLimit,
+		unix.RLIMIT_NOFILE: openFileLimit,
+	}
+
+	for resource, limit := range limits {
+		if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return fmt.Errorf("resource %d: %w", resource, err)
+		}
+	}
+
+	// SIGXCPU is sent on the soft limit, SIGKILL on the hard one
+	if cfg.CPUTimeout > 0 {
+		limit := &unix.Rlimit{Cur: cfg.CPUTimeout, Max: cfg.CPUTimeout + 1}
+		if err := unix.Setrlimit(unix.RLIMIT_CPU, limit); err != nil {
+			return fmt.Errorf("cpu: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// dropCapabilities empties the bounding set, so the program gets
+// no capability when it's executed, even though it runs as the namespace root
+func dropCapabilities() error {
+	for capability := 0; ; capability++ {
+		err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(capability), 0, 0, 0)
+		if errors.Is(err, unix.EINVAL) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("capability %d: %w", capability, err)
+		}
+	}
+}
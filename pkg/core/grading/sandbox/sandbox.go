@@ -0,0 +1,45 @@
+// Package sandbox runs programs on the host inside an unprivileged Linux sandbox,
+// so grading doesn't need a container runtime.
+//
+// Every compile & run gets its own user, mount, pid, network, ipc & uts namespaces,
+// a cgroup v2 with memory, cpu & pids limits, rlimits and a seccomp syscall allowlist.
+// The root filesystem is a tmpfs with the host toolchain dirs mounted read only,
+// the source code dir is mounted at /src and the build dir at /build,
+// the same layout as the podman runner, so the grading.LanguageSpec commands work for both.
+//
+// The sandbox is set up by re-executing the current binary, so Init must be called
+// at the very start of main.
+package sandbox
+
+import "github.com/fahmifan/autograd/pkg/core/grading"
+
+// Config is the host specific configuration of the sandbox
+type Config struct {
+	// CgroupPath is a cgroup v2 dir delegated to the user running autograd,
+	// e.g. /sys/fs/cgroup/autograd. A child cgroup is created in it for every program.
+	CgroupPath string
+	// ReadOnlyPaths are the host paths mounted read only in the sandbox,
+	// it defaults to DefaultReadOnlyPaths
+	ReadOnlyPaths []string
+}
+
+// DefaultReadOnlyPaths are the host dirs where the toolchains & shared libraries live
+var DefaultReadOnlyPaths = []string{"/bin", "/sbin", "/lib", "/lib32", "/lib64", "/usr", "/etc"}
+
+// sandbox limits that are not part of grading.Limits
+const (
+	compileMemLimit grading.Mib = 1024
+	compilePidLimit             = 128
+	// maxPidLimit is used when the run has no process limit
+	maxPidLimit = 1024
+	// fileSizeLimit is the max size of a file written by the program
+	fileSizeLimit = 64 << 20
+	openFileLimit = 256
+)
+
+func (cfg Config) readOnlyPaths() []string {
+	if len(cfg.ReadOnlyPaths) > 0 {
+		return cfg.ReadOnlyPaths
+	}
+	return DefaultReadOnlyPaths
+}
@@ -0,0 +1,342 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/logs"
+)
+
+// DefaultRegistry registers a sandbox runner for every supported language.
+// The language toolchains must be installed on the host.
+func DefaultRegistry(cfg Config) (*grading.Registry, error) {
+	if cfg.CgroupPath == "" {
+		return nil, errors.New("sandbox: cgroup path is required")
+	}
+
+	if err := enableControllers(cfg.CgroupPath); err != nil {
+		return nil, fmt.Errorf("sandbox: %w", err)
+	}
+
+	registry := grading.NewRegistry()
+	for _, spec := range grading.LanguageSpecs() {
+		registry.Register(spec, NewRunner(spec, cfg))
+	}
+	for _, spec := range grading.FrameworkSpecs() {
+		registry.RegisterFramework(spec, NewRunner(spec.LanguageSpec(), cfg))
+	}
+	return registry, nil
+}
+
+var _ grading.Runner = &Runner{}
+
+// Runner compiles & runs a program of the spec language in the sandbox
+type Runner struct {
+	Spec   grading.LanguageSpec
+	Config Config
+}
+
+func NewRunner(spec grading.LanguageSpec, cfg Config) *Runner {
+	return &Runner{Spec: spec, Config: cfg}
+}
+
+func (r *Runner) Compile(arg grading.CompileArg) (grading.CompileResult, error) {
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("sandbox: compile %s: build dir: %w", r.Spec.Language, err)
+	}
+
+	if arg.IsProject {
+		err = copyDir(arg.MountDir, buildDir)
+	} else {
+		err = copyFile(filepath.Join(arg.MountDir, arg.ProgramFileName), filepath.Join(buildDir, r.Spec.SourceFileName))
+	}
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("sandbox: compile %s: copy source: %w", r.Spec.Language, err)
+	}
+
+	for _, name := range arg.SupportFileNames {
+		err = copyFile(filepath.Join(arg.MountDir, name), filepath.Join(buildDir, filepath.Base(name)))
+		if err != nil {
+			return grading.CompileResult{}, fmt.Errorf("sandbox: compile %s: copy support file: %w", r.Spec.Language, err)
+		}
+	}
+
+	compileCmd := arg.CompileCmd(r.Spec.CompileCmd)
+	if compileCmd == "" {
+		return grading.CompileResult{Status: grading.RunStatusOK}, nil
+	}
+
+	stderr := bytes.NewBuffer(nil)
+	res, err := r.exec(job{
+		Command: compileCmd,
+		WorkDir: "/build",
+		Mounts:  []mount{{Source: buildDir, Target: "/build"}},
+		Stdout:  io.Discard,
+		Stderr:  stderr,
+		Timeout: arg.Timeout,
+		Cgroup:  cgroupLimits{Memory: compileMemLimit, Pids: compilePidLimit},
+	})
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("sandbox: compile %s: %w", r.Spec.Language, err)
+	}
+
+	compileRes := grading.CompileResult{
+		Status:   grading.RunStatusOK,
+		Stderr:   stderr.Bytes(),
+		ExitCode: res.ExitCode,
+		Duration: res.WallTime,
+	}
+
+	switch {
+	case res.TimedOut:
+		compileRes.Status = grading.RunStatusTimeLimitExceeded
+	case res.ExitCode != 0 || res.OOMKilled:
+		compileRes.Status = grading.RunStatusCompileError
+	}
+
+	return compileRes, nil
+}
+
+func (r *Runner) Run(arg grading.RunnerArg) (grading.RunResult, error) {
+	mountDir, err := filepath.Abs(arg.MountDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("sandbox: run %s: mount dir: %w", r.Spec.Language, err)
+	}
+
+	buildDir, err := filepath.Abs(arg.BuildDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("sandbox: run %s: build dir: %w", r.Spec.Language, err)
+	}
+
+	stdout := arg.NewStdout()
+	stderr := bytes.NewBuffer(nil)
+	res, err := r.exec(job{
+		Command: r.Spec.RunCmd + " " + grading.ShellQuoteAll(arg.Args),
+		WorkDir: "/src",
+		Mounts: []mount{
+			{Source: mountDir, Target: "/src", ReadOnly: true},
+			{Source: buildDir, Target: "/build", ReadOnly: true},
+		},
+		Stdin:      arg.Input,
+		Stdout:     stdout,
+		Stderr:     stderr,
+		Timeout:    arg.RunTimeout,
+		CPUTimeout: arg.RunTimeout,
+		Cgroup:     cgroupLimits{Memory: arg.MemLimit, Pids: processLimit(arg.ProcessLimit)},
+	})
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("sandbox: run %s: %w", r.Spec.Language, err)
+	}
+
+	runRes := grading.RunResult{
+		Output:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+		ExitCode: res.ExitCode,
+		WallTime: res.WallTime,
+		CPUTime:  res.CPUTime,
+		Memory:   res.Memory,
+		Status:   grading.RunStatusOK,
+	}
+
+	switch {
+	case res.TimedOut, res.Signal == syscall.SIGXCPU:
+		runRes.Status = grading.RunStatusTimeLimitExceeded
+	case res.OOMKilled:
+		runRes.Status = grading.RunStatusMemoryLimitExceeded
+	case res.ExitCode != 0:
+		runRes.Status = grading.RunStatusRuntimeError
+	}
+	runRes.Status = stdout.Status(runRes.Status)
+
+	return runRes, nil
+}
+
+func processLimit(limit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return maxPidLimit
+}
+
+type job struct {
+	Command string
+	WorkDir string
+	Mounts  []mount
+	Stdin   io.Reader
+	Stdout  io.Writer
+	Stderr  io.Writer
+	// Timeout is the wall time limit
+	Timeout grading.Second
+	// CPUTimeout is set as RLIMIT_CPU, 0 means unlimited
+	CPUTimeout grading.Second
+	Cgroup     cgroupLimits
+}
+
+type jobResult struct {
+	ExitCode  int
+	Signal    syscall.Signal
+	TimedOut  bool
+	OOMKilled bool
+	WallTime  time.Duration
+	CPUTime   time.Duration
+	Memory    grading.Kib
+}
+
+// exec runs the job command in a new sandbox,
+// a non zero exit code of the command is not an error
+func (r *Runner) exec(j job) (jobResult, error) {
+	rootDir, err := os.MkdirTemp("", "autograd-sandbox-*")
+	if err != nil {
+		return jobResult{}, fmt.Errorf("create root dir: %w", err)
+	}
+	defer os.RemoveAll(rootDir)
+
+	cg, err := newCgroup(r.Config.CgroupPath, j.Cgroup)
+	if err != nil {
+		return jobResult{}, err
+	}
+	defer func() {
+		if err := cg.remove(); err != nil {
+			logs.Err(err, "sandbox: exec: remove cgroup")
+		}
+	}()
+
+	cfg := initConfig{
+		RootDir:       rootDir,
+		ReadOnlyPaths: r.Config.readOnlyPaths(),
+		Mounts:        j.Mounts,
+		WorkDir:       j.WorkDir,
+		Command:       j.Command,
+		CPUTimeout:    uint64(j.CPUTimeout),
+	}
+	cfgJSON, err := json.Marshal(cfg)
+	if err != nil {
+		return jobResult{}, fmt.Errorf("marshal config: %w", err)
+	}
+
+	// the init process reports a setup failure through the pipe,
+	// it is closed on exec when the setup succeeds
+	errReader, errWriter, err := os.Pipe()
+	if err != nil {
+		return jobResult{}, fmt.Errorf("create pipe: %w", err)
+	}
+	defer errReader.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(j.Timeout)*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "/proc/self/exe", initArg)
+	cmd.Env = []string{initConfigEnv + "=" + string(cfgJSON)}
+	cmd.Stdin = j.Stdin
+	cmd.Stdout = j.Stdout
+	cmd.Stderr = j.Stderr
+	cmd.ExtraFiles = []*os.File{errWriter}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWUSER |
+			syscall.CLONE_NEWNS |
+			syscall.CLONE_NEWPID |
+			syscall.CLONE_NEWNET |
+			syscall.CLONE_NEWIPC |
+			syscall.CLONE_NEWUTS,
+		UidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}},
+		GidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}},
+		GidMappingsEnableSetgroups: false,
+		Pdeathsig:                  syscall.SIGKILL,
+		UseCgroupFD:                true,
+		CgroupFD:                   cg.fd(),
+	}
+
+	start := time.Now()
+	err = cmd.Start()
+	errWriter.Close()
+	if err != nil {
+		return jobResult{}, fmt.Errorf("start: %w", err)
+	}
+
+	setupErr, _ := io.ReadAll(errReader)
+	err = cmd.Wait()
+	wallTime := time.Since(start)
+
+	if len(setupErr) > 0 {
+		return jobResult{}, fmt.Errorf("setup: %s", setupErr)
+	}
+
+	res := jobResult{
+		WallTime:  wallTime,
+		CPUTime:   cg.cpuTime(),
+		Memory:    cg.memoryPeak(),
+		TimedOut:  errors.Is(ctx.Err(), context.DeadlineExceeded),
+		OOMKilled: cg.oomKilled(),
+	}
+
+	exitErr := &exec.ExitError{}
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		res.ExitCode = exitErr.ExitCode()
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			res.Signal = status.Signal()
+			// follow the shell convention
+			res.ExitCode = 128 + int(res.Signal)
+		}
+	default:
+		return jobResult{}, fmt.Errorf("wait: %w", err)
+	}
+
+	return res, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+// copyDir copies the regular files & dirs of src into dst
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, 0755)
+		case entry.Type().IsRegular():
+			return copyFile(path, target)
+		default:
+			return nil
+		}
+	})
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+)
+
+// Init is a no-op, the sandbox is only supported on linux
+func Init() {}
+
+// DefaultRegistry always fails, the sandbox is only supported on linux
+func DefaultRegistry(cfg Config) (*grading.Registry, error) {
+	return nil, errors.New("sandbox: only supported on linux")
+}
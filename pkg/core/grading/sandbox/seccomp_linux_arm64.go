@@ -0,0 +1,10 @@
+package sandbox
+
+import "golang.org/x/sys/unix"
+
+const auditArch = unix.AUDIT_ARCH_AARCH64
+
+// archSyscalls are the syscalls that only exist on arm64
+var archSyscalls = []uintptr{
+	unix.SYS_FSTATAT,
+}
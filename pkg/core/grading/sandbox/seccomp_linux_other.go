@@ -0,0 +1,9 @@
+//go:build linux && !amd64 && !arm64
+
+package sandbox
+
+import "errors"
+
+func installSeccomp() error {
+	return errors.New("seccomp allowlist is not available on this architecture")
+}
@@ -0,0 +1,117 @@
+package grading
+
+import (
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// DefaultMaxScore is the max score of an assignment that doesn't set one
+const DefaultMaxScore int32 = 100
+
+// ScoreCases sets the earned weight of each case and returns the score out of maxScore.
+//
+// A case without a group earns its weight when it's accepted,
+// or the PartialPercent of its weight when it's partially correct.
+// The cases of a group are scored all or nothing, they only earn
+// their weights when every case of the group is accepted.
+// A zero weight case is not scored.
+// The score is rounded down, it's zero when there is no weight at all.
+func ScoreCases(cases []CaseResult, maxScore int32) ([]CaseResult, int32) {
+	groupAccepted := map[string]bool{}
+	for _, caseResult := range cases {
+		if caseResult.Group == "" {
+			continue
+		}
+		accepted, ok := groupAccepted[caseResult.Group]
+		groupAccepted[caseResult.Group] = (accepted || !ok) && caseResult.IsAccepted()
+	}
+
+	scored := make([]CaseResult, len(cases))
+	// weights are counted in percent so partial cases aren't rounded before scoring
+	var totalWeight, earnedWeight int64
+	for i, caseResult := range cases {
+		var percent int64
+		switch {
+		case caseResult.Group != "":
+			if groupAccepted[caseResult.Group] {
+				percent = 100
+			}
+		case caseResult.IsAccepted():
+			percent = 100
+		case caseResult.Verdict == VerdictPartiallyCorrect:
+			percent = int64(min(max(caseResult.PartialPercent, 0), 100))
+		}
+
+		caseResult.EarnedWeight = int32(int64(caseResult.Weight) * percent / 100)
+
+		totalWeight += int64(caseResult.Weight) * 100
+		earnedWeight += int64(caseResult.Weight) * percent
+		scored[i] = caseResult
+	}
+
+	if totalWeight == 0 {
+		return scored, 0
+	}
+
+	return scored, int32(earnedWeight * int64(maxScore) / totalWeight)
+}
+
+// AttemptGrade is the grade of a submission attempt
+type AttemptGrade struct {
+	ID     uuid.UUID
+	Number int32
+	// Grade is the RawGrade after the late penalty
+	Grade    int32
+	RawGrade int32
+	MaxScore int32
+	IsGraded bool
+	Lateness Lateness
+}
+
+// ratio compares grades out of different max scores
+func (grade AttemptGrade) ratio() float64 {
+	if grade.MaxScore <= 0 {
+		return 0
+	}
+	return float64(grade.Grade) / float64(grade.MaxScore)
+}
+
+// ScorePolicy is which attempt grade is the submission grade
+type ScorePolicy string
+
+const (
+	ScorePolicyLast ScorePolicy = "last"
+	ScorePolicyBest ScorePolicy = "best"
+)
+
+// ScoredAttempt picks the attempt whose grade is the submission grade.
+//
+// The last policy picks the current attempt, graded or not.
+// The best policy picks the graded attempt with the highest grade ratio,
+// the latest one on a tie, or the current attempt when none is graded.
+func ScoredAttempt(policy ScorePolicy, currentAttemptID uuid.UUID, attempts []AttemptGrade) AttemptGrade {
+	current, _ := lo.Find(attempts, func(attempt AttemptGrade) bool {
+		return attempt.ID == currentAttemptID
+	})
+
+	if policy != ScorePolicyBest {
+		return current
+	}
+
+	best, found := AttemptGrade{}, false
+	for _, attempt := range attempts {
+		if !attempt.IsGraded {
+			continue
+		}
+
+		if !found || attempt.ratio() > best.ratio() ||
+			(attempt.ratio() == best.ratio() && attempt.Number > best.Number) {
+			best, found = attempt, true
+		}
+	}
+
+	if !found {
+		return current
+	}
+	return best
+}
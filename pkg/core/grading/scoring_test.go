@@ -0,0 +1,125 @@
+package grading
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestScoreCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		cases    []CaseResult
+		maxScore int32
+		score    int32
+		earned   []int32
+	}{
+		{"no case", nil, 100, 0, nil},
+		{
+			"weighted",
+			[]CaseResult{
+				{Verdict: VerdictAccepted, Weight: 3},
+				{Verdict: VerdictWrongAnswer, Weight: 1},
+			},
+			100, 75, []int32{3, 0},
+		},
+		{
+			"no weight at all",
+			[]CaseResult{
+				{Verdict: VerdictAccepted},
+				{Verdict: VerdictWrongAnswer},
+			},
+			100, 0, []int32{0, 0},
+		},
+		{
+			"group all or nothing",
+			[]CaseResult{
+				{Verdict: VerdictAccepted, Weight: 1},
+				{Verdict: VerdictAccepted, Weight: 2, Group: "a"},
+				{Verdict: VerdictTimeLimitExceeded, Weight: 2, Group: "a"},
+				{Verdict: VerdictAccepted, Weight: 5, Group: "b"},
+			},
+			10, 6, []int32{1, 0, 0, 5},
+		},
+		{
+			"zero weight is not scored",
+			[]CaseResult{
+				{Verdict: VerdictWrongAnswer, Weight: 0},
+				{Verdict: VerdictAccepted, Weight: 2},
+			},
+			100, 100, []int32{0, 2},
+		},
+		{
+			"custom max score",
+			[]CaseResult{
+				{Verdict: VerdictAccepted, Weight: 1},
+				{Verdict: VerdictAccepted, Weight: 1},
+				{Verdict: VerdictWrongAnswer, Weight: 1},
+			},
+			30, 20, []int32{1, 1, 0},
+		},
+		{
+			"partially correct",
+			[]CaseResult{
+				{Verdict: VerdictPartiallyCorrect, Weight: 3, PartialPercent: 50},
+				{Verdict: VerdictAccepted, Weight: 1},
+				{Verdict: VerdictPartiallyCorrect, Weight: 2, PartialPercent: 50, Group: "a"},
+			},
+			100, 41, []int32{1, 1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scored, score := ScoreCases(tt.cases, tt.maxScore)
+			if score != tt.score {
+				t.Errorf("want score %d, got %d", tt.score, score)
+			}
+
+			for i, caseResult := range scored {
+				if caseResult.EarnedWeight != tt.earned[i] {
+					t.Errorf("case %d: want earned weight %d, got %d", i+1, tt.earned[i], caseResult.EarnedWeight)
+				}
+			}
+		})
+	}
+}
+
+func TestScoredAttempt(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	attempts := []AttemptGrade{
+		{ID: ids[0], Number: 1, Grade: 80, MaxScore: 100, IsGraded: true},
+		{ID: ids[1], Number: 2, Grade: 40, MaxScore: 50, IsGraded: true},
+		{ID: ids[2], Number: 3, Grade: 0, MaxScore: 0, IsGraded: false},
+	}
+
+	tests := []struct {
+		name     string
+		policy   ScorePolicy
+		current  uuid.UUID
+		attempts []AttemptGrade
+		scored   uuid.UUID
+	}{
+		{"last picks the current attempt", ScorePolicyLast, ids[2], attempts, ids[2]},
+		{"best picks the latest of the same ratio", ScorePolicyBest, ids[2], attempts, ids[1]},
+		{"best without graded attempt", ScorePolicyBest, ids[2], attempts[2:], ids[2]},
+		{
+			"best picks the highest ratio",
+			ScorePolicyBest, ids[1],
+			[]AttemptGrade{
+				{ID: ids[0], Number: 1, Grade: 90, MaxScore: 100, IsGraded: true},
+				{ID: ids[1], Number: 2, Grade: 10, MaxScore: 100, IsGraded: true},
+			},
+			ids[0],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scored := ScoredAttempt(tt.policy, tt.current, tt.attempts)
+			if scored.ID != tt.scored {
+				t.Errorf("want attempt %s, got %s", tt.scored, scored.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package grading
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// SourceArchiveExt is the extension of a stored multi file submission,
+// its source files are stored as a zip archive
+const SourceArchiveExt = ".zip"
+
+// bounds of a multi file submission
+const (
+	MaxSourceFiles       = 100
+	MaxSourceFileSize    = 1 << 20
+	MaxSourceTotalSize   = 4 << 20
+	MaxSourceArchiveSize = 4 << 20
+	maxSourcePathLen     = 255
+)
+
+// SourceFile is a file of a multi file submission
+type SourceFile struct {
+	// Path is slash separated & relative to the project root, e.g. src/main.cpp
+	Path    string
+	Content []byte
+}
+
+// IsSourceArchive reports whether the stored submission file is a multi file submission
+func IsSourceArchive(fileName string) bool {
+	return strings.EqualFold(path.Ext(fileName), SourceArchiveExt)
+}
+
+// ValidateSourceFiles checks the file count, sizes & paths of a multi file submission.
+// A path must be relative & clean, so the files can't be written outside the project root.
+func ValidateSourceFiles(files []SourceFile) error {
+	if len(files) == 0 {
+		return errors.New("submission has no source file")
+	}
+
+	if len(files) > MaxSourceFiles {
+		return fmt.Errorf("submission must not have more than %d files", MaxSourceFiles)
+	}
+
+	paths := map[string]bool{}
+	totalSize := 0
+	for _, file := range files {
+		if err := validSourcePath(file.Path); err != nil {
+			return err
+		}
+
+		if paths[file.Path] {
+			return fmt.Errorf("duplicate source file %q", file.Path)
+		}
+		paths[file.Path] = true
+
+		if len(file.Content) > MaxSourceFileSize {
+			return fmt.Errorf("source file %q must not be larger than %d KiB", file.Path, MaxSourceFileSize>>10)
+		}
+
+		totalSize += len(file.Content)
+		if totalSize > MaxSourceTotalSize {
+			return fmt.Errorf("submission must not be larger than %d KiB", MaxSourceTotalSize>>10)
+		}
+	}
+
+	// a file can't be the dir of another file
+	for _, file := range files {
+		for dir := path.Dir(file.Path); dir != "."; dir = path.Dir(dir) {
+			if paths[dir] {
+				return fmt.Errorf("source file %q is also a dir", dir)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validSourcePath(name string) error {
+	if name == "" || len(name) > maxSourcePathLen {
+		return fmt.Errorf("source file path must be between 1 and %d characters", maxSourcePathLen)
+	}
+
+	if strings.ContainsAny(name, "\\:\x00") || strings.ContainsFunc(name, func(r rune) bool { return r < ' ' }) {
+		return fmt.Errorf("invalid source file path %q", name)
+	}
+
+	if path.IsAbs(name) || path.Clean(name) != name {
+		return fmt.Errorf("source file path %q must be relative & clean", name)
+	}
+
+	for _, elem := range strings.Split(name, "/") {
+		if elem == ".." || elem == "." {
+			return fmt.Errorf("source file path %q must not leave the project", name)
+		}
+	}
+
+	return nil
+}
+
+// ReadSourceArchive reads the files of a zip archive, dirs are skipped.
+// A single top dir shared by every file is stripped, e.g. project/main.cpp is main.cpp.
+// The sizes are checked while reading, the archive headers are not trusted.
+func ReadSourceArchive(archive []byte) ([]SourceFile, error) {
+	if len(archive) > MaxSourceArchiveSize {
+		return nil, fmt.Errorf("archive must not be larger than %d KiB", MaxSourceArchiveSize>>10)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive: %w", err)
+	}
+
+	files := []SourceFile{}
+	totalSize := 0
+	for _, entry := range reader.File {
+		// metadata of the macOS archiver
+		if entry.FileInfo().IsDir() || strings.HasPrefix(entry.Name, "__MACOSX/") {
+			continue
+		}
+
+		if !entry.Mode().IsRegular() {
+			return nil, fmt.Errorf("archive entry %q is not a regular file", entry.Name)
+		}
+
+		if len(files) == MaxSourceFiles {
+			return nil, fmt.Errorf("submission must not have more than %d files", MaxSourceFiles)
+		}
+
+		content, err := readArchiveEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		totalSize += len(content)
+		if totalSize > MaxSourceTotalSize {
+			return nil, fmt.Errorf("submission must not be larger than %d KiB", MaxSourceTotalSize>>10)
+		}
+
+		files = append(files, SourceFile{Path: entry.Name, Content: content})
+	}
+
+	files = stripTopDir(files)
+
+	if err := ValidateSourceFiles(files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func readArchiveEntry(entry *zip.File) ([]byte, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("open archive entry %q: %w", entry.Name, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, MaxSourceFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read archive entry %q: %w", entry.Name, err)
+	}
+
+	if len(content) > MaxSourceFileSize {
+		return nil, fmt.Errorf("source file %q must not be larger than %d KiB", entry.Name, MaxSourceFileSize>>10)
+	}
+
+	return content, nil
+}
+
+func stripTopDir(files []SourceFile) []SourceFile {
+	if len(files) == 0 {
+		return files
+	}
+
+	topDir, _, ok := strings.Cut(files[0].Path, "/")
+	if !ok || topDir == "" {
+		return files
+	}
+
+	prefix := topDir + "/"
+	for _, file := range files {
+		if !strings.HasPrefix(file.Path, prefix) {
+			return files
+		}
+	}
+
+	stripped := make([]SourceFile, len(files))
+	for i, file := range files {
+		stripped[i] = SourceFile{Path: strings.TrimPrefix(file.Path, prefix), Content: file.Content}
+	}
+	return stripped
+}
+
+// WriteSourceArchive writes the files into a zip archive sorted by path, it's how they are stored
+func WriteSourceArchive(files []SourceFile) ([]byte, error) {
+	sorted := slices.Clone(files)
+	slices.SortFunc(sorted, func(a, b SourceFile) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	buf := bytes.NewBuffer(nil)
+	writer := zip.NewWriter(buf)
+	for _, file := range sorted {
+		dst, err := writer.CreateHeader(&zip.FileHeader{Name: file.Path, Method: zip.Deflate})
+		if err != nil {
+			return nil, fmt.Errorf("create archive entry %q: %w", file.Path, err)
+		}
+
+		if _, err := dst.Write(file.Content); err != nil {
+			return nil, fmt.Errorf("write archive entry %q: %w", file.Path, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("close archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteSourceFiles writes the files into the dir, the file paths must be valid, see ValidateSourceFiles
+func WriteSourceFiles(dir string, files []SourceFile) error {
+	for _, file := range files {
+		name := filepath.Join(dir, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return fmt.Errorf("create dir of %q: %w", file.Path, err)
+		}
+
+		if err := os.WriteFile(name, file.Content, 0644); err != nil {
+			return fmt.Errorf("write %q: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// FindSourceFile finds the file by its path
+func FindSourceFile(files []SourceFile, name string) (SourceFile, bool) {
+	for _, file := range files {
+		if file.Path == name {
+			return file, true
+		}
+	}
+	return SourceFile{}, false
+}
+
+// SubmissionSource is the source code of a stored submission file
+type SubmissionSource struct {
+	// Code is the source of a single file submission,
+	// or the files of a multi file submission each headed by its path
+	Code string
+	// Files are only set for a multi file submission
+	Files []SourceFile
+}
+
+// ReadSubmissionSource reads the stored submission file named fileName
+func ReadSubmissionSource(fileName string, buf []byte) (SubmissionSource, error) {
+	if !IsSourceArchive(fileName) {
+		return SubmissionSource{Code: string(buf)}, nil
+	}
+
+	files, err := ReadSourceArchive(buf)
+	if err != nil {
+		return SubmissionSource{}, err
+	}
+
+	return SubmissionSource{Code: JoinSourceFiles(files), Files: files}, nil
+}
+
+// JoinSourceFiles joins the files into a single text, e.g. to be diffed
+func JoinSourceFiles(files []SourceFile) string {
+	code, _ := joinSourceFiles(files)
+	return code
+}
+
+// SourceFileHeaderLines returns the 1-based lines of the file path headers in JoinSourceFiles
+func SourceFileHeaderLines(files []SourceFile) []int {
+	_, headerLines := joinSourceFiles(files)
+	return headerLines
+}
+
+func joinSourceFiles(files []SourceFile) (string, []int) {
+	sb := strings.Builder{}
+	headerLines := make([]int, len(files))
+	// line is the line the next write starts at
+	line := 1
+	for i, file := range files {
+		if i > 0 {
+			sb.WriteString("\n")
+			line++
+		}
+		headerLines[i] = line
+		fmt.Fprintf(&sb, "===== %s =====\n", file.Path)
+		line++
+		sb.Write(file.Content)
+		line += bytes.Count(file.Content, []byte("\n"))
+		if len(file.Content) > 0 && !bytes.HasSuffix(file.Content, []byte("\n")) {
+			sb.WriteString("\n")
+			line++
+		}
+	}
+	return sb.String(), headerLines
+}
@@ -0,0 +1,116 @@
+package grading
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func zipArchive(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	buf := bytes.NewBuffer(nil)
+	writer := zip.NewWriter(buf)
+	for _, name := range names {
+		dst, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.HasSuffix(name, "/") {
+			continue
+		}
+		if _, err := dst.Write([]byte("// " + name)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadSourceArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		paths   []string
+		isErr   bool
+	}{
+		{"flat", []string{"main.cpp", "lib/util.h"}, []string{"main.cpp", "lib/util.h"}, false},
+		{"top dir stripped", []string{"project/", "project/main.cpp", "project/lib/util.h"}, []string{"main.cpp", "lib/util.h"}, false},
+		{"macos metadata skipped", []string{"main.cpp", "__MACOSX/._main.cpp"}, []string{"main.cpp"}, false},
+		{"path traversal", []string{"main.cpp", "../evil.sh"}, nil, true},
+		{"absolute path", []string{"/etc/passwd"}, nil, true},
+		{"duplicate", []string{"main.cpp", "main.cpp"}, nil, true},
+		{"file is a dir", []string{"lib", "lib/util.h"}, nil, true},
+		{"empty", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := ReadSourceArchive(zipArchive(t, tt.entries...))
+			if tt.isErr {
+				if err == nil {
+					t.Fatalf("want error, got %d files", len(files))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(files) != len(tt.paths) {
+				t.Fatalf("want %d files, got %d", len(tt.paths), len(files))
+			}
+			for i, file := range files {
+				if file.Path != tt.paths[i] {
+					t.Errorf("want path %q, got %q", tt.paths[i], file.Path)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteSourceArchive(t *testing.T) {
+	files := []SourceFile{
+		{Path: "src/util.cpp", Content: []byte("int util() { return 1; }\n")},
+		{Path: "main.cpp", Content: []byte("int main() {}\n")},
+	}
+
+	archive, err := WriteSourceArchive(files)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := ReadSubmissionSource("submission"+SourceArchiveExt, archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(source.Files) != 2 || source.Files[0].Path != "main.cpp" || source.Files[1].Path != "src/util.cpp" {
+		t.Fatalf("want files sorted by path, got %+v", source.Files)
+	}
+
+	want := "===== main.cpp =====\nint main() {}\n\n===== src/util.cpp =====\nint util() { return 1; }\n"
+	if source.Code != want {
+		t.Errorf("want code %q, got %q", want, source.Code)
+	}
+}
+
+func TestSourceFileHeaderLines(t *testing.T) {
+	files := []SourceFile{
+		{Path: "a.cpp", Content: []byte("int a;\nint b;\n")},
+		{Path: "empty.cpp"},
+		{Path: "no_newline.cpp", Content: []byte("int c;")},
+		{Path: "z.cpp", Content: []byte("int d;\n")},
+	}
+
+	lines := strings.Split(JoinSourceFiles(files), "\n")
+	for i, headerLine := range SourceFileHeaderLines(files) {
+		want := "===== " + files[i].Path + " ====="
+		if got := lines[headerLine-1]; got != want {
+			t.Errorf("want line %d to be %q, got %q", headerLine, want, got)
+		}
+	}
+}
@@ -2,29 +2,56 @@ package grading
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
 	"path"
+	"time"
 
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type SubmissionReader struct{}
 
-func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, objStorer core.ObjectStorer, rootDir string, id uuid.UUID) (Submission, error) {
+// FindByID finds the submission with the file & language of the attempt,
+// attemptID defaults to the current attempt when it's uuid.Nil
+func (SubmissionReader) FindByID(
+	ctx context.Context,
+	tx *gorm.DB,
+	objStorer core.ObjectStorer,
+	rootDir string,
+	id uuid.UUID,
+	attemptID uuid.UUID,
+) (Submission, error) {
 	tx = tx.WithContext(ctx)
 
+	// the row isn't locked while grading, see FindGradingStateForUpdate
 	submModel := dbmodel.Submission{}
 	if err := tx.Where("id = ?", id).Take(&submModel).Error; err != nil {
 		return Submission{}, fmt.Errorf("find submission: %w", err)
 	}
 
+	if attemptID == uuid.Nil {
+		attemptID = submModel.CurrentAttemptID
+	}
+
+	attemptModel := dbmodel.SubmissionAttempt{}
+	if err := tx.Where("id = ? and submission_id = ?", attemptID, id).Take(&attemptModel).Error; err != nil {
+		return Submission{}, fmt.Errorf("find attempt: %w", err)
+	}
+
+	attemptModels := []dbmodel.SubmissionAttempt{}
+	if err := tx.Where("submission_id = ?", id).Find(&attemptModels).Error; err != nil {
+		return Submission{}, fmt.Errorf("find attempts: %w", err)
+	}
+
 	submFile := dbmodel.File{}
-	if err := tx.Where("id = ?", submModel.FileID).Take(&submFile).Error; err != nil {
+	if err := tx.Where("id = ?", attemptModel.FileID).Take(&submFile).Error; err != nil {
 		return Submission{}, fmt.Errorf("find submission file: %w", err)
 	}
 
@@ -33,11 +60,20 @@ func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, objStorer cor
 		return Submission{}, fmt.Errorf("find assignment: %w", err)
 	}
 
-	var assignmentFiles []dbmodel.File
-	caseFileIDs := []uuid.UUID{assignmentModel.CaseInputFileID, assignmentModel.CaseOutputFileID}
+	extensionModel := dbmodel.AssignmentExtension{}
+	err := tx.Where("assignment_id = ? and student_id = ?", assignmentModel.ID, submModel.SubmittedBy).
+		Take(&extensionModel).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return Submission{}, fmt.Errorf("find extension: %w", err)
+	}
+	hasExtension := err == nil
 
-	if err := tx.Where("id in (?)", caseFileIDs).Find(&assignmentFiles).Error; err != nil {
-		return Submission{}, fmt.Errorf("find case files: %w", err)
+	testCaseModels := []dbmodel.AssignmentTestCase{}
+	err = tx.Where("assignment_id = ?", assignmentModel.ID).
+		Order("position asc, created_at asc").
+		Find(&testCaseModels).Error
+	if err != nil {
+		return Submission{}, fmt.Errorf("find test cases: %w", err)
 	}
 
 	studentModel := dbmodel.User{}
@@ -50,25 +86,23 @@ func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, objStorer cor
 		return Submission{}, fmt.Errorf("find assigner: %w", err)
 	}
 
-	caseInputModel, ok := lo.Find(assignmentFiles, func(file dbmodel.File) bool {
-		return file.Type == dbmodel.FileTypeAssignmentCaseInput
-	})
-	if !ok {
-		return Submission{}, fmt.Errorf("case input file not found")
-	}
-
-	caseOutputModel, ok := lo.Find(assignmentFiles, func(file dbmodel.File) bool {
-		return file.Type == dbmodel.FileTypeAssignmentCaseOutput
-	})
-	if !ok {
-		return Submission{}, fmt.Errorf("case input file not found")
-	}
-
 	submission := Submission{
-		ID:        id,
-		Grade:     submModel.Grade,
-		Feedback:  submModel.Feedback,
-		UpdatedAt: submModel.UpdatedAt.Time,
+		ID:               id,
+		AttemptID:        attemptModel.ID,
+		CurrentAttemptID: submModel.CurrentAttemptID,
+		Attempts:         toAttemptGrades(attemptModels),
+		SubmittedAt:      attemptModel.CreatedAt.Time,
+		Grade:            attemptModel.Grade,
+		Feedback:         submModel.Feedback,
+		Manual: ManualGrade{
+			RubricPoints:    submModel.RubricPoints,
+			RubricMaxPoints: submModel.RubricMaxPoints,
+			IsOverridden:    submModel.IsOverridden == 1,
+			OverrideGrade:   submModel.OverrideGrade,
+			OverrideReason:  submModel.OverrideReason,
+		},
+		Language:  Language(attemptModel.Language),
+		UpdatedAt: attemptModel.UpdatedAt.Time,
 		SubmissionFile: SubmissionFile{
 			FileName: submFile.Name,
 			FilePath: submFile.Path,
@@ -85,49 +119,302 @@ func (SubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, objStorer cor
 		Assignment: Assignment{
 			ID:         assignmentModel.ID,
 			DeadlineAt: assignmentModel.DeadlineAt,
+			TestCases:  toTestCases(testCaseModels),
+			Checker: CheckerConfig{
+				Type:       CheckerType(assignmentModel.CheckerType),
+				AbsEpsilon: assignmentModel.CheckerAbsEpsilon,
+				RelEpsilon: assignmentModel.CheckerRelEpsilon,
+				Source:     assignmentModel.CheckerSource,
+				InteractorLimits: Limits{
+					TimeLimit:   Second(assignmentModel.InteractorTimeLimitSec),
+					MemoryLimit: Mib(assignmentModel.InteractorMemoryLimitMib),
+				},
+			},
+			MaxScore:      assignmentModel.MaxScore,
+			ScorePolicy:   ScorePolicy(assignmentModel.ScorePolicy),
+			TestVersion:   assignmentModel.TestVersion,
+			TestFramework: TestFramework(assignmentModel.TestFramework),
+			BuildCommand:  assignmentModel.BuildCommand,
+			LatePolicy: LatePolicy{
+				HardDeadlineAt:       assignmentModel.HardDeadlineAt.Time,
+				GracePeriod:          time.Duration(assignmentModel.LateGracePeriodSec) * time.Second,
+				PenaltyPercentPerDay: assignmentModel.LatePenaltyPercentPerDay,
+			},
+			Limits: Limits{
+				TimeLimit:    Second(assignmentModel.TimeLimitSec),
+				MemoryLimit:  Mib(assignmentModel.MemoryLimitMib),
+				OutputLimit:  Kib(assignmentModel.OutputLimitKib),
+				ProcessLimit: assignmentModel.ProcessLimit,
+			},
 		},
 	}
 
-	// defer close file when error, so we don't leak it
-	{
-		var err error
+	// the late penalty is from the student extended deadline
+	if hasExtension {
+		submission.Assignment = submission.Assignment.WithExtendedDeadline(extensionModel.DeadlineAt)
+	}
 
-		submissionFile, err := objStorer.Seek(ctx, path.Join(rootDir, submFile.Path))
-		defer closeWhenErr(err, submissionFile)
-		if err != nil {
-			return Submission{}, fmt.Errorf("seek submission file: %w", err)
+	submissionFile, err := objStorer.Seek(ctx, path.Join(rootDir, submFile.Path))
+	if err != nil {
+		return Submission{}, fmt.Errorf("seek submission file: %w", err)
+	}
+	submission.SubmissionFile.File = submissionFile
+
+	submission.Assignment.HarnessFiles, err = findHarnessFiles(ctx, tx, objStorer, rootDir, assignmentModel.ID)
+	if err != nil {
+		submissionFile.Close()
+		return Submission{}, fmt.Errorf("find harness files: %w", err)
+	}
+
+	// the legacy case files are only used when there is no test case
+	if len(testCaseModels) > 0 {
+		return submission, nil
+	}
+
+	var assignmentFiles []dbmodel.File
+	caseFileIDs := []uuid.UUID{assignmentModel.CaseInputFileID, assignmentModel.CaseOutputFileID}
+
+	if err := tx.Where("id in (?)", caseFileIDs).Find(&assignmentFiles).Error; err != nil {
+		submissionFile.Close()
+		return Submission{}, fmt.Errorf("find case files: %w", err)
+	}
+
+	caseInputModel, okInput := lo.Find(assignmentFiles, func(file dbmodel.File) bool {
+		return file.Type == dbmodel.FileTypeAssignmentCaseInput
+	})
+
+	caseOutputModel, okOutput := lo.Find(assignmentFiles, func(file dbmodel.File) bool {
+		return file.Type == dbmodel.FileTypeAssignmentCaseOutput
+	})
+
+	if !okInput || !okOutput {
+		return submission, nil
+	}
+
+	caseInputFile, err := objStorer.Seek(ctx, path.Join(rootDir, caseInputModel.Path))
+	if err != nil {
+		submissionFile.Close()
+		return Submission{}, fmt.Errorf("seek case input: %w", err)
+	}
+
+	caseOutputFile, err := objStorer.Seek(ctx, path.Join(rootDir, caseOutputModel.Path))
+	if err != nil {
+		submissionFile.Close()
+		caseInputFile.Close()
+		return Submission{}, fmt.Errorf("seek case output: %w", err)
+	}
+
+	submission.Assignment.CaseInputFile.File = caseInputFile
+	submission.Assignment.CaseOutputFile.File = caseOutputFile
+
+	return submission, nil
+}
+
+// findHarnessFiles opens the harness files of the assignment, the caller closes them
+// FindGradingStateForUpdate locks the submission row until the transaction ends
+// and finds the state the grade is saved against, the attempts of a submission
+// are saved one at a time so the scored attempt is picked from their latest grades
+func (SubmissionReader) FindGradingStateForUpdate(ctx context.Context, tx *gorm.DB, id uuid.UUID) (GradingState, error) {
+	tx = tx.WithContext(ctx)
+
+	submModel := dbmodel.Submission{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Take(&submModel).Error; err != nil {
+		return GradingState{}, fmt.Errorf("find submission: %w", err)
+	}
+
+	attemptModels := []dbmodel.SubmissionAttempt{}
+	if err := tx.Where("submission_id = ?", id).Find(&attemptModels).Error; err != nil {
+		return GradingState{}, fmt.Errorf("find attempts: %w", err)
+	}
+
+	assignmentModel := dbmodel.Assignment{}
+	err := tx.Select("test_version", "score_policy").
+		Where("id = ?", submModel.AssignmentID).
+		Take(&assignmentModel).Error
+	if err != nil {
+		return GradingState{}, fmt.Errorf("find assignment: %w", err)
+	}
+
+	return GradingState{
+		CurrentAttemptID: submModel.CurrentAttemptID,
+		Attempts:         toAttemptGrades(attemptModels),
+		Manual: ManualGrade{
+			RubricPoints:    submModel.RubricPoints,
+			RubricMaxPoints: submModel.RubricMaxPoints,
+			IsOverridden:    submModel.IsOverridden == 1,
+			OverrideGrade:   submModel.OverrideGrade,
+			OverrideReason:  submModel.OverrideReason,
+		},
+		TestVersion: assignmentModel.TestVersion,
+		ScorePolicy: ScorePolicy(assignmentModel.ScorePolicy),
+	}, nil
+}
+
+func findHarnessFiles(ctx context.Context, tx *gorm.DB, objStorer core.ObjectStorer, rootDir string, assignmentID uuid.UUID) ([]HarnessFile, error) {
+	harnessModels := []dbmodel.AssignmentHarnessFile{}
+	if err := tx.Where("assignment_id = ?", assignmentID).Order("name asc").Find(&harnessModels).Error; err != nil {
+		return nil, fmt.Errorf("find harness: %w", err)
+	}
+
+	if len(harnessModels) == 0 {
+		return nil, nil
+	}
+
+	fileModels := []dbmodel.File{}
+	fileIDs := lo.Map(harnessModels, func(model dbmodel.AssignmentHarnessFile, _ int) uuid.UUID {
+		return model.FileID
+	})
+	if err := tx.Where("id in (?)", fileIDs).Find(&fileModels).Error; err != nil {
+		return nil, fmt.Errorf("find files: %w", err)
+	}
+	fileMap := lo.KeyBy(fileModels, func(file dbmodel.File) uuid.UUID {
+		return file.ID
+	})
+
+	closeAll := func(files []HarnessFile) {
+		for _, file := range files {
+			file.File.Close()
 		}
+	}
 
-		caseInputFile, err := objStorer.Seek(ctx, path.Join(rootDir, caseInputModel.Path))
-		defer closeWhenErr(err, caseInputFile)
-		if err != nil {
-			return Submission{}, fmt.Errorf("seek case input: %w", err)
+	harnessFiles := []HarnessFile{}
+	for _, model := range harnessModels {
+		fileModel, ok := fileMap[model.FileID]
+		if !ok {
+			closeAll(harnessFiles)
+			return nil, fmt.Errorf("harness file %s not found", model.Name)
 		}
 
-		caseOutputFile, err := objStorer.Seek(ctx, path.Join(rootDir, caseOutputModel.Path))
-		defer closeWhenErr(err, caseOutputFile)
+		file, err := objStorer.Seek(ctx, path.Join(rootDir, fileModel.Path))
 		if err != nil {
-			return Submission{}, fmt.Errorf("seek case output: %w", err)
+			closeAll(harnessFiles)
+			return nil, fmt.Errorf("seek %s: %w", model.Name, err)
 		}
 
-		submission.SubmissionFile.File = submissionFile
-		submission.Assignment.CaseInputFile.File = caseInputFile
-		submission.Assignment.CaseOutputFile.File = caseOutputFile
+		harnessFiles = append(harnessFiles, HarnessFile{Name: model.Name, File: file})
 	}
 
-	return submission, nil
+	return harnessFiles, nil
 }
 
 type SubmissionWriter struct{}
 
+// Update saves the grade of the submission attempt,
+// the submission gets the grade of the scored attempt
 func (SubmissionWriter) Update(ctx context.Context, tx *gorm.DB, submission *Submission) error {
-	return tx.WithContext(ctx).Model(&dbmodel.Submission{}).
-		Where("id = ?", submission.ID).
+	tx = tx.WithContext(ctx)
+
+	err := tx.Model(&dbmodel.SubmissionAttempt{}).
+		Where("id = ?", submission.AttemptID).
 		UpdateColumns(map[string]any{
-			"grade":      submission.Grade,
-			"updated_at": submission.UpdatedAt,
-			"is_graded":  intBool(submission.IsGraded),
+			"grade":                submission.Grade,
+			"raw_grade":            submission.RawGrade,
+			"is_late":              intBool(submission.Lateness.IsLate),
+			"late_days":            submission.Lateness.Days,
+			"late_penalty_percent": submission.Lateness.PenaltyPercent,
+			"updated_at":           submission.UpdatedAt,
+			"is_graded":            intBool(submission.IsGraded),
+			"compile_output":       submission.CompileOutput,
+			"max_score":            submission.MaxScore,
 		}).Error
+	if err != nil {
+		return fmt.Errorf("update attempt: %w", err)
+	}
+
+	grade, maxScore := submission.FinalGrade()
+	submissionColumns := map[string]any{
+		"grade":                grade,
+		"max_score":            maxScore,
+		"auto_grade":           submission.Scored.Grade,
+		"auto_max_score":       submission.Scored.MaxScore,
+		"raw_grade":            submission.Scored.RawGrade,
+		"is_late":              intBool(submission.Scored.Lateness.IsLate),
+		"late_days":            submission.Scored.Lateness.Days,
+		"late_penalty_percent": submission.Scored.Lateness.PenaltyPercent,
+		"is_graded":            intBool(submission.Scored.IsGraded),
+		"scored_attempt_id":    submission.Scored.ID,
+		"updated_at":           submission.UpdatedAt,
+	}
+	// the compile output shown is the current attempt's
+	if submission.AttemptID == submission.CurrentAttemptID {
+		submissionColumns["compile_output"] = submission.CompileOutput
+	}
+
+	err = tx.Model(&dbmodel.Submission{}).
+		Where("id = ?", submission.ID).
+		UpdateColumns(submissionColumns).Error
+	if err != nil {
+		return fmt.Errorf("update submission: %w", err)
+	}
+
+	// case results are replaced on every grading
+	err = tx.Unscoped().Where("attempt_id = ?", submission.AttemptID).Delete(&dbmodel.SubmissionCaseResult{}).Error
+	if err != nil {
+		return fmt.Errorf("delete case results: %w", err)
+	}
+
+	if len(submission.CaseResults) == 0 {
+		return nil
+	}
+
+	caseResultModels := lo.Map(submission.CaseResults, func(caseResult CaseResult, _ int) dbmodel.SubmissionCaseResult {
+		model := dbmodel.SubmissionCaseResult{
+			Base: dbmodel.Base{
+				ID: uuid.New(),
+				Metadata: dbmodel.Metadata{
+					CreatedAt: null.TimeFrom(submission.UpdatedAt),
+					UpdatedAt: null.TimeFrom(submission.UpdatedAt),
+				},
+			},
+			SubmissionID: submission.ID,
+			AttemptID:    submission.AttemptID,
+			TestCaseID:   uuid.NullUUID{UUID: caseResult.TestCaseID, Valid: caseResult.TestCaseID != uuid.Nil},
+			CaseNumber:   caseResult.Number,
+			Name:         caseResult.Name,
+			Verdict:      string(caseResult.Verdict),
+			ExitCode:     int32(caseResult.ExitCode),
+			Stderr:       caseResult.Stderr,
+			WallTimeMs:   caseResult.WallTime.Milliseconds(),
+			CPUTimeMs:    caseResult.CPUTime.Milliseconds(),
+			Weight:       caseResult.Weight,
+			GroupName:    caseResult.Group,
+			EarnedWeight: caseResult.EarnedWeight,
+			IsHidden:     intBool(caseResult.IsHidden),
+		}
+
+		// the output of a hidden case may reveal its input
+		if !caseResult.IsHidden {
+			model.Input = Excerpt([]byte(caseResult.Input), CaseExcerptLimit)
+			model.ExpectedOutput = Excerpt([]byte(caseResult.Expected), CaseExcerptLimit)
+			model.Output = Excerpt([]byte(caseResult.Output), CaseExcerptLimit)
+		}
+
+		return model
+	})
+
+	if err = tx.Create(&caseResultModels).Error; err != nil {
+		return fmt.Errorf("create case results: %w", err)
+	}
+
+	return nil
+}
+
+func toAttemptGrades(models []dbmodel.SubmissionAttempt) []AttemptGrade {
+	return lo.Map(models, func(model dbmodel.SubmissionAttempt, _ int) AttemptGrade {
+		return AttemptGrade{
+			ID:       model.ID,
+			Number:   model.AttemptNumber,
+			Grade:    model.Grade,
+			RawGrade: model.RawGrade,
+			MaxScore: model.MaxScore,
+			IsGraded: model.IsGraded == 1,
+			Lateness: Lateness{
+				IsLate:         model.IsLate == 1,
+				Days:           model.LateDays,
+				PenaltyPercent: model.LatePenaltyPercent,
+			},
+		}
+	})
 }
 
 func intBool(b bool) int {
@@ -137,8 +424,18 @@ func intBool(b bool) int {
 	return 0
 }
 
-func closeWhenErr(err error, readCloser io.ReadCloser) {
-	if err != nil && readCloser != nil {
-		readCloser.Close()
+func toTestCases(models []dbmodel.AssignmentTestCase) []TestCase {
+	testCases := make([]TestCase, len(models))
+	for i, model := range models {
+		testCases[i] = TestCase{
+			ID:       model.ID,
+			Name:     model.Name,
+			Input:    model.Input,
+			Expected: model.ExpectedOutput,
+			Weight:   model.Weight,
+			IsHidden: model.IsHidden == 1,
+			Group:    model.GroupName,
+		}
 	}
+	return testCases
 }
@@ -0,0 +1,65 @@
+package isolate
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BoxPool hands out isolate box ids, so concurrent runs never share a box
+type BoxPool struct {
+	ids chan int
+	// reservedIDs are the boxes reserved for the interactive runs, see ReservePair
+	reservedIDs chan int
+	// reserveMu makes the two boxes of a pair reserved at once
+	reserveMu sync.Mutex
+}
+
+// NewBoxPool creates a pool of size boxes with ids starting from firstID
+func NewBoxPool(firstID, size int) *BoxPool {
+	ids := make(chan int, size)
+	for i := 0; i < size; i++ {
+		ids <- firstID + i
+	}
+	return &BoxPool{ids: ids, reservedIDs: make(chan int, size)}
+}
+
+// Acquire blocks until a box is free
+func (pool *BoxPool) Acquire() int {
+	return <-pool.ids
+}
+
+func (pool *BoxPool) Release(id int) {
+	pool.ids <- id
+}
+
+// ReservePair blocks until two boxes are free and reserves both for an interactor & its program,
+// the interactive runs then take their box with AcquireReserved. Acquiring the boxes of a pair
+// one by one deadlocks once every box is held by a run waiting on its pair.
+// release must be called once both runs are done.
+func (pool *BoxPool) ReservePair() (release func(), err error) {
+	if cap(pool.ids) < 2 {
+		return nil, fmt.Errorf("an interactive run needs 2 boxes, the pool has %d", cap(pool.ids))
+	}
+
+	pool.reserveMu.Lock()
+	first, second := <-pool.ids, <-pool.ids
+	pool.reserveMu.Unlock()
+
+	pool.reservedIDs <- first
+	pool.reservedIDs <- second
+
+	return func() {
+		// any two reserved boxes, a box of another pair may still be in use
+		pool.ids <- <-pool.reservedIDs
+		pool.ids <- <-pool.reservedIDs
+	}, nil
+}
+
+// AcquireReserved blocks until a box reserved by ReservePair is free
+func (pool *BoxPool) AcquireReserved() int {
+	return <-pool.reservedIDs
+}
+
+func (pool *BoxPool) ReleaseReserved(id int) {
+	pool.reservedIDs <- id
+}
@@ -0,0 +1,64 @@
+package isolate
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBoxPoolReservePair(t *testing.T) {
+	t.Run("pool of 1", func(t *testing.T) {
+		pool := NewBoxPool(0, 1)
+		if _, err := pool.ReservePair(); err == nil {
+			t.Fatalf("want error, a pair doesn't fit the pool")
+		}
+
+		// the box is still free for a single run
+		pool.Release(pool.Acquire())
+	})
+
+	t.Run("concurrent pairs", func(t *testing.T) {
+		pool := NewBoxPool(0, 3)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				release, err := pool.ReservePair()
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				defer release()
+
+				// the interactor & the program hold their box at the same time
+				first := pool.AcquireReserved()
+				second := pool.AcquireReserved()
+				time.Sleep(time.Millisecond)
+				pool.ReleaseReserved(first)
+				pool.ReleaseReserved(second)
+			}()
+			go func() {
+				defer wg.Done()
+				pool.Release(pool.Acquire())
+			}()
+		}
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("deadlock, the runs didn't finish")
+		}
+
+		if len(pool.ids) != 3 {
+			t.Errorf("want every box back in the pool, got %d", len(pool.ids))
+		}
+	})
+}
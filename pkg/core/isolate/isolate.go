@@ -1,31 +1,487 @@
+// Package isolate runs programs in IOI isolate boxes, see https://github.com/ioi/isolate.
+//
+// The build dir is mounted at /build and the source code dir at /src,
+// the same layout as the podman runner, so the grading.LanguageSpec commands work for both.
 package isolate
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/logs"
+)
+
+var isolateBinPath = "./pkg/bin/isolate/isolate"
+
+// isolate exit codes, any other is an internal error
+const (
+	exitCodeOK            = 0
+	exitCodeProgramFailed = 1
 )
 
+// limits that are not part of grading.Limits
+const (
+	compileMemLimit  grading.Mib = 1024
+	compileProcesses             = 128
+	// maxProcesses is used when the run has no process limit
+	maxProcesses       = 1024
+	fileSizeLimitKiB   = 64 << 10
+	openFileLimit      = 256
+	wallTimeMultiplier = 2
+)
+
+var programEnv = []string{
+	"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+	"HOME=/tmp",
+	"LANG=C.UTF-8",
+}
+
+type Config struct {
+	// BinPath defaults to ./pkg/bin/isolate/isolate
+	BinPath string
+	// FirstBoxID & BoxCount are the box ids used by the runners
+	FirstBoxID int
+	BoxCount   int
+	// ControlGroups enables the --cg mode, the memory limit is
+	// applied to the whole box instead of the address space of each process
+	ControlGroups bool
+}
+
+func (cfg Config) binPath() string {
+	if cfg.BinPath != "" {
+		return cfg.BinPath
+	}
+	return isolateBinPath
+}
+
+// DefaultRegistry registers an isolate runner for every supported language,
+// the runners share the same box pool
+func DefaultRegistry(cfg Config) (*grading.Registry, error) {
+	if cfg.BoxCount < 1 {
+		return nil, errors.New("isolate: box count must be at least 1")
+	}
+
+	if _, err := exec.LookPath(cfg.binPath()); err != nil {
+		return nil, fmt.Errorf("isolate: %w", err)
+	}
+
+	pool := NewBoxPool(cfg.FirstBoxID, cfg.BoxCount)
+	registry := grading.NewRegistry()
+	for _, spec := range grading.LanguageSpecs() {
+		registry.Register(spec, NewRunner(spec, cfg, pool))
+	}
+	for _, spec := range grading.FrameworkSpecs() {
+		registry.RegisterFramework(spec, NewRunner(spec.LanguageSpec(), cfg, pool))
+	}
+	return registry, nil
+}
+
+var _ grading.Runner = &Runner{}
+var _ grading.PairReserver = &Runner{}
+
+// Runner compiles & runs a program of the spec language in an isolate box
+type Runner struct {
+	Spec   grading.LanguageSpec
+	Config Config
+	Pool   *BoxPool
+}
+
+func NewRunner(spec grading.LanguageSpec, cfg Config, pool *BoxPool) *Runner {
+	return &Runner{Spec: spec, Config: cfg, Pool: pool}
+}
+
+// Compile compiles the source inside a box, then copies the box content out to the build dir
+func (r *Runner) Compile(arg grading.CompileArg) (grading.CompileResult, error) {
+	res, err := r.compile(arg)
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("isolate: compile %s: %w", r.Spec.Language, err)
+	}
+	return res, nil
+}
+
+func (r *Runner) compile(arg grading.CompileArg) (grading.CompileResult, error) {
+	box, err := r.acquireBox(false)
+	if err != nil {
+		return grading.CompileResult{}, err
+	}
+	defer r.releaseBox(box, false)
+
+	if arg.IsProject {
+		err = copyDir(arg.MountDir, box.dir)
+	} else {
+		err = copyFile(filepath.Join(arg.MountDir, arg.ProgramFileName), filepath.Join(box.dir, r.Spec.SourceFileName))
+	}
+	if err != nil {
+		return grading.CompileResult{}, fmt.Errorf("copy source: %w", err)
+	}
+
+	for _, name := range arg.SupportFileNames {
+		err = copyFile(filepath.Join(arg.MountDir, name), filepath.Join(box.dir, filepath.Base(name)))
+		if err != nil {
+			return grading.CompileResult{}, fmt.Errorf("copy support file: %w", err)
+		}
+	}
+
+	res := grading.CompileResult{Status: grading.RunStatusOK}
+
+	if compileCmd := arg.CompileCmd(r.Spec.CompileCmd); compileCmd != "" {
+		stderr := bytes.NewBuffer(nil)
+		meta, err := box.run(runRequest{
+			Command:   compileCmd,
+			WorkDir:   "/build",
+			Dirs:      []string{"/build=" + box.dir + ":rw"},
+			Stdout:    io.Discard,
+			Stderr:    stderr,
+			Time:      arg.Timeout,
+			WallTime:  arg.Timeout,
+			MemLimit:  compileMemLimit,
+			Processes: compileProcesses,
+		})
+		if err != nil {
+			return grading.CompileResult{}, err
+		}
+
+		res.Stderr = stderr.Bytes()
+		res.ExitCode = meta.ExitCode
+		res.Duration = meta.WallTime
+
+		switch meta.Status {
+		case "":
+		case metaStatusTimeout:
+			res.Status = grading.RunStatusTimeLimitExceeded
+		default:
+			res.Status = grading.RunStatusCompileError
+		}
+	}
+
+	if res.Status != grading.RunStatusOK {
+		return res, nil
+	}
+
+	if err := copyDir(box.dir, arg.BuildDir); err != nil {
+		return grading.CompileResult{}, fmt.Errorf("copy build: %w", err)
+	}
+
+	return res, nil
+}
+
+// Run copies the compiled program into a box and runs it
+func (r *Runner) Run(arg grading.RunnerArg) (grading.RunResult, error) {
+	res, err := r.run(arg)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("isolate: run %s: %w", r.Spec.Language, err)
+	}
+	return res, nil
+}
+
+func (r *Runner) run(arg grading.RunnerArg) (grading.RunResult, error) {
+	mountDir, err := filepath.Abs(arg.MountDir)
+	if err != nil {
+		return grading.RunResult{}, fmt.Errorf("mount dir: %w", err)
+	}
+
+	box, err := r.acquireBox(arg.Interactive)
+	if err != nil {
+		return grading.RunResult{}, err
+	}
+	defer r.releaseBox(box, arg.Interactive)
+
+	if err := copyDir(arg.BuildDir, box.dir); err != nil {
+		return grading.RunResult{}, fmt.Errorf("copy build: %w", err)
+	}
+
+	stdout := arg.NewStdout()
+	stderr := bytes.NewBuffer(nil)
+	meta, err := box.run(runRequest{
+		Command:   r.Spec.RunCmd + " " + grading.ShellQuoteAll(arg.Args),
+		WorkDir:   "/src",
+		Dirs:      []string{"/build=" + box.dir, "/src=" + mountDir},
+		Stdin:     arg.Input,
+		Stdout:    stdout,
+		Stderr:    stderr,
+		Time:      arg.RunTimeout,
+		WallTime:  arg.RunTimeout * wallTimeMultiplier,
+		MemLimit:  arg.MemLimit,
+		Processes: processLimit(arg.ProcessLimit),
+	})
+	if err != nil {
+		return grading.RunResult{}, err
+	}
+
+	res := grading.RunResult{
+		Output:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+		ExitCode: meta.ExitCode,
+		WallTime: meta.WallTime,
+		CPUTime:  meta.CPUTime,
+		Memory:   grading.Kib(max(meta.CgMem, meta.MaxRSS)),
+		Status:   stdout.Status(runStatus(meta)),
+	}
+
+	if meta.Status == metaStatusSignaled {
+		// follow the shell convention
+		res.ExitCode = 128 + meta.ExitSig
+	}
+
+	return res, nil
+}
+
+func processLimit(limit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return maxProcesses
+}
+
+func runStatus(meta Meta) grading.RunStatus {
+	switch {
+	case meta.OOM:
+		return grading.RunStatusMemoryLimitExceeded
+	case meta.Status == metaStatusTimeout:
+		return grading.RunStatusTimeLimitExceeded
+	case meta.Status == metaStatusRuntimeError, meta.Status == metaStatusSignaled:
+		return grading.RunStatusRuntimeError
+	default:
+		return grading.RunStatusOK
+	}
+}
+
+// ReservePair reserves the boxes of an interactor & its program run, see BoxPool.ReservePair
+func (r *Runner) ReservePair() (release func(), err error) {
+	release, err = r.Pool.ReservePair()
+	if err != nil {
+		return nil, fmt.Errorf("isolate: %w", err)
+	}
+	return release, nil
+}
+
+// acquireBox takes a box reserved by ReservePair for an interactive run
+func (r *Runner) acquireBox(interactive bool) (*isolator, error) {
+	boxID := 0
+	if interactive {
+		boxID = r.Pool.AcquireReserved()
+	} else {
+		boxID = r.Pool.Acquire()
+	}
+
+	box := &isolator{
+		binPath:       r.Config.binPath(),
+		boxID:         boxID,
+		controlGroups: r.Config.ControlGroups,
+	}
+
+	// a box left by a crashed run is reset
+	if err := box.cleanup(); err != nil {
+		r.releaseBoxID(box.boxID, interactive)
+		return nil, err
+	}
+
+	if err := box.init(); err != nil {
+		r.releaseBoxID(box.boxID, interactive)
+		return nil, err
+	}
+
+	return box, nil
+}
+
+func (r *Runner) releaseBox(box *isolator, interactive bool) {
+	if err := box.cleanup(); err != nil {
+		logs.Err(err, "isolate: release box", strconv.Itoa(box.boxID))
+	}
+	r.releaseBoxID(box.boxID, interactive)
+}
+
+func (r *Runner) releaseBoxID(boxID int, interactive bool) {
+	if interactive {
+		r.Pool.ReleaseReserved(boxID)
+		return
+	}
+	r.Pool.Release(boxID)
+}
+
+// isolator runs isolate commands on a single box
 type isolator struct {
+	binPath       string
+	boxID         int
+	controlGroups bool
+	// dir is the box working dir on the host, it is set on init
+	dir string
 }
 
-var isolateBinPath = "./pkg/bin/isolate/isolate"
+func (is *isolator) args(args ...string) []string {
+	base := []string{fmt.Sprintf("--box-id=%d", is.boxID)}
+	if is.controlGroups {
+		base = append(base, "--cg")
+	}
+	return append(base, args...)
+}
 
 func (is *isolator) init() error {
-	return exec.Command(isolateBinPath, "--init").Run()
+	out, err := exec.Command(is.binPath, is.args("--init")...).Output()
+	if err != nil {
+		return fmt.Errorf("init box %d: %w", is.boxID, commandErr(err))
+	}
+
+	is.dir = filepath.Join(strings.TrimSpace(string(out)), "box")
+	return nil
+}
+
+func (is *isolator) cleanup() error {
+	err := exec.Command(is.binPath, is.args("--cleanup")...).Run()
+	if err != nil {
+		return fmt.Errorf("cleanup box %d: %w", is.boxID, commandErr(err))
+	}
+	return nil
+}
+
+type runRequest struct {
+	Command string
+	WorkDir string
+	// Dirs are the isolate --dir rules
+	Dirs      []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	Time      grading.Second
+	WallTime  grading.Second
+	MemLimit  grading.Mib
+	Processes int
 }
 
-func (is *isolator) run(binName string) error {
-	return exec.Command(isolateBinPath, "--run", binName).Run()
+// run runs the command in the box, a failed program is reported in the meta, not as an error
+func (is *isolator) run(req runRequest) (Meta, error) {
+	metaFile, err := os.CreateTemp("", "autograd-isolate-meta-*")
+	if err != nil {
+		return Meta{}, fmt.Errorf("create meta file: %w", err)
+	}
+	metaFile.Close()
+	defer os.Remove(metaFile.Name())
+
+	args := []string{
+		"--meta=" + metaFile.Name(),
+		"--silent",
+		fmt.Sprintf("--time=%d", req.Time),
+		fmt.Sprintf("--wall-time=%d", req.WallTime),
+		fmt.Sprintf("--processes=%d", req.Processes),
+		fmt.Sprintf("--fsize=%d", fileSizeLimitKiB),
+		fmt.Sprintf("--open-files=%d", openFileLimit),
+		"--chdir=" + req.WorkDir,
+		"--dir=/etc",
+	}
+
+	if req.MemLimit > 0 {
+		memLimitKiB := int64(req.MemLimit) << 10
+		if is.controlGroups {
+			args = append(args, fmt.Sprintf("--cg-mem=%d", memLimitKiB))
+		} else {
+			args = append(args, fmt.Sprintf("--mem=%d", memLimitKiB))
+		}
+	}
+
+	for _, env := range programEnv {
+		args = append(args, "--env="+env)
+	}
+
+	for _, dir := range req.Dirs {
+		args = append(args, "--dir="+dir)
+	}
+
+	args = append(args, "--run", "--", "/bin/sh", "-c", req.Command)
+
+	cmd := exec.Command(is.binPath, is.args(args...)...)
+	cmd.Stdin = req.Stdin
+	cmd.Stdout = req.Stdout
+	cmd.Stderr = req.Stderr
+
+	err = cmd.Run()
+	exitErr := &exec.ExitError{}
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == exitCodeProgramFailed:
+	default:
+		return Meta{}, fmt.Errorf("run box %d: %w", is.boxID, err)
+	}
+
+	f, err := os.Open(metaFile.Name())
+	if err != nil {
+		return Meta{}, fmt.Errorf("open meta: %w", err)
+	}
+	defer f.Close()
+
+	meta, err := ParseMeta(f)
+	if err != nil {
+		return Meta{}, fmt.Errorf("parse meta: %w", err)
+	}
+
+	if meta.Status == metaStatusInternalError {
+		return Meta{}, fmt.Errorf("run box %d: %s", is.boxID, meta.Message)
+	}
+
+	return meta, nil
 }
 
-func (is *isolator) Exec(binPath string) (*exec.Cmd, error) {
-	isolate := isolator{}
+// commandErr adds the stderr of a failed command to the error
+func commandErr(err error) error {
+	exitErr := &exec.ExitError{}
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, bytes.TrimSpace(exitErr.Stderr))
+	}
+	return err
+}
+
+func copyFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
 
-	if err := isolate.init(); err != nil {
-		return nil, fmt.Errorf("init")
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
 	}
 
-	panic("not implemented")
-	// dst, err := os.Create("")
-	// io.Copy()
+	return out.Close()
+}
+
+// copyDir copies the regular files & dirs of src into dst, keeping the permissions
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, 0755)
+		case entry.Type().IsRegular():
+			return copyFile(path, target)
+		default:
+			return nil
+		}
+	})
 }
@@ -0,0 +1,83 @@
+package isolate
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// meta status codes
+const (
+	metaStatusRuntimeError  = "RE"
+	metaStatusSignaled      = "SG"
+	metaStatusTimeout       = "TO"
+	metaStatusInternalError = "XX"
+)
+
+// Meta is the run report written by isolate with --meta
+type Meta struct {
+	// Status is empty when the program exits with zero code
+	Status   string
+	Message  string
+	ExitCode int
+	ExitSig  int
+	Killed   bool
+	OOM      bool
+	CPUTime  time.Duration
+	WallTime time.Duration
+	// MaxRSS & CgMem are in KiB
+	MaxRSS int64
+	CgMem  int64
+}
+
+// ParseMeta parses the "key:value" lines of a meta file, unknown keys are ignored
+func ParseMeta(r io.Reader) (Meta, error) {
+	meta := Meta{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, val, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "status":
+			meta.Status = val
+		case "message":
+			meta.Message = val
+		case "exitcode":
+			meta.ExitCode, _ = strconv.Atoi(val)
+		case "exitsig":
+			meta.ExitSig, _ = strconv.Atoi(val)
+		case "killed":
+			meta.Killed = val == "1"
+		case "cg-oom-killed":
+			meta.OOM = val == "1"
+		case "time":
+			meta.CPUTime = parseSeconds(val)
+		case "time-wall":
+			meta.WallTime = parseSeconds(val)
+		case "max-rss":
+			meta.MaxRSS, _ = strconv.ParseInt(val, 10, 64)
+		case "cg-mem":
+			meta.CgMem, _ = strconv.ParseInt(val, 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Meta{}, err
+	}
+
+	return meta, nil
+}
+
+func parseSeconds(val string) time.Duration {
+	sec, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(sec * float64(time.Second))
+}
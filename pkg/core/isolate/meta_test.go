@@ -0,0 +1,48 @@
+package isolate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+)
+
+func TestParseMeta(t *testing.T) {
+	meta, err := ParseMeta(strings.NewReader("time:0.120\ntime-wall:0.250\nmax-rss:2048\ncg-mem:4096\nexitcode:3\nstatus:RE\nmessage:Exited with error status 3\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Meta{
+		Status:   metaStatusRuntimeError,
+		Message:  "Exited with error status 3",
+		ExitCode: 3,
+		CPUTime:  120 * time.Millisecond,
+		WallTime: 250 * time.Millisecond,
+		MaxRSS:   2048,
+		CgMem:    4096,
+	}
+	if meta != want {
+		t.Errorf("want %+v, got %+v", want, meta)
+	}
+}
+
+func TestRunStatus(t *testing.T) {
+	tests := []struct {
+		meta   Meta
+		status grading.RunStatus
+	}{
+		{Meta{}, grading.RunStatusOK},
+		{Meta{Status: metaStatusTimeout, Killed: true}, grading.RunStatusTimeLimitExceeded},
+		{Meta{Status: metaStatusSignaled, ExitSig: 9, OOM: true}, grading.RunStatusMemoryLimitExceeded},
+		{Meta{Status: metaStatusSignaled, ExitSig: 11}, grading.RunStatusRuntimeError},
+		{Meta{Status: metaStatusRuntimeError, ExitCode: 1}, grading.RunStatusRuntimeError},
+	}
+
+	for _, tt := range tests {
+		if status := runStatus(tt.meta); status != tt.status {
+			t.Errorf("%+v: want %s, got %s", tt.meta, tt.status, status)
+		}
+	}
+}
@@ -34,13 +34,22 @@ func (media MediaFile) GetInternalPath(baseURL string) string {
 
 func ValidExtension(ext Extension) bool {
 	switch ext {
-	case ".txt", ".cpp":
+	case ".txt", ".cpp", ".h", ".hpp", ".py", ".java", ".go", ".rs":
 		return true
 	default:
 		return false
 	}
 }
 
+// validExtension also allows the zip archive of a multi file submission,
+// its files are validated whenever it's read, see grading.ReadSourceArchive
+func validExtension(ext Extension, fileType MediaFileType) bool {
+	if fileType == MediaFileType(dbmodel.FileTypeSubmission) && ext == ".zip" {
+		return true
+	}
+	return ValidExtension(ext)
+}
+
 type CreateMediaRequest struct {
 	NewID     uuid.UUID
 	Now       time.Time
@@ -52,7 +61,7 @@ type CreateMediaRequest struct {
 }
 
 func CreateMediaFile(req CreateMediaRequest) (MediaFile, error) {
-	if !ValidExtension(req.Ext) {
+	if !validExtension(req.Ext, req.FileType) {
 		return MediaFile{}, errors.New("invalid extension")
 	}
 
@@ -19,6 +19,7 @@ type InternalFindMediaFileRequest struct {
 }
 
 type InternalFindMediaFileResponse struct {
+	FileName   string
 	BodyCloser io.ReadCloser
 }
 
@@ -35,6 +36,7 @@ func (query *MediaStoreQuery) InternalFindMediaFile(ctx context.Context, req Int
 	}
 
 	return InternalFindMediaFileResponse{
+		FileName:   mediaFile.FileName,
 		BodyCloser: readCloser,
 	}, nil
 }
@@ -0,0 +1,67 @@
+package plagiarism
+
+import (
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/google/uuid"
+)
+
+// DefaultMinScore is the score a similarity is considered suspicious from
+const DefaultMinScore = 50
+
+// Source is the current source file of a submission
+type Source struct {
+	SubmissionID uuid.UUID
+	Submitter    Submitter
+	Language     grading.Language
+	FileID       uuid.UUID
+	FilePath     string
+}
+
+type Submitter struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// Similarity is a pair of submissions of an assignment sharing source code
+type Similarity struct {
+	ID           uuid.UUID
+	AssignmentID uuid.UUID
+	First        Source
+	Second       Source
+	Score        int32
+	Regions      []Region
+	core.TimestampMetadata
+}
+
+type CreateSimilaritiesRequest struct {
+	Now          time.Time
+	AssignmentID uuid.UUID
+	Sources      []Source
+	Matches      []Match
+}
+
+// CreateSimilarities creates the similarities of the matched sources
+func CreateSimilarities(req CreateSimilaritiesRequest) []Similarity {
+	sources := make(map[uuid.UUID]Source, len(req.Sources))
+	for _, source := range req.Sources {
+		sources[source.SubmissionID] = source
+	}
+
+	similarities := make([]Similarity, 0, len(req.Matches))
+	for _, match := range req.Matches {
+		similarities = append(similarities, Similarity{
+			ID:                uuid.New(),
+			AssignmentID:      req.AssignmentID,
+			First:             sources[match.First],
+			Second:            sources[match.Second],
+			Score:             match.Score,
+			Regions:           match.Regions,
+			TimestampMetadata: core.NewTimestampMeta(req.Now),
+		})
+	}
+
+	return similarities
+}
@@ -0,0 +1,85 @@
+package plagiarism_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/jobqueue"
+	"github.com/fahmifan/autograd/pkg/jobqueue/outbox"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PlagiarismCmd struct {
+	*core.Ctx
+}
+
+// CheckAssignmentPlagiarism enqueues a plagiarism check of the submissions of an assignment,
+// the similarities of the previous check are replaced once it's done
+func (cmd *PlagiarismCmd) CheckAssignmentPlagiarism(
+	ctx context.Context,
+	req *connect.Request[autogradv1.CheckAssignmentPlagiarismRequest],
+) (*connect.Response[autogradv1.Empty], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.GradeAssignment) {
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+
+	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, errors.New("assignment not found"))
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismCmd: CheckAssignmentPlagiarism: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		granted, err := auth.CourseRoleReader{}.Authorize(ctx, tx, authUser, assignment.CourseID, auth.GradeAssignment)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismCmd: CheckAssignmentPlagiarism: CourseRoleReader{}.Authorize")
+			return core.ErrInternalServer
+		}
+		if !granted {
+			return connect.NewError(connect.CodePermissionDenied, nil)
+		}
+
+		_, err = cmd.OutboxEnqueuer.Enqueue(ctx, tx, outbox.EnqueueRequest{
+			JobType:       JobCheckPlagiarism,
+			IdempotentKey: jobqueue.IdempotentKey(fmt.Sprintf("%s:%d", assignment.ID, now.Unix())),
+			Payload: CheckPlagiarismPayload{
+				AssignmentID: assignment.ID,
+			},
+		})
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismCmd: CheckAssignmentPlagiarism: Enqueue")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ProtoEmptyResponse, nil
+}
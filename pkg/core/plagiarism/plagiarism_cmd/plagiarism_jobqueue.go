@@ -0,0 +1,118 @@
+package plagiarism_cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/core/plagiarism"
+	"github.com/fahmifan/autograd/pkg/jobqueue"
+	"github.com/fahmifan/autograd/pkg/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const JobCheckPlagiarism jobqueue.JobType = "check_plagiarism"
+
+type CheckPlagiarismHandler struct {
+	*core.Ctx
+}
+
+type CheckPlagiarismPayload struct {
+	AssignmentID uuid.UUID
+}
+
+func (handler *CheckPlagiarismHandler) JobType() jobqueue.JobType {
+	return JobCheckPlagiarism
+}
+
+func (handler *CheckPlagiarismHandler) Handle(ctx context.Context, tx *gorm.DB, payload jobqueue.Payload) error {
+	req := CheckPlagiarismPayload{}
+	err := jobqueue.UnmarshalPayload(payload, &req)
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: json.Unmarshal")
+	}
+
+	_, err = assignments.AssignmentReader{}.FindByID(ctx, tx, req.AssignmentID)
+	if core.IsDBNotFoundErr(err) {
+		logs.InfoCtx(ctx, "CheckPlagiarismHandler: Handle", "assignment is deleted", req.AssignmentID.String())
+		return nil
+	}
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: FindByID")
+	}
+
+	sources, err := plagiarism.SourceReader{}.FindAllByAssignmentID(ctx, tx, req.AssignmentID)
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: FindAllByAssignmentID")
+	}
+
+	// only the submissions of the same language are comparable
+	docsByLanguage := map[string][]plagiarism.Document{}
+	for _, source := range sources {
+		if !plagiarism.SupportedLanguage(source.Language) {
+			continue
+		}
+
+		code, headerLines, err := handler.readSource(ctx, source)
+		if err != nil {
+			return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: readSource")
+		}
+
+		tokens, err := plagiarism.Tokenize(source.Language, code)
+		if err != nil {
+			return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: Tokenize")
+		}
+		tokens = plagiarism.WithoutLines(tokens, headerLines)
+
+		lang := string(source.Language)
+		docsByLanguage[lang] = append(docsByLanguage[lang], plagiarism.NewDocument(source.SubmissionID, tokens))
+	}
+
+	matches := []plagiarism.Match{}
+	for _, docs := range docsByLanguage {
+		matches = append(matches, plagiarism.CompareAll(docs)...)
+	}
+
+	similarities := plagiarism.CreateSimilarities(plagiarism.CreateSimilaritiesRequest{
+		Now:          time.Now(),
+		AssignmentID: req.AssignmentID,
+		Sources:      sources,
+		Matches:      matches,
+	})
+
+	err = plagiarism.SimilarityWriter{}.ReplaceAllByAssignmentID(ctx, tx, req.AssignmentID, similarities)
+	if err != nil {
+		return logs.ErrWrapCtx(ctx, err, "CheckPlagiarismHandler: Handle: ReplaceAllByAssignmentID")
+	}
+
+	return nil
+}
+
+// readSource reads the code shown on the similarity, see grading.ReadSubmissionSource,
+// the match lines are of this code. The file path headers of a multi file submission
+// are returned to be skipped, they would match across every multi file submission.
+func (handler *CheckPlagiarismHandler) readSource(ctx context.Context, source plagiarism.Source) (string, []int, error) {
+	file, err := handler.ObjectStorer.Seek(ctx, path.Join(handler.RootDir, source.FilePath))
+	if err != nil {
+		return "", nil, fmt.Errorf("seek source file: %w", err)
+	}
+	defer file.Close()
+
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return "", nil, fmt.Errorf("read source file: %w", err)
+	}
+
+	submissionSource, err := grading.ReadSubmissionSource(source.FilePath, buf)
+	if err != nil {
+		return "", nil, fmt.Errorf("read submission source: %w", err)
+	}
+
+	return submissionSource.Code, grading.SourceFileHeaderLines(submissionSource.Files), nil
+}
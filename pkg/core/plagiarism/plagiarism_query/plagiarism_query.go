@@ -0,0 +1,158 @@
+package plagiarism_query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/assignments"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_query"
+	"github.com/fahmifan/autograd/pkg/core/plagiarism"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type PlagiarismQuery struct {
+	*core.Ctx
+}
+
+// FindAllSubmissionSimilarities finds the suspicious pairs of the last plagiarism check of an assignment,
+// the compared source codes are included to highlight the regions side by side
+func (query *PlagiarismQuery) FindAllSubmissionSimilarities(
+	ctx context.Context,
+	req *connect.Request[autogradv1.FindAllSubmissionSimilaritiesRequest],
+) (*connect.Response[autogradv1.FindAllSubmissionSimilaritiesResponse], error) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAnySubmissions) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	minScore := req.Msg.GetMinScore()
+	if minScore <= 0 {
+		minScore = plagiarism.DefaultMinScore
+	}
+
+	var similarities []plagiarism.Similarity
+	err = core.Transaction(ctx, query.Ctx, func(tx *gorm.DB) error {
+		assignment, err := assignments.AssignmentReader{}.FindByID(ctx, tx, assignmentID)
+		if core.IsDBNotFoundErr(err) {
+			return connect.NewError(connect.CodeNotFound, errors.New("assignment not found"))
+		}
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismQuery: FindAllSubmissionSimilarities: AssignmentReader{}.FindByID")
+			return core.ErrInternalServer
+		}
+
+		granted, err := auth.CourseRoleReader{}.Authorize(ctx, tx, authUser, assignment.CourseID, auth.ViewAnySubmissions)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismQuery: FindAllSubmissionSimilarities: CourseRoleReader{}.Authorize")
+			return core.ErrInternalServer
+		}
+		if !granted {
+			return core.ErrPermissionDenied
+		}
+
+		similarities, err = plagiarism.SimilarityReader{}.FindAllByAssignmentID(ctx, tx, assignmentID, minScore)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "PlagiarismQuery: FindAllSubmissionSimilarities: SimilarityReader{}.FindAllByAssignmentID")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// a submission is usually in many pairs, every file is read once
+	codes := map[uuid.UUID]string{}
+	for _, similarity := range similarities {
+		for _, source := range []plagiarism.Source{similarity.First, similarity.Second} {
+			if _, ok := codes[source.FileID]; ok {
+				continue
+			}
+
+			codes[source.FileID], err = query.readCode(ctx, source.FileID)
+			if err != nil {
+				logs.ErrCtx(ctx, err, "PlagiarismQuery: FindAllSubmissionSimilarities: readCode")
+				return nil, core.ErrInternalServer
+			}
+		}
+	}
+
+	return &connect.Response[autogradv1.FindAllSubmissionSimilaritiesResponse]{
+		Msg: &autogradv1.FindAllSubmissionSimilaritiesResponse{
+			Similarities: lo.Map(similarities, func(similarity plagiarism.Similarity, _ int) *autogradv1.SubmissionSimilarity {
+				return toSimilarityProto(similarity, codes)
+			}),
+		},
+	}, nil
+}
+
+func (query *PlagiarismQuery) readCode(ctx context.Context, fileID uuid.UUID) (string, error) {
+	mediaStoreQuery := mediastore_query.MediaStoreQuery{Ctx: query.Ctx}
+	media, err := mediaStoreQuery.InternalFindMediaFile(ctx, mediastore_query.InternalFindMediaFileRequest{
+		ID: fileID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("find media file: %w", err)
+	}
+	defer media.BodyCloser.Close()
+
+	buf, err := io.ReadAll(media.BodyCloser)
+	if err != nil {
+		return "", fmt.Errorf("read media file: %w", err)
+	}
+
+	source, err := grading.ReadSubmissionSource(media.FileName, buf)
+	if err != nil {
+		return "", fmt.Errorf("read submission source: %w", err)
+	}
+
+	return source.Code, nil
+}
+
+func toSimilarityProto(similarity plagiarism.Similarity, codes map[uuid.UUID]string) *autogradv1.SubmissionSimilarity {
+	toSourceProto := func(source plagiarism.Source) *autogradv1.SubmissionSimilarity_Source {
+		return &autogradv1.SubmissionSimilarity_Source{
+			SubmissionId:  source.SubmissionID.String(),
+			SubmitterId:   source.Submitter.ID.String(),
+			SubmitterName: source.Submitter.Name,
+			Language:      string(source.Language),
+			Code:          codes[source.FileID],
+		}
+	}
+
+	return &autogradv1.SubmissionSimilarity{
+		Id:     similarity.ID.String(),
+		First:  toSourceProto(similarity.First),
+		Second: toSourceProto(similarity.Second),
+		Score:  similarity.Score,
+		Regions: lo.Map(similarity.Regions, func(region plagiarism.Region, _ int) *autogradv1.SubmissionSimilarity_Region {
+			return &autogradv1.SubmissionSimilarity_Region{
+				FirstStartLine:  int32(region.First.Start),
+				FirstEndLine:    int32(region.First.End),
+				SecondStartLine: int32(region.Second.Start),
+				SecondEndLine:   int32(region.Second.End),
+			}
+		}),
+		TimestampMetadata: similarity.ProtoTimestampMetadata(),
+	}
+}
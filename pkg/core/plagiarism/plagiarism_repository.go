@@ -0,0 +1,220 @@
+package plagiarism
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type SourceReader struct{}
+
+// FindAllByAssignmentID finds the current source file of every submission of the assignment
+func (SourceReader) FindAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID) ([]Source, error) {
+	submissions := []dbmodel.Submission{}
+	err := tx.WithContext(ctx).Where("assignment_id = ?", assignmentID).
+		Order("created_at asc").
+		Find(&submissions).Error
+	if err != nil {
+		return nil, fmt.Errorf("find submissions: %w", err)
+	}
+
+	fileIDs := lo.Map(submissions, func(submission dbmodel.Submission, _ int) uuid.UUID {
+		return submission.FileID
+	})
+
+	return findSources(ctx, tx, submissions, fileIDs)
+}
+
+// findSources finds the sources of the submissions, fileIDs are the source files by the submission index
+func findSources(ctx context.Context, tx *gorm.DB, submissions []dbmodel.Submission, fileIDs []uuid.UUID) ([]Source, error) {
+	files := []dbmodel.File{}
+	err := tx.WithContext(ctx).Unscoped().Where("id IN ?", lo.Uniq(fileIDs)).Find(&files).Error
+	if err != nil {
+		return nil, fmt.Errorf("find files: %w", err)
+	}
+
+	submitterIDs := lo.Map(submissions, func(submission dbmodel.Submission, _ int) uuid.UUID {
+		return submission.SubmittedBy
+	})
+	users := []dbmodel.User{}
+	err = tx.WithContext(ctx).Unscoped().Where("id IN ?", lo.Uniq(submitterIDs)).Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("find submitters: %w", err)
+	}
+
+	fileByID := lo.KeyBy(files, func(file dbmodel.File) uuid.UUID { return file.ID })
+	userByID := lo.KeyBy(users, func(user dbmodel.User) uuid.UUID { return user.ID })
+
+	sources := make([]Source, len(submissions))
+	for i, submission := range submissions {
+		sources[i] = Source{
+			SubmissionID: submission.ID,
+			Submitter: Submitter{
+				ID:   submission.SubmittedBy,
+				Name: userByID[submission.SubmittedBy].Name,
+			},
+			Language: grading.Language(submission.Language),
+			FileID:   fileIDs[i],
+			FilePath: fileByID[fileIDs[i]].Path,
+		}
+	}
+
+	return sources, nil
+}
+
+type SimilarityWriter struct{}
+
+// ReplaceAllByAssignmentID replaces the similarities of the assignment with the latest check
+func (SimilarityWriter) ReplaceAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID, similarities []Similarity) error {
+	oldIDs := tx.Model(&dbmodel.SubmissionSimilarity{}).Select("id").Where("assignment_id = ?", assignmentID)
+	err := tx.WithContext(ctx).Unscoped().Where("similarity_id IN (?)", oldIDs).Delete(&dbmodel.SubmissionSimilarityRegion{}).Error
+	if err != nil {
+		return fmt.Errorf("delete regions: %w", err)
+	}
+
+	err = tx.WithContext(ctx).Unscoped().Where("assignment_id = ?", assignmentID).Delete(&dbmodel.SubmissionSimilarity{}).Error
+	if err != nil {
+		return fmt.Errorf("delete similarities: %w", err)
+	}
+
+	if len(similarities) == 0 {
+		return nil
+	}
+
+	models := []dbmodel.SubmissionSimilarity{}
+	regionModels := []dbmodel.SubmissionSimilarityRegion{}
+	for _, similarity := range similarities {
+		models = append(models, dbmodel.SubmissionSimilarity{
+			Base: dbmodel.Base{
+				ID:       similarity.ID,
+				Metadata: core.NewModelMetadata(similarity.TimestampMetadata),
+			},
+			AssignmentID:       similarity.AssignmentID,
+			FirstSubmissionID:  similarity.First.SubmissionID,
+			SecondSubmissionID: similarity.Second.SubmissionID,
+			FirstFileID:        similarity.First.FileID,
+			SecondFileID:       similarity.Second.FileID,
+			Score:              similarity.Score,
+		})
+
+		for _, region := range similarity.Regions {
+			regionModels = append(regionModels, dbmodel.SubmissionSimilarityRegion{
+				Base: dbmodel.Base{
+					ID:       uuid.New(),
+					Metadata: core.NewModelMetadata(similarity.TimestampMetadata),
+				},
+				SimilarityID:    similarity.ID,
+				FirstStartLine:  int32(region.First.Start),
+				FirstEndLine:    int32(region.First.End),
+				SecondStartLine: int32(region.Second.Start),
+				SecondEndLine:   int32(region.Second.End),
+			})
+		}
+	}
+
+	err = tx.WithContext(ctx).CreateInBatches(&models, 100).Error
+	if err != nil {
+		return fmt.Errorf("create similarities: %w", err)
+	}
+
+	if len(regionModels) == 0 {
+		return nil
+	}
+
+	err = tx.WithContext(ctx).CreateInBatches(&regionModels, 100).Error
+	if err != nil {
+		return fmt.Errorf("create regions: %w", err)
+	}
+
+	return nil
+}
+
+type SimilarityReader struct{}
+
+// FindAllByAssignmentID finds the similarities of the assignment scored at least minScore,
+// the most similar first. The pairs with a deleted submission are skipped.
+func (SimilarityReader) FindAllByAssignmentID(ctx context.Context, tx *gorm.DB, assignmentID uuid.UUID, minScore int32) ([]Similarity, error) {
+	models := []dbmodel.SubmissionSimilarity{}
+	err := tx.WithContext(ctx).
+		Where("assignment_id = ? AND score >= ?", assignmentID, minScore).
+		Order("score desc, created_at asc").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("find similarities: %w", err)
+	}
+	if len(models) == 0 {
+		return []Similarity{}, nil
+	}
+
+	submissionIDs := []uuid.UUID{}
+	for _, model := range models {
+		submissionIDs = append(submissionIDs, model.FirstSubmissionID, model.SecondSubmissionID)
+	}
+	submissions := []dbmodel.Submission{}
+	err = tx.WithContext(ctx).Where("id IN ?", lo.Uniq(submissionIDs)).Find(&submissions).Error
+	if err != nil {
+		return nil, fmt.Errorf("find submissions: %w", err)
+	}
+	submissionByID := lo.KeyBy(submissions, func(submission dbmodel.Submission) uuid.UUID { return submission.ID })
+
+	// every pair is resolved with its own compared files
+	pairSubmissions := []dbmodel.Submission{}
+	pairFileIDs := []uuid.UUID{}
+	pairModels := []dbmodel.SubmissionSimilarity{}
+	for _, model := range models {
+		first, okFirst := submissionByID[model.FirstSubmissionID]
+		second, okSecond := submissionByID[model.SecondSubmissionID]
+		if !okFirst || !okSecond {
+			continue
+		}
+		pairModels = append(pairModels, model)
+		pairSubmissions = append(pairSubmissions, first, second)
+		pairFileIDs = append(pairFileIDs, model.FirstFileID, model.SecondFileID)
+	}
+	if len(pairModels) == 0 {
+		return []Similarity{}, nil
+	}
+
+	sources, err := findSources(ctx, tx, pairSubmissions, pairFileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("find sources: %w", err)
+	}
+
+	regionModels := []dbmodel.SubmissionSimilarityRegion{}
+	similarityIDs := lo.Map(pairModels, func(model dbmodel.SubmissionSimilarity, _ int) uuid.UUID { return model.ID })
+	err = tx.WithContext(ctx).Where("similarity_id IN ?", similarityIDs).
+		Order("first_start_line asc").
+		Find(&regionModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("find regions: %w", err)
+	}
+	regionsBySimilarityID := lo.GroupBy(regionModels, func(model dbmodel.SubmissionSimilarityRegion) uuid.UUID {
+		return model.SimilarityID
+	})
+
+	similarities := make([]Similarity, len(pairModels))
+	for i, model := range pairModels {
+		similarities[i] = Similarity{
+			ID:           model.ID,
+			AssignmentID: model.AssignmentID,
+			First:        sources[2*i],
+			Second:       sources[2*i+1],
+			Score:        model.Score,
+			Regions: lo.Map(regionsBySimilarityID[model.ID], func(region dbmodel.SubmissionSimilarityRegion, _ int) Region {
+				return Region{
+					First:  LineRange{Start: int(region.FirstStartLine), End: int(region.FirstEndLine)},
+					Second: LineRange{Start: int(region.SecondStartLine), End: int(region.SecondEndLine)},
+				}
+			}),
+			TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+		}
+	}
+
+	return similarities, nil
+}
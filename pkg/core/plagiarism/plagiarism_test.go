@@ -0,0 +1,181 @@
+package plagiarism
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/google/uuid"
+)
+
+const cppSum = `#include <iostream>
+using namespace std;
+
+int main() {
+	int n, total = 0;
+	cin >> n;
+	for (int i = 0; i < n; i++) {
+		int x;
+		cin >> x;
+		total += x;
+	}
+	cout << total << endl;
+	return 0;
+}
+`
+
+// cppSumRenamed is cppSum with renamed variables, comments & blank lines
+const cppSumRenamed = `#include <bits/stdc++.h>
+using namespace std;
+
+// sum every number
+int main() {
+	int count, acc = 0;
+
+	cin >> count;
+	for (int j = 0; j < count; j++) {
+		int value; /* the next number */
+		cin >> value;
+		acc += value;
+	}
+	cout << acc << endl;
+	return 0;
+}
+`
+
+const cppMax = `#include <iostream>
+using namespace std;
+
+int main() {
+	long long a, b;
+	cin >> a >> b;
+	if (a > b) {
+		cout << a << "\n";
+	} else {
+		cout << b << "\n";
+	}
+}
+`
+
+func texts(tokens []Token) string {
+	words := make([]string, len(tokens))
+	for i, token := range tokens {
+		words[i] = token.Text
+	}
+	return strings.Join(words, " ")
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   grading.Language
+		source string
+		want   string
+	}{
+		{"cpp", grading.LanguageCPP, "#include <cstdio>\nint x = 10; // ten\n", "int I = N ;"},
+		{"cpp block comment", grading.LanguageCPP, "a /* b\nc */ + \"s\\\"\";", "I + S ;"},
+		{"python", grading.LanguagePython, "# read\nfor v in range(3):\n    print('x')\n", "for I in I ( N ) : I ( S )"},
+		{"python docstring", grading.LanguagePython, "def f():\n    \"\"\"doc\n    string\"\"\"\n    return None\n", "def I ( ) : S return None"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := Tokenize(tt.lang, tt.source)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if got := texts(tokens); got != tt.want {
+				t.Errorf("tokens %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	tokens, _ := Tokenize(grading.LanguagePython, "a = 1\n\"\"\"x\ny\"\"\"\nb = 2\n")
+	if last := tokens[len(tokens)-1]; last.Line != 4 {
+		t.Errorf("last token line %d, want 4", last.Line)
+	}
+
+	if _, err := Tokenize(grading.LanguageGo, "package main"); err != ErrUnsupportedLanguage {
+		t.Errorf("error %v, want ErrUnsupportedLanguage", err)
+	}
+}
+
+func TestTokenizeJoinedSourceFiles(t *testing.T) {
+	files := []grading.SourceFile{
+		{Path: "main.cpp", Content: []byte("int main() {\n  return f();\n}")},
+		{Path: "lib/f.cpp", Content: []byte("int f() { return 1; }\n")},
+	}
+
+	tokens, err := Tokenize(grading.LanguageCPP, grading.JoinSourceFiles(files))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	tokens = WithoutLines(tokens, grading.SourceFileHeaderLines(files))
+
+	want := "int I ( ) { return I ( ) ; } int I ( ) { return N ; }"
+	if got := texts(tokens); got != want {
+		t.Errorf("tokens %q, want %q", got, want)
+	}
+
+	// the lines are of the joined code shown on the similarity
+	if first, last := tokens[0].Line, tokens[len(tokens)-1].Line; first != 2 || last != 7 {
+		t.Errorf("token lines %d to %d, want 2 to 7", first, last)
+	}
+}
+
+func newTestDocument(t *testing.T, source string) Document {
+	t.Helper()
+	tokens, err := Tokenize(grading.LanguageCPP, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewDocument(uuid.New(), tokens)
+}
+
+func TestCompare(t *testing.T) {
+	sum := newTestDocument(t, cppSum)
+	renamed := newTestDocument(t, cppSumRenamed)
+	other := newTestDocument(t, cppMax)
+
+	match := Compare(sum, renamed)
+	if match.Score != 100 {
+		t.Errorf("renamed copy score %d, want 100", match.Score)
+	}
+	if len(match.Regions) != 1 {
+		t.Fatalf("renamed copy regions %v, want 1 region", match.Regions)
+	}
+	region := match.Regions[0]
+	// the region covers the copied loop, the last k-gram may not be selected
+	if region.First.Start != 2 || region.First.End < 11 || region.Second.Start != 2 || region.Second.End < 13 {
+		t.Errorf("region %+v, want the whole programs", region)
+	}
+
+	if match := Compare(sum, other); match.Score >= DefaultMinScore {
+		t.Errorf("different program score %d, want below %d", match.Score, DefaultMinScore)
+	}
+
+	if match := Compare(sum, newTestDocument(t, "int main() {}")); match.Score != 0 || len(match.Regions) != 0 {
+		t.Errorf("short program match %+v, want nothing", match)
+	}
+}
+
+func TestCompareAll(t *testing.T) {
+	docs := []Document{newTestDocument(t, cppMax), newTestDocument(t, cppSum), newTestDocument(t, cppSumRenamed)}
+
+	matches := CompareAll(docs)
+	if len(matches) == 0 {
+		t.Fatal("want the copied pair")
+	}
+	if first := matches[0]; first.First != docs[1].ID || first.Second != docs[2].ID {
+		t.Errorf("most similar pair %v & %v, want the copied pair", first.First, first.Second)
+	}
+
+	// the starter code of every submission is not a copy
+	starter := []Document{}
+	for i := 0; i <= MaxSharedDocuments; i++ {
+		starter = append(starter, newTestDocument(t, cppSum))
+	}
+	if matches := CompareAll(starter); len(matches) != 0 {
+		t.Errorf("starter code matches %d pairs, want none", len(matches))
+	}
+}
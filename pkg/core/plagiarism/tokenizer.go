@@ -0,0 +1,192 @@
+package plagiarism
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/samber/lo"
+)
+
+var ErrUnsupportedLanguage = errors.New("plagiarism: unsupported language")
+
+// Token is a normalized lexeme of a source code,
+// identifiers & literals are replaced by their kind so renaming a variable
+// or changing a constant does not hide a copied solution
+type Token struct {
+	Text string
+	// Line is the 1-based line of the token in the source code
+	Line int
+}
+
+const (
+	tokenIdent  = "I"
+	tokenNumber = "N"
+	tokenString = "S"
+)
+
+type lexer struct {
+	keywords map[string]bool
+	// lineComment starts a comment until the end of the line
+	lineComment string
+	// blockComment is the start & end of a multi line comment
+	blockComment [2]string
+	// tripleQuote enables python triple quoted strings
+	tripleQuote bool
+	// skipDirective skips the c preprocessor lines, they are mostly
+	// the same includes on every submission
+	skipDirective bool
+}
+
+var lexers = map[grading.Language]lexer{
+	grading.LanguageCPP: {
+		keywords: keywordSet(
+			"auto", "bool", "break", "case", "catch", "char", "class", "const", "continue",
+			"default", "delete", "do", "double", "else", "enum", "false", "float", "for",
+			"if", "int", "long", "namespace", "new", "nullptr", "private", "public",
+			"return", "short", "signed", "sizeof", "static", "struct", "switch", "template",
+			"this", "throw", "true", "try", "typedef", "unsigned", "using", "void", "while",
+		),
+		lineComment:   "//",
+		blockComment:  [2]string{"/*", "*/"},
+		skipDirective: true,
+	},
+	grading.LanguagePython: {
+		keywords: keywordSet(
+			"and", "as", "assert", "break", "class", "continue", "def", "del", "elif",
+			"else", "except", "False", "finally", "for", "from", "global", "if", "import",
+			"in", "is", "lambda", "None", "nonlocal", "not", "or", "pass", "raise",
+			"return", "True", "try", "while", "with", "yield",
+		),
+		lineComment: "#",
+		tripleQuote: true,
+	},
+}
+
+func keywordSet(keywords ...string) map[string]bool {
+	set := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		set[keyword] = true
+	}
+	return set
+}
+
+// SupportedLanguage reports whether submissions of the language can be compared
+func SupportedLanguage(lang grading.Language) bool {
+	_, ok := lexers[lang]
+	return ok
+}
+
+// Tokenize splits the source code into normalized tokens,
+// whitespaces & comments are dropped
+func Tokenize(lang grading.Language, source string) ([]Token, error) {
+	lex, ok := lexers[lang]
+	if !ok {
+		return nil, ErrUnsupportedLanguage
+	}
+
+	return lex.tokenize([]rune(source)), nil
+}
+
+// WithoutLines drops the tokens of the 1-based lines,
+// e.g. the file path headers of a multi file submission that match across every submission
+func WithoutLines(tokens []Token, lines []int) []Token {
+	if len(lines) == 0 {
+		return tokens
+	}
+
+	return lo.Filter(tokens, func(token Token, _ int) bool {
+		return !lo.Contains(lines, token.Line)
+	})
+}
+
+func (lex lexer) tokenize(src []rune) []Token {
+	tokens := []Token{}
+	line := 1
+	lineStart := true
+
+	hasPrefix := func(i int, prefix string) bool {
+		return prefix != "" && strings.HasPrefix(string(src[i:min(len(src), i+len(prefix))]), prefix)
+	}
+	// skipUntil returns the index after end, counting the newlines it skips
+	skipUntil := func(i int, end string) int {
+		for i < len(src) && !hasPrefix(i, end) {
+			if src[i] == '\n' {
+				line++
+			}
+			i++
+		}
+		return min(len(src), i+len(end))
+	}
+
+	for i := 0; i < len(src); {
+		r := src[i]
+		switch {
+		case r == '\n':
+			line++
+			lineStart = true
+			i++
+			continue
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case lex.skipDirective && lineStart && r == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case hasPrefix(i, lex.lineComment):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case hasPrefix(i, lex.blockComment[0]):
+			i = skipUntil(i+len(lex.blockComment[0]), lex.blockComment[1])
+			continue
+		}
+
+		lineStart = false
+		tokenLine := line
+		switch {
+		case lex.tripleQuote && (hasPrefix(i, `"""`) || hasPrefix(i, `'''`)):
+			quote := string(src[i : i+3])
+			i = skipUntil(i+3, quote)
+			tokens = append(tokens, Token{Text: tokenString, Line: tokenLine})
+		case r == '"' || r == '\'':
+			i++
+			for i < len(src) && src[i] != r && src[i] != '\n' {
+				if src[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			tokens = append(tokens, Token{Text: tokenString, Line: tokenLine})
+		case unicode.IsDigit(r):
+			for i < len(src) && (isIdentRune(src[i]) || src[i] == '.' || src[i] == '\'') {
+				i++
+			}
+			tokens = append(tokens, Token{Text: tokenNumber, Line: tokenLine})
+		case isIdentRune(r):
+			start := i
+			for i < len(src) && isIdentRune(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			if !lex.keywords[word] {
+				word = tokenIdent
+			}
+			tokens = append(tokens, Token{Text: word, Line: tokenLine})
+		default:
+			i++
+			tokens = append(tokens, Token{Text: string(r), Line: tokenLine})
+		}
+	}
+
+	return tokens
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
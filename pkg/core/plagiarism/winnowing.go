@@ -0,0 +1,227 @@
+package plagiarism
+
+import (
+	"hash/fnv"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// KGramSize is the number of tokens hashed into a fingerprint,
+	// matches shorter than it are ignored as noise
+	KGramSize = 10
+	// WindowSize is the number of consecutive k-gram hashes a fingerprint is
+	// selected from, any match of at least KGramSize+WindowSize-1 tokens
+	// shares a fingerprint
+	WindowSize = 5
+	// MaxSharedDocuments is the number of documents a fingerprint may appear
+	// in before it's considered as a starter code & ignored
+	MaxSharedDocuments = 10
+)
+
+// Fingerprint is a selected k-gram hash with the lines it covers
+type Fingerprint struct {
+	Hash      uint64
+	StartLine int
+	EndLine   int
+}
+
+// Document is the winnowed fingerprints of a source code
+type Document struct {
+	ID           uuid.UUID
+	Fingerprints []Fingerprint
+}
+
+// NewDocument selects the fingerprints of the tokens using the winnowing algorithm,
+// the smallest hash in every window is selected, the rightmost on ties
+func NewDocument(id uuid.UUID, tokens []Token) Document {
+	doc := Document{ID: id}
+	if len(tokens) < KGramSize {
+		return doc
+	}
+
+	kgrams := make([]Fingerprint, len(tokens)-KGramSize+1)
+	for i := range kgrams {
+		kgrams[i] = Fingerprint{
+			Hash:      hashTokens(tokens[i : i+KGramSize]),
+			StartLine: tokens[i].Line,
+			EndLine:   tokens[i+KGramSize-1].Line,
+		}
+	}
+
+	window := min(WindowSize, len(kgrams))
+	lastSelected := -1
+	for start := 0; start+window <= len(kgrams); start++ {
+		selected := start
+		for i := start; i < start+window; i++ {
+			if kgrams[i].Hash <= kgrams[selected].Hash {
+				selected = i
+			}
+		}
+		if selected != lastSelected {
+			doc.Fingerprints = append(doc.Fingerprints, kgrams[selected])
+			lastSelected = selected
+		}
+	}
+
+	return doc
+}
+
+func hashTokens(tokens []Token) uint64 {
+	hash := fnv.New64a()
+	for _, token := range tokens {
+		hash.Write([]byte(token.Text))
+		hash.Write([]byte{0})
+	}
+	return hash.Sum64()
+}
+
+func (doc Document) hashSet(ignored map[uint64]bool) map[uint64]bool {
+	set := make(map[uint64]bool, len(doc.Fingerprints))
+	for _, fp := range doc.Fingerprints {
+		if !ignored[fp.Hash] {
+			set[fp.Hash] = true
+		}
+	}
+	return set
+}
+
+// LineRange is an inclusive 1-based line range
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Region is a matched passage of two documents
+type Region struct {
+	First  LineRange
+	Second LineRange
+}
+
+// Match is the similarity of two documents
+type Match struct {
+	First  uuid.UUID
+	Second uuid.UUID
+	// Score is the percentage of the shared fingerprints
+	// of the document with less fingerprints
+	Score   int32
+	Regions []Region
+}
+
+// Compare matches two documents, the score is 0 when nothing is shared
+func Compare(a, b Document) Match {
+	return compare(a, b, nil)
+}
+
+// CompareAll matches every pair of the documents, only the pairs sharing
+// a fingerprint are returned, the most similar first.
+// The fingerprints shared by more than MaxSharedDocuments are ignored.
+func CompareAll(docs []Document) []Match {
+	ignored := sharedHashes(docs)
+
+	matches := []Match{}
+	for i := range docs {
+		for j := i + 1; j < len(docs); j++ {
+			match := compare(docs[i], docs[j], ignored)
+			if match.Score > 0 {
+				matches = append(matches, match)
+			}
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
+
+func sharedHashes(docs []Document) map[uint64]bool {
+	count := map[uint64]int{}
+	for _, doc := range docs {
+		for hash := range doc.hashSet(nil) {
+			count[hash]++
+		}
+	}
+
+	ignored := map[uint64]bool{}
+	for hash, n := range count {
+		if n > MaxSharedDocuments {
+			ignored[hash] = true
+		}
+	}
+	return ignored
+}
+
+func compare(a, b Document, ignored map[uint64]bool) Match {
+	match := Match{First: a.ID, Second: b.ID}
+
+	aHashes := a.hashSet(ignored)
+	bHashes := b.hashSet(ignored)
+	if len(aHashes) == 0 || len(bHashes) == 0 {
+		return match
+	}
+
+	shared := 0
+	for hash := range aHashes {
+		if bHashes[hash] {
+			shared++
+		}
+	}
+	if shared == 0 {
+		return match
+	}
+
+	match.Score = int32(shared * 100 / min(len(aHashes), len(bHashes)))
+	match.Regions = matchRegions(a, b, ignored)
+
+	return match
+}
+
+// matchRegions pairs the shared fingerprints in the order of a,
+// the consecutive pairs are merged into a region
+func matchRegions(a, b Document, ignored map[uint64]bool) []Region {
+	firstInB := map[uint64]Fingerprint{}
+	for _, fp := range b.Fingerprints {
+		if _, ok := firstInB[fp.Hash]; !ok && !ignored[fp.Hash] {
+			firstInB[fp.Hash] = fp
+		}
+	}
+
+	regions := []Region{}
+	for _, fpA := range a.Fingerprints {
+		fpB, ok := firstInB[fpA.Hash]
+		if !ok {
+			continue
+		}
+
+		region := Region{
+			First:  LineRange{Start: fpA.StartLine, End: fpA.EndLine},
+			Second: LineRange{Start: fpB.StartLine, End: fpB.EndLine},
+		}
+		if n := len(regions); n > 0 && regions[n-1].mergeable(region) {
+			regions[n-1] = regions[n-1].merge(region)
+			continue
+		}
+		regions = append(regions, region)
+	}
+
+	return regions
+}
+
+func (r Region) mergeable(next Region) bool {
+	return r.First.adjacent(next.First) && r.Second.adjacent(next.Second)
+}
+
+func (r Region) merge(next Region) Region {
+	return Region{First: r.First.merge(next.First), Second: r.Second.merge(next.Second)}
+}
+
+func (r LineRange) adjacent(next LineRange) bool {
+	return next.Start <= r.End+1 && next.End >= r.Start-1
+}
+
+func (r LineRange) merge(next LineRange) LineRange {
+	return LineRange{Start: min(r.Start, next.Start), End: max(r.End, next.End)}
+}
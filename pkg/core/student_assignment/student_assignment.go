@@ -2,11 +2,14 @@ package student_assignment
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type Assigner struct {
@@ -20,16 +23,101 @@ type Student struct {
 	Active bool
 }
 
+// AttemptPolicy limits how often a student may resubmit
+type AttemptPolicy struct {
+	// MaxAttempts zero means unlimited
+	MaxAttempts int32
+	// Cooldown is the minimum interval between attempts
+	Cooldown    time.Duration
+	ScorePolicy dbmodel.ScorePolicy
+}
+
+// UnlimitedAttempts is the remaining attempts of an assignment without max attempts
+const UnlimitedAttempts int32 = -1
+
+// RemainingAttempts returns UnlimitedAttempts when there is no max attempts
+func (policy AttemptPolicy) RemainingAttempts(attemptCount int32) int32 {
+	if policy.MaxAttempts <= 0 {
+		return UnlimitedAttempts
+	}
+	return max(policy.MaxAttempts-attemptCount, 0)
+}
+
+// NextAttemptAt is when the cooldown of the last attempt ends,
+// it's zero when there is no cooldown
+func (policy AttemptPolicy) NextAttemptAt(lastAttemptAt time.Time) time.Time {
+	if policy.Cooldown <= 0 || lastAttemptAt.IsZero() {
+		return time.Time{}
+	}
+	return lastAttemptAt.Add(policy.Cooldown)
+}
+
+// CanAttempt checks the max attempts & the cooldown before a new attempt
+func (policy AttemptPolicy) CanAttempt(now time.Time, attemptCount int32, lastAttemptAt time.Time) error {
+	if policy.RemainingAttempts(attemptCount) == 0 {
+		return fmt.Errorf("no attempt left, the max is %d attempts", policy.MaxAttempts)
+	}
+
+	nextAttemptAt := policy.NextAttemptAt(lastAttemptAt)
+	if now.Before(nextAttemptAt) {
+		return fmt.Errorf("next attempt is allowed at %s", nextAttemptAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Release is when an assignment is visible to the students
+type Release struct {
+	Status dbmodel.AssignmentStatus
+	// PublishAt zero means the assignment is visible once it's published
+	PublishAt time.Time
+}
+
+func (release Release) IsPublished(now time.Time) bool {
+	if release.Status != dbmodel.AssignmentStatusPublished {
+		return false
+	}
+	return !release.PublishAt.After(now)
+}
+
 type StudentAssignment struct {
-	ID            uuid.UUID
-	Name          string
-	Description   string
-	Assigner      Assigner
-	DeadlineAt    time.Time
-	UpdatedAt     time.Time
-	Submission    StudentSubmissionForAssignment
-	CodeTemplate  string
-	HasSubmission bool
+	ID               uuid.UUID
+	Name             string
+	Description      string
+	Assigner         Assigner
+	DeadlineAt       time.Time
+	UpdatedAt        time.Time
+	Submission       StudentSubmissionForAssignment
+	CodeTemplate     string
+	HasSubmission    bool
+	AllowedLanguages []grading.Language
+	FeedbackLevel    dbmodel.FeedbackLevel
+	AttemptPolicy    AttemptPolicy
+	LatePolicy       grading.LatePolicy
+	// HasExtension is true when DeadlineAt is the student extended deadline
+	HasExtension bool
+	Release      Release
+	// IsEnrolled is true when the assignment has no course
+	// or the student is enrolled in its course
+	IsEnrolled    bool
+	TestFramework grading.TestFramework
+	BuildCommand  string
+}
+
+// WithExtendedDeadline resolves the deadline of a student with an extension
+func (assignment StudentAssignment) WithExtendedDeadline(deadlineAt time.Time) StudentAssignment {
+	assignment.LatePolicy = assignment.LatePolicy.Extend(assignment.DeadlineAt, deadlineAt)
+	assignment.DeadlineAt = deadlineAt
+	assignment.HasExtension = true
+	return assignment
+}
+
+func (assignment StudentAssignment) RemainingAttempts() int32 {
+	return assignment.AttemptPolicy.RemainingAttempts(assignment.Submission.AttemptCount)
+}
+
+func (assignment StudentAssignment) NextAttemptAt() time.Time {
+	return assignment.AttemptPolicy.NextAttemptAt(assignment.Submission.LastAttemptAt)
 }
 
 type StudentSubmissionForAssignment struct {
@@ -40,6 +128,37 @@ type StudentSubmissionForAssignment struct {
 	Feedback         string
 	SubmissionFileID uuid.UUID
 	UpdatedAt        time.Time
+	Language         grading.Language
+	CaseResults      []CaseResult
+	CompileOutput    string
+	MaxScore         int32
+	CurrentAttemptID uuid.UUID
+	// ScoredAttemptID is the attempt of the grade, see grading.ScoredAttempt
+	ScoredAttemptID uuid.UUID
+	AttemptCount    int32
+	LastAttemptAt   time.Time
+	// RawGrade is the grade before the late penalty
+	RawGrade int32
+	Lateness grading.Lateness
+}
+
+type CaseResult struct {
+	Number int32
+	// Name is the test name of a unit test assignment
+	Name         string
+	Verdict      string
+	ExitCode     int32
+	Stderr       string
+	WallTime     time.Duration
+	CPUTime      time.Duration
+	Weight       int32
+	Group        string
+	EarnedWeight int32
+	IsHidden     bool
+	// Input, Expected & Output are only stored for the sample cases
+	Input    string
+	Expected string
+	Output   string
 }
 
 type SubmissionFile struct {
@@ -50,10 +169,111 @@ type SubmissionFile struct {
 }
 
 type Assignment struct {
-	ID            uuid.UUID
-	DeadlineAt    time.Time
-	Template      string
-	HasAssignment bool
+	ID               uuid.UUID
+	DeadlineAt       time.Time
+	Template         string
+	HasAssignment    bool
+	AllowedLanguages []grading.Language
+	FeedbackLevel    dbmodel.FeedbackLevel
+	AttemptPolicy    AttemptPolicy
+	LatePolicy       grading.LatePolicy
+	Release          Release
+	// CourseID is uuid.Nil when the assignment has no course
+	CourseID uuid.UUID
+	// IsEnrolled is true when the assignment has no course
+	// or the student is enrolled in its course
+	IsEnrolled bool
+	// TestFramework only accepts a single file submission, see ValidateSourceFiles
+	TestFramework grading.TestFramework
+	// BuildCommand compiles a multi file submission, empty means the language compile command
+	BuildCommand string
+}
+
+// WithExtendedDeadline resolves the deadline of a student with an extension
+func (assignment Assignment) WithExtendedDeadline(deadlineAt time.Time) Assignment {
+	assignment.LatePolicy = assignment.LatePolicy.Extend(assignment.DeadlineAt, deadlineAt)
+	assignment.DeadlineAt = deadlineAt
+	return assignment
+}
+
+// IsOpen reports whether the assignment still accepts submissions, late or not
+func (assignment Assignment) IsOpen(now time.Time) bool {
+	return assignment.LatePolicy.IsOpen(assignment.DeadlineAt, now)
+}
+
+// SubmissionLanguage resolves the language of a submission,
+// the first allowed language is used when lang is empty.
+func (assignment Assignment) SubmissionLanguage(lang grading.Language) (grading.Language, error) {
+	if len(assignment.AllowedLanguages) == 0 {
+		return "", errors.New("assignment has no allowed language")
+	}
+
+	if lang == "" {
+		return assignment.AllowedLanguages[0], nil
+	}
+
+	if !lo.Contains(assignment.AllowedLanguages, lang) {
+		return "", fmt.Errorf("language %q is not allowed", lang)
+	}
+
+	return lang, nil
+}
+
+// ValidateSourceFiles checks a multi file submission.
+// Without a build command the language compile command compiles the entry file
+// at the project root, e.g. main.cpp, so it must be submitted.
+func (assignment Assignment) ValidateSourceFiles(lang grading.Language, files []grading.SourceFile) error {
+	if assignment.TestFramework != grading.TestFrameworkNone {
+		return errors.New("unit test assignment only accepts a single file submission")
+	}
+
+	if err := grading.ValidateSourceFiles(files); err != nil {
+		return err
+	}
+
+	spec, ok := grading.LookupLanguageSpec(lang)
+	if !ok {
+		return fmt.Errorf("invalid language %q", lang)
+	}
+
+	entry, ok := grading.FindSourceFile(files, spec.SourceFileName)
+	if !ok {
+		if assignment.BuildCommand == "" {
+			return fmt.Errorf("submission must have %s at the project root", spec.SourceFileName)
+		}
+		return nil
+	}
+
+	return lang.ValidateSource(string(entry.Content))
+}
+
+// Attempt is a submit or resubmit of the submission, it's never modified
+// other than by grading
+type Attempt struct {
+	ID             uuid.UUID
+	Number         int32
+	SubmissionFile SubmissionFile
+	Language       grading.Language
+	Grade          int32
+	// RawGrade is the grade before the late penalty
+	RawGrade      int32
+	Lateness      grading.Lateness
+	MaxScore      int32
+	IsGraded      bool
+	CompileOutput string
+	CaseResults   []CaseResult
+
+	core.TimestampMetadata
+}
+
+func newAttempt(id uuid.UUID, number int32, now time.Time, file SubmissionFile, lang grading.Language) Attempt {
+	return Attempt{
+		ID:                id,
+		Number:            number,
+		SubmissionFile:    file,
+		Language:          lang,
+		TimestampMetadata: core.NewTimestampMeta(now),
+	}
 }
 
 type StudentSubmission struct {
@@ -61,18 +281,34 @@ type StudentSubmission struct {
 	Student        Student
 	Assignment     Assignment
 	SubmissionFile SubmissionFile
+	Language       grading.Language
 	Grade          int32
-	Feedback       string
+	// RawGrade is the grade before the late penalty
+	RawGrade int32
+	Lateness grading.Lateness
+	IsGraded bool
+	Feedback string
+	// CurrentAttempt is the latest attempt, the file & language above are the same as its
+	CurrentAttempt Attempt
+	// ScoredAttemptID is the attempt of the grade, see grading.ScoredAttempt
+	ScoredAttemptID uuid.UUID
 
 	core.TimestampMetadata
 }
 
+// IsOwner reports whether the user is the student of the submission
+func (studentSub StudentSubmission) IsOwner(userID uuid.UUID) bool {
+	return studentSub.Student.ID == userID
+}
+
 type CreateStudentSubmissionRequest struct {
 	NewID          uuid.UUID
+	NewAttemptID   uuid.UUID
 	Now            time.Time
 	Student        Student
 	Assignment     Assignment
 	SubmissionFile SubmissionFile
+	Language       grading.Language
 }
 
 func SubmitStudentSubmission(req CreateStudentSubmissionRequest) (StudentSubmission, error) {
@@ -84,7 +320,15 @@ func SubmitStudentSubmission(req CreateStudentSubmissionRequest) (StudentSubmiss
 		return StudentSubmission{}, errors.New("submission already created")
 	}
 
-	if req.Now.After(req.Assignment.DeadlineAt) {
+	if !req.Assignment.IsEnrolled {
+		return StudentSubmission{}, errors.New("student is not enrolled in the course")
+	}
+
+	if !req.Assignment.Release.IsPublished(req.Now) {
+		return StudentSubmission{}, errors.New("assignment is not published yet")
+	}
+
+	if !req.Assignment.IsOpen(req.Now) {
 		return StudentSubmission{}, errors.New("assignment deadline has passed")
 	}
 
@@ -92,30 +336,94 @@ func SubmitStudentSubmission(req CreateStudentSubmissionRequest) (StudentSubmiss
 		return StudentSubmission{}, errors.New("invalid submission file")
 	}
 
+	lang, err := req.Assignment.SubmissionLanguage(req.Language)
+	if err != nil {
+		return StudentSubmission{}, err
+	}
+
 	return StudentSubmission{
 		ID:                req.NewID,
 		Student:           req.Student,
 		Assignment:        req.Assignment,
 		SubmissionFile:    req.SubmissionFile,
+		Language:          lang,
+		CurrentAttempt:    newAttempt(req.NewAttemptID, 1, req.Now, req.SubmissionFile, lang),
+		ScoredAttemptID:   req.NewAttemptID,
 		TimestampMetadata: core.NewTimestampMeta(req.Now),
 	}, nil
 }
 
 type UpdateStudentSubmissionRequest struct {
+	NewAttemptID      uuid.UUID
 	Now               time.Time
 	NewSubmissionFile SubmissionFile
+	// Language defaults to the previous submission language
+	Language grading.Language
 }
 
+// ResubmitLanguage resolves the language of the resubmission
+func (studentSub StudentSubmission) ResubmitLanguage(lang grading.Language) (grading.Language, error) {
+	if lang == "" {
+		lang = studentSub.Language
+	}
+
+	return studentSub.Assignment.SubmissionLanguage(lang)
+}
+
+// CanAttempt checks the assignment attempt policy against the current attempt
+func (studentSub StudentSubmission) CanAttempt(now time.Time) error {
+	return studentSub.Assignment.AttemptPolicy.CanAttempt(
+		now,
+		studentSub.CurrentAttempt.Number,
+		studentSub.CurrentAttempt.CreatedAt,
+	)
+}
+
+// Resubmit appends a new attempt, the previous attempts are kept.
+// With the last score policy the submission is ungraded until the new attempt is graded,
+// with the best score policy it keeps the best grade so far.
 func (studentSub StudentSubmission) Resubmit(req UpdateStudentSubmissionRequest) (StudentSubmission, error) {
-	if req.Now.After(studentSub.Assignment.DeadlineAt) {
+	if !studentSub.Assignment.IsEnrolled {
+		return StudentSubmission{}, errors.New("student is not enrolled in the course")
+	}
+
+	if !studentSub.Assignment.Release.IsPublished(req.Now) {
+		return StudentSubmission{}, errors.New("assignment is not published yet")
+	}
+
+	if !studentSub.Assignment.IsOpen(req.Now) {
 		return StudentSubmission{}, errors.New("assignment deadline has passed")
 	}
 
+	if err := studentSub.CanAttempt(req.Now); err != nil {
+		return StudentSubmission{}, err
+	}
+
 	if req.NewSubmissionFile.Type != dbmodel.FileTypeSubmission {
 		return StudentSubmission{}, errors.New("invalid submission file")
 	}
 
+	lang, err := studentSub.ResubmitLanguage(req.Language)
+	if err != nil {
+		return StudentSubmission{}, err
+	}
+
 	studentSub.SubmissionFile = req.NewSubmissionFile
+	studentSub.Language = lang
+	studentSub.CurrentAttempt = newAttempt(
+		req.NewAttemptID,
+		studentSub.CurrentAttempt.Number+1,
+		req.Now,
+		req.NewSubmissionFile,
+		lang,
+	)
+	if studentSub.Assignment.AttemptPolicy.ScorePolicy != dbmodel.ScorePolicyBest {
+		studentSub.ScoredAttemptID = req.NewAttemptID
+		studentSub.Grade = 0
+		studentSub.RawGrade = 0
+		studentSub.Lateness = grading.Lateness{}
+		studentSub.IsGraded = false
+	}
 	studentSub.UpdatedAt = req.Now
 	return studentSub, nil
 }
@@ -2,17 +2,13 @@ package student_assignment_cmd
 
 import (
 	"context"
-	"errors"
-	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/core/auth"
-	"github.com/fahmifan/autograd/pkg/core/mediastore"
-	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_cmd"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/core/student_assignment"
-	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/fahmifan/autograd/pkg/jobqueue"
 	"github.com/fahmifan/autograd/pkg/jobqueue/outbox"
 	"github.com/fahmifan/autograd/pkg/logs"
@@ -42,18 +38,18 @@ func (cmd *StudentAssignmentCmd) SubmitStudentSubmission(ctx context.Context, re
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if strings.TrimSpace(req.Msg.GetSubmissionCode()) == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("submission code is empty"))
+	source, err := sourceFromProto(req.Msg.GetSubmissionCode(), req.Msg.GetSourceFiles(), req.Msg.GetSourceArchive())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	submissionFileReader := student_assignment.SubmissionFileReader{}
 	assignmentReader := student_assignment.StudentAssignmentReader{}
 	submissionWriter := student_assignment.StudentSubmissionWriter{}
-	mediastoreCmd := &mediastore_cmd.MediaStoreCmd{Ctx: cmd.Ctx}
 
 	studentID := authUser.UserID
 	now := time.Now()
 	newID := uuid.New()
+	newAttemptID := uuid.New()
 
 	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
 		assignmet, err := assignmentReader.FindByID(ctx, tx, student_assignment.FindStudentAssignmentByIDRequest{
@@ -66,36 +62,42 @@ func (cmd *StudentAssignmentCmd) SubmitStudentSubmission(ctx context.Context, re
 			return core.ErrInternalServer
 		}
 
-		mediaRes, err := mediastoreCmd.InternalSave(ctx, tx, mediastore_cmd.InternalSaveRequest{
-			Ext:       ".cpp",
-			Body:      strings.NewReader(req.Msg.GetSubmissionCode()),
-			MediaType: mediastore.MediaFileType(dbmodel.FileTypeSubmission),
-		})
+		assignment := student_assignment.Assignment{
+			ID:               assignmet.ID,
+			DeadlineAt:       assignmet.DeadlineAt,
+			HasAssignment:    assignmet.HasSubmission,
+			AllowedLanguages: assignmet.AllowedLanguages,
+			FeedbackLevel:    assignmet.FeedbackLevel,
+			AttemptPolicy:    assignmet.AttemptPolicy,
+			LatePolicy:       assignmet.LatePolicy,
+			Release:          assignmet.Release,
+			IsEnrolled:       assignmet.IsEnrolled,
+			TestFramework:    assignmet.TestFramework,
+			BuildCommand:     assignmet.BuildCommand,
+		}
+
+		lang, err := assignment.SubmissionLanguage(grading.Language(req.Msg.GetLanguage()))
 		if err != nil {
-			logs.ErrCtx(ctx, err, "StudentAssignmentCmd: SubmitStudentSubmission: save submission code")
-			return core.ErrInternalServer
+			return connect.NewError(connect.CodeInvalidArgument, err)
 		}
 
-		submissionFile, err := submissionFileReader.FindByID(ctx, tx, mediaRes.ID)
+		submissionFile, err := cmd.saveSubmissionSource(ctx, tx, assignment, lang, source)
 		if err != nil {
-			logs.ErrCtx(ctx, err, "StudentAssignmentCmd: SubmitStudentSubmission: find submission file by id")
-			return core.ErrInternalServer
+			return err
 		}
 
 		submission, err := student_assignment.SubmitStudentSubmission(student_assignment.CreateStudentSubmissionRequest{
-			NewID: newID,
-			Now:   now,
+			NewID:        newID,
+			NewAttemptID: newAttemptID,
+			Now:          now,
 			Student: student_assignment.Student{
 				ID:     studentID,
 				Name:   authUser.Name,
 				Active: true,
 			},
-			Assignment: student_assignment.Assignment{
-				ID:            assignmet.ID,
-				DeadlineAt:    assignmet.DeadlineAt,
-				HasAssignment: assignmet.HasSubmission,
-			},
+			Assignment:     assignment,
 			SubmissionFile: submissionFile,
+			Language:       lang,
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -109,9 +111,10 @@ func (cmd *StudentAssignmentCmd) SubmitStudentSubmission(ctx context.Context, re
 
 		_, err = cmd.OutboxEnqueuer.Enqueue(ctx, tx, outbox.EnqueueRequest{
 			JobType:       JobGradeSubmission,
-			IdempotentKey: jobqueue.IdempotentKey(newID.String()),
+			IdempotentKey: jobqueue.IdempotentKey(newAttemptID.String()),
 			Payload: GradeStudentSubmissionPayload{
 				SubmissionID: newID,
+				AttemptID:    newAttemptID,
 			},
 		})
 		if err != nil {
@@ -150,16 +153,16 @@ func (cmd *StudentAssignmentCmd) ResubmitStudentSubmission(ctx context.Context,
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if strings.TrimSpace(req.Msg.GetSubmissionCode()) == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("submission code is empty"))
+	source, err := sourceFromProto(req.Msg.GetSubmissionCode(), req.Msg.GetSourceFiles(), req.Msg.GetSourceArchive())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	submissionFileReader := student_assignment.SubmissionFileReader{}
 	submissionReader := student_assignment.StudentSubmissionReader{}
 	submissionWriter := student_assignment.StudentSubmissionWriter{}
-	mediastoreCmd := &mediastore_cmd.MediaStoreCmd{Ctx: cmd.Ctx}
 
 	now := time.Now()
+	newAttemptID := uuid.New()
 
 	err = core.Transaction(ctx, cmd.Ctx, func(tx *gorm.DB) error {
 		submission, err := submissionReader.FindByID(ctx, tx, submissionID)
@@ -168,25 +171,26 @@ func (cmd *StudentAssignmentCmd) ResubmitStudentSubmission(ctx context.Context,
 			return core.ErrInternalServer
 		}
 
-		mediaRes, err := mediastoreCmd.InternalSave(ctx, tx, mediastore_cmd.InternalSaveRequest{
-			Ext:       ".cpp",
-			Body:      strings.NewReader(req.Msg.GetSubmissionCode()),
-			MediaType: mediastore.MediaFileType(dbmodel.FileTypeSubmission),
-		})
+		// check before saving the submission code
+		if err = submission.CanAttempt(now); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		lang, err := submission.ResubmitLanguage(grading.Language(req.Msg.GetLanguage()))
 		if err != nil {
-			logs.ErrCtx(ctx, err, "StudentAssignmentCmd: ResubmitStudentSubmission: save submission code")
-			return core.ErrInternalServer
+			return connect.NewError(connect.CodeInvalidArgument, err)
 		}
 
-		submissionFile, err := submissionFileReader.FindByID(ctx, tx, mediaRes.ID)
+		submissionFile, err := cmd.saveSubmissionSource(ctx, tx, submission.Assignment, lang, source)
 		if err != nil {
-			logs.ErrCtx(ctx, err, "StudentAssignmentCmd: ResubmitStudentSubmission: find submission file by id")
-			return core.ErrInternalServer
+			return err
 		}
 
 		submission, err = submission.Resubmit(student_assignment.UpdateStudentSubmissionRequest{
+			NewAttemptID:      newAttemptID,
 			Now:               now,
 			NewSubmissionFile: submissionFile,
+			Language:          lang,
 		})
 		if err != nil {
 			return connect.NewError(connect.CodeInvalidArgument, err)
@@ -200,9 +204,10 @@ func (cmd *StudentAssignmentCmd) ResubmitStudentSubmission(ctx context.Context,
 
 		_, err = cmd.OutboxEnqueuer.Enqueue(ctx, tx, outbox.EnqueueRequest{
 			JobType:       JobGradeSubmission,
-			IdempotentKey: jobqueue.IdempotentKey(submissionID.String()),
+			IdempotentKey: jobqueue.IdempotentKey(newAttemptID.String()),
 			Payload: GradeStudentSubmissionPayload{
 				SubmissionID: submissionID,
+				AttemptID:    newAttemptID,
 			},
 		})
 		if err != nil {
@@ -19,6 +19,11 @@ type GradeStudentSubmissionHandler struct {
 
 type GradeStudentSubmissionPayload struct {
 	SubmissionID uuid.UUID
+	// AttemptID is empty for the jobs enqueued before attempts existed,
+	// the current attempt is graded then
+	AttemptID uuid.UUID
+	// TestVersion is set on a regrade, see grading_cmd.InternalGradeSubmissionRequest
+	TestVersion int32
 }
 
 func (handler *GradeStudentSubmissionHandler) JobType() jobqueue.JobType {
@@ -34,12 +39,18 @@ func (handler *GradeStudentSubmissionHandler) Handle(ctx context.Context, tx *go
 
 	gradingCmd := &grading_cmd.GradingCmd{Ctx: handler.Ctx}
 
-	_, err = gradingCmd.InternalGradeSubmissionTx(ctx, tx, grading_cmd.InternalGradeSubmissionRequest{
+	res, err := gradingCmd.InternalGradeSubmissionTx(ctx, tx, grading_cmd.InternalGradeSubmissionRequest{
 		SubmissionID: req.SubmissionID,
+		AttemptID:    req.AttemptID,
+		TestVersion:  req.TestVersion,
 	})
 	if err != nil {
 		return logs.ErrWrapCtx(ctx, err, "GradeStudentSubmissionHandler: Handle: InternalGradeSubmissionTx")
 	}
 
+	if res.IsStale {
+		logs.InfoCtx(ctx, "GradeStudentSubmissionHandler: Handle", "regrade is stale", req.SubmissionID.String())
+	}
+
 	return nil
 }
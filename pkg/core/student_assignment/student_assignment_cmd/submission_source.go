@@ -0,0 +1,114 @@
+package student_assignment_cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/core/mediastore"
+	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_cmd"
+	"github.com/fahmifan/autograd/pkg/core/student_assignment"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"gorm.io/gorm"
+)
+
+// submissionSource is the source of a submit, the code of a single file submission
+// or the files of a multi file submission
+type submissionSource struct {
+	Code  string
+	Files []grading.SourceFile
+}
+
+func (source submissionSource) isMultiFile() bool {
+	return len(source.Files) > 0
+}
+
+// sourceFromProto reads the submitted source, a submission is either a single file code,
+// the source files or a zip archive of the source files
+func sourceFromProto(code string, files []*autogradv1.SourceFile, archive []byte) (submissionSource, error) {
+	hasCode := strings.TrimSpace(code) != ""
+	switch {
+	case !hasCode && len(files) == 0 && len(archive) == 0:
+		return submissionSource{}, errors.New("submission code is empty")
+	case hasCode && (len(files) > 0 || len(archive) > 0), len(files) > 0 && len(archive) > 0:
+		return submissionSource{}, errors.New("submit either the code, the source files or the source archive")
+	case hasCode:
+		return submissionSource{Code: code}, nil
+	case len(archive) > 0:
+		sourceFiles, err := grading.ReadSourceArchive(archive)
+		if err != nil {
+			return submissionSource{}, err
+		}
+		return submissionSource{Files: sourceFiles}, nil
+	}
+
+	sourceFiles := make([]grading.SourceFile, len(files))
+	for i, file := range files {
+		sourceFiles[i] = grading.SourceFile{
+			Path:    file.GetPath(),
+			Content: []byte(file.GetContent()),
+		}
+	}
+
+	return submissionSource{Files: sourceFiles}, nil
+}
+
+// saveSubmissionSource validates the source for the assignment and stores it,
+// the files of a multi file submission are stored as a zip archive
+func (cmd *StudentAssignmentCmd) saveSubmissionSource(
+	ctx context.Context,
+	tx *gorm.DB,
+	assignment student_assignment.Assignment,
+	lang grading.Language,
+	source submissionSource,
+) (student_assignment.SubmissionFile, error) {
+	ext := mediastore.Extension(lang.Ext())
+	var body io.Reader
+
+	if source.isMultiFile() {
+		if err := assignment.ValidateSourceFiles(lang, source.Files); err != nil {
+			return student_assignment.SubmissionFile{}, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		archive, err := grading.WriteSourceArchive(source.Files)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "StudentAssignmentCmd: saveSubmissionSource: write source archive")
+			return student_assignment.SubmissionFile{}, core.ErrInternalServer
+		}
+
+		ext = mediastore.Extension(grading.SourceArchiveExt)
+		body = bytes.NewReader(archive)
+	} else {
+		if err := lang.ValidateSource(source.Code); err != nil {
+			return student_assignment.SubmissionFile{}, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		body = strings.NewReader(source.Code)
+	}
+
+	mediastoreCmd := &mediastore_cmd.MediaStoreCmd{Ctx: cmd.Ctx}
+	mediaRes, err := mediastoreCmd.InternalSave(ctx, tx, mediastore_cmd.InternalSaveRequest{
+		Ext:       ext,
+		Body:      body,
+		MediaType: mediastore.MediaFileType(dbmodel.FileTypeSubmission),
+	})
+	if err != nil {
+		logs.ErrCtx(ctx, err, "StudentAssignmentCmd: saveSubmissionSource: save submission code")
+		return student_assignment.SubmissionFile{}, core.ErrInternalServer
+	}
+
+	submissionFile, err := student_assignment.SubmissionFileReader{}.FindByID(ctx, tx, mediaRes.ID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "StudentAssignmentCmd: saveSubmissionSource: find submission file by id")
+		return student_assignment.SubmissionFile{}, core.ErrInternalServer
+	}
+
+	return submissionFile, nil
+}
@@ -0,0 +1,204 @@
+package student_assignment_query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/grading"
+	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_query"
+	"github.com/fahmifan/autograd/pkg/core/student_assignment"
+	"github.com/fahmifan/autograd/pkg/logs"
+	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
+	"github.com/fahmifan/autograd/pkg/textdiff"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+func (query *StudentAssignmentQuery) FindAllSubmissionAttempts(ctx context.Context, req *connect.Request[autogradv1.FindAllSubmissionAttemptsRequest]) (
+	*connect.Response[autogradv1.FindAllSubmissionAttemptsResponse], error,
+) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAssignment) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	submissionID, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid submission id"))
+	}
+
+	submission, attempts, isTeacher, err := query.findAttempts(ctx, authUser, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// teachers see every case result details
+	if !isTeacher {
+		for i := range attempts {
+			attempts[i].CaseResults = visibleCaseResults(submission.Assignment.FeedbackLevel, attempts[i].CaseResults)
+		}
+	}
+
+	return &connect.Response[autogradv1.FindAllSubmissionAttemptsResponse]{
+		Msg: &autogradv1.FindAllSubmissionAttemptsResponse{
+			Attempts: lo.Map(attempts, func(attempt student_assignment.Attempt, _ int) *autogradv1.SubmissionAttempt {
+				return toAttemptProto(attempt, submission.CurrentAttempt.ID)
+			}),
+		},
+	}, nil
+}
+
+func (query *StudentAssignmentQuery) DiffSubmissionAttempts(ctx context.Context, req *connect.Request[autogradv1.DiffSubmissionAttemptsRequest]) (
+	*connect.Response[autogradv1.DiffSubmissionAttemptsResponse], error,
+) {
+	authUser, ok := auth.GetUserFromCtx(ctx)
+	if !ok {
+		return nil, core.ErrUnauthenticated
+	}
+
+	if !authUser.Role.CanInAnyCourse(auth.ViewAssignment) {
+		return nil, core.ErrPermissionDenied
+	}
+
+	submissionID, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid submission id"))
+	}
+
+	fromAttemptID, err := uuid.Parse(req.Msg.GetFromAttemptId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid from attempt id"))
+	}
+
+	toAttemptID, err := uuid.Parse(req.Msg.GetToAttemptId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid to attempt id"))
+	}
+
+	_, attempts, _, err := query.findAttempts(ctx, authUser, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAttempt, okFrom := lo.Find(attempts, func(attempt student_assignment.Attempt) bool {
+		return attempt.ID == fromAttemptID
+	})
+	toAttempt, okTo := lo.Find(attempts, func(attempt student_assignment.Attempt) bool {
+		return attempt.ID == toAttemptID
+	})
+	if !okFrom || !okTo {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("attempt not found"))
+	}
+
+	fromCode, err := query.readSubmissionCode(ctx, fromAttempt.SubmissionFile.ID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "StudentAssignmentQuery: DiffSubmissionAttempts: read from attempt code")
+		return nil, core.ErrInternalServer
+	}
+
+	toCode, err := query.readSubmissionCode(ctx, toAttempt.SubmissionFile.ID)
+	if err != nil {
+		logs.ErrCtx(ctx, err, "StudentAssignmentQuery: DiffSubmissionAttempts: read to attempt code")
+		return nil, core.ErrInternalServer
+	}
+
+	return &connect.Response[autogradv1.DiffSubmissionAttemptsResponse]{
+		Msg: &autogradv1.DiffSubmissionAttemptsResponse{
+			Diff: textdiff.Unified(
+				fmt.Sprintf("attempt %d", fromAttempt.Number),
+				fmt.Sprintf("attempt %d", toAttempt.Number),
+				fromCode,
+				toCode,
+			),
+		},
+	}, nil
+}
+
+// findAttempts finds the submission attempts the user may see,
+// a student only sees the attempts of its own submission.
+// isTeacher is true when the user may view any submission of the assignment.
+func (query *StudentAssignmentQuery) findAttempts(ctx context.Context, authUser auth.AuthUser, submissionID uuid.UUID) (
+	submission student_assignment.StudentSubmission, attempts []student_assignment.Attempt, isTeacher bool, err error,
+) {
+	err = core.Transaction(ctx, query.Ctx, func(tx *gorm.DB) (err error) {
+		submission, err = student_assignment.StudentSubmissionReader{}.FindByID(ctx, tx, submissionID)
+		if err != nil {
+			if core.IsDBNotFoundErr(err) {
+				return connect.NewError(connect.CodeNotFound, errors.New("submission not found"))
+			}
+			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: findAttempts: find submission")
+			return core.ErrInternalServer
+		}
+
+		isTeacher, err = auth.CourseRoleReader{}.Authorize(ctx, tx, authUser, submission.Assignment.CourseID, auth.ViewAnySubmissions)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: findAttempts: authorize")
+			return core.ErrInternalServer
+		}
+
+		if !submission.IsOwner(authUser.UserID) && !isTeacher {
+			return core.ErrPermissionDenied
+		}
+
+		attempts, err = student_assignment.SubmissionAttemptReader{}.FindAllBySubmissionID(ctx, tx, submissionID)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: findAttempts: find attempts")
+			return core.ErrInternalServer
+		}
+
+		return nil
+	})
+
+	return submission, attempts, isTeacher, err
+}
+
+func (query *StudentAssignmentQuery) readSubmissionCode(ctx context.Context, fileID uuid.UUID) (string, error) {
+	mediaStoreQuery := mediastore_query.MediaStoreQuery{Ctx: query.Ctx}
+	media, err := mediaStoreQuery.InternalFindMediaFile(ctx, mediastore_query.InternalFindMediaFileRequest{
+		ID: fileID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("find media file: %w", err)
+	}
+	defer media.BodyCloser.Close()
+
+	buf, err := io.ReadAll(media.BodyCloser)
+	if err != nil {
+		return "", fmt.Errorf("read media file: %w", err)
+	}
+
+	source, err := grading.ReadSubmissionSource(media.FileName, buf)
+	if err != nil {
+		return "", fmt.Errorf("read submission source: %w", err)
+	}
+
+	return source.Code, nil
+}
+
+func toAttemptProto(attempt student_assignment.Attempt, currentAttemptID uuid.UUID) *autogradv1.SubmissionAttempt {
+	return &autogradv1.SubmissionAttempt{
+		Id:            attempt.ID.String(),
+		AttemptNumber: attempt.Number,
+		Language:      string(attempt.Language),
+		Grade:         attempt.Grade,
+		MaxScore:      attempt.MaxScore,
+		IsGraded:      attempt.IsGraded,
+		CompileOutput: attempt.CompileOutput,
+		CaseResults:   toCaseResultProtos(attempt.CaseResults),
+		CreatedAt:     attempt.CreatedAt.Format(time.RFC3339),
+		IsCurrent:     attempt.ID == currentAttemptID,
+		RawGrade:      attempt.RawGrade,
+		Lateness:      toLatenessProto(attempt.Lateness),
+	}
+}
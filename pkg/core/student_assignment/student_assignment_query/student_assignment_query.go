@@ -9,11 +9,14 @@ import (
 	"connectrpc.com/connect"
 	"github.com/fahmifan/autograd/pkg/core"
 	"github.com/fahmifan/autograd/pkg/core/auth"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/core/mediastore/mediastore_query"
 	"github.com/fahmifan/autograd/pkg/core/student_assignment"
+	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/fahmifan/autograd/pkg/logs"
 	autogradv1 "github.com/fahmifan/autograd/pkg/pb/autograd/v1"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type StudentAssignmentQuery struct {
@@ -55,6 +58,7 @@ func (query *StudentAssignmentQuery) FindAllStudentAssignments(ctx context.Conte
 	reader := student_assignment.StudentAssignmentReader{}
 	res, err := reader.FindAllAssignments(ctx, query.GormDB, student_assignment.FindAllAssignmentRequest{
 		PaginationRequest: core.PaginationRequestFromProto(req.Msg.GetPaginationRequest()),
+		Now:               time.Now(),
 		From:              from,
 		To:                to,
 		StudentID:         authUser.UserID,
@@ -95,12 +99,22 @@ func (query *StudentAssignmentQuery) FindStudentAssignment(ctx context.Context,
 		StudentID:     authUser.UserID,
 		WithStudentID: true,
 	})
+	if core.IsDBNotFoundErr(err) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("assignment not found"))
+	}
 	if err != nil {
 		logs.ErrCtx(ctx, err, "StudentAssignmentQuery: FindStudentAssignment: FindByID")
 		return nil, core.ErrInternalServer
 	}
 
-	var submissionBuf []byte
+	// an unpublished assignment & the assignment of other courses are hidden from the students
+	if !res.IsEnrolled || !res.Release.IsPublished(time.Now()) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("assignment not found"))
+	}
+
+	res.Submission.CaseResults = visibleCaseResults(res.FeedbackLevel, res.Submission.CaseResults)
+
+	var submissionSource grading.SubmissionSource
 	if res.HasSubmission {
 		mediaStoreQuery := mediastore_query.MediaStoreQuery{Ctx: query.Ctx}
 		submissionMedia, err := mediaStoreQuery.InternalFindMediaFile(ctx, mediastore_query.InternalFindMediaFileRequest{
@@ -112,27 +126,64 @@ func (query *StudentAssignmentQuery) FindStudentAssignment(ctx context.Context,
 		}
 		defer submissionMedia.BodyCloser.Close()
 
-		submissionBuf, err = io.ReadAll(submissionMedia.BodyCloser)
+		submissionBuf, err := io.ReadAll(submissionMedia.BodyCloser)
 		if err != nil {
 			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: FindStudentAssignment: io.ReadAll")
 			return nil, core.ErrInternalServer
 		}
+
+		submissionSource, err = grading.ReadSubmissionSource(submissionMedia.FileName, submissionBuf)
+		if err != nil {
+			logs.ErrCtx(ctx, err, "StudentAssignmentQuery: FindStudentAssignment: ReadSubmissionSource")
+			return nil, core.ErrInternalServer
+		}
 	}
 
 	return &connect.Response[autogradv1.StudentAssignment]{
-		Msg: toStudentAssignmentProto(res, submissionBuf),
+		Msg: toStudentAssignmentProto(res, submissionSource),
 	}, nil
 }
 
+// visibleCaseResults hides the case results details the student may not see.
+// Only the sample cases of a full diff assignment show the input, expected & actual output,
+// a hidden case never shows more than its verdict.
+func visibleCaseResults(level dbmodel.FeedbackLevel, caseResults []student_assignment.CaseResult) []student_assignment.CaseResult {
+	if level == dbmodel.FeedbackLevelScoreOnly {
+		return nil
+	}
+
+	visibles := make([]student_assignment.CaseResult, len(caseResults))
+	for i, caseResult := range caseResults {
+		if level == dbmodel.FeedbackLevelFullDiff && !caseResult.IsHidden {
+			visibles[i] = caseResult
+			continue
+		}
+
+		// the stderr & exit code may reveal the input too
+		visibles[i] = student_assignment.CaseResult{
+			Number:       caseResult.Number,
+			Name:         caseResult.Name,
+			Verdict:      caseResult.Verdict,
+			WallTime:     caseResult.WallTime,
+			CPUTime:      caseResult.CPUTime,
+			Weight:       caseResult.Weight,
+			Group:        caseResult.Group,
+			EarnedWeight: caseResult.EarnedWeight,
+			IsHidden:     caseResult.IsHidden,
+		}
+	}
+	return visibles
+}
+
 func toStudentAssignmentProtos(assignments []student_assignment.StudentAssignment) []*autogradv1.StudentAssignment {
 	assignmentProtos := make([]*autogradv1.StudentAssignment, len(assignments))
 	for i := range assignments {
-		assignmentProtos[i] = toStudentAssignmentProto(assignments[i], nil)
+		assignmentProtos[i] = toStudentAssignmentProto(assignments[i], grading.SubmissionSource{})
 	}
 	return assignmentProtos
 }
 
-func toStudentAssignmentProto(assignment student_assignment.StudentAssignment, submissionCode []byte) *autogradv1.StudentAssignment {
+func toStudentAssignmentProto(assignment student_assignment.StudentAssignment, submissionSource grading.SubmissionSource) *autogradv1.StudentAssignment {
 	return &autogradv1.StudentAssignment{
 		Id:           assignment.ID.String(),
 		Name:         assignment.Name,
@@ -143,12 +194,87 @@ func toStudentAssignmentProto(assignment student_assignment.StudentAssignment, s
 		UpdatedAt:    assignment.UpdatedAt.Format(time.RFC3339),
 		DeadlineAt:   assignment.DeadlineAt.Format(time.RFC3339),
 		Submission: &autogradv1.StudentAssignment_Submission{
-			Id:             assignment.Submission.ID.String(),
-			SubmissionCode: string(submissionCode),
-			Grade:          int32(assignment.Submission.Grade),
-			UpdatedAt:      assignment.Submission.UpdatedAt.Format(time.RFC3339),
-			IsGraded:       assignment.Submission.IsGraded,
+			Id:               assignment.Submission.ID.String(),
+			SubmissionCode:   submissionSource.Code,
+			SourceFiles:      toSourceFileProtos(submissionSource.Files),
+			Grade:            int32(assignment.Submission.Grade),
+			UpdatedAt:        assignment.Submission.UpdatedAt.Format(time.RFC3339),
+			IsGraded:         assignment.Submission.IsGraded,
+			CaseResults:      toCaseResultProtos(assignment.Submission.CaseResults),
+			Language:         string(assignment.Submission.Language),
+			CompileOutput:    assignment.Submission.CompileOutput,
+			MaxScore:         assignment.Submission.MaxScore,
+			CurrentAttemptId: assignment.Submission.CurrentAttemptID.String(),
+			ScoredAttemptId:  assignment.Submission.ScoredAttemptID.String(),
+			AttemptCount:     assignment.Submission.AttemptCount,
+			RawGrade:         assignment.Submission.RawGrade,
+			Lateness:         toLatenessProto(assignment.Submission.Lateness),
 		},
 		HasSubmission: assignment.HasSubmission,
+		AllowedLanguages: lo.Map(assignment.AllowedLanguages, func(lang grading.Language, _ int) string {
+			return string(lang)
+		}),
+		FeedbackLevel: string(assignment.FeedbackLevel),
+		AttemptPolicy: &autogradv1.AssignmentAttemptPolicy{
+			MaxAttempts: assignment.AttemptPolicy.MaxAttempts,
+			CooldownSec: int32(assignment.AttemptPolicy.Cooldown / time.Second),
+			ScorePolicy: string(assignment.AttemptPolicy.ScorePolicy),
+		},
+		RemainingAttempts: assignment.RemainingAttempts(),
+		NextAttemptAt:     formatTimeOrEmpty(assignment.NextAttemptAt()),
+		HasExtension:      assignment.HasExtension,
+		LatePolicy: &autogradv1.AssignmentLatePolicy{
+			HardDeadlineAt:       formatTimeOrEmpty(assignment.LatePolicy.HardDeadlineAt),
+			GracePeriodSec:       int32(assignment.LatePolicy.GracePeriod / time.Second),
+			PenaltyPercentPerDay: assignment.LatePolicy.PenaltyPercentPerDay,
+		},
+		BuildCommand: assignment.BuildCommand,
+	}
+}
+
+func toSourceFileProtos(files []grading.SourceFile) []*autogradv1.SourceFile {
+	return lo.Map(files, func(file grading.SourceFile, _ int) *autogradv1.SourceFile {
+		return &autogradv1.SourceFile{
+			Path:    file.Path,
+			Content: string(file.Content),
+		}
+	})
+}
+
+func toLatenessProto(lateness grading.Lateness) *autogradv1.SubmissionLateness {
+	return &autogradv1.SubmissionLateness{
+		IsLate:         lateness.IsLate,
+		Days:           lateness.Days,
+		PenaltyPercent: lateness.PenaltyPercent,
+	}
+}
+
+func formatTimeOrEmpty(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toCaseResultProtos(caseResults []student_assignment.CaseResult) []*autogradv1.SubmissionCaseResult {
+	caseResultProtos := make([]*autogradv1.SubmissionCaseResult, len(caseResults))
+	for i, caseResult := range caseResults {
+		caseResultProtos[i] = &autogradv1.SubmissionCaseResult{
+			CaseNumber:     caseResult.Number,
+			Name:           caseResult.Name,
+			Verdict:        caseResult.Verdict,
+			ExitCode:       caseResult.ExitCode,
+			Stderr:         caseResult.Stderr,
+			WallTimeMs:     caseResult.WallTime.Milliseconds(),
+			CpuTimeMs:      caseResult.CPUTime.Milliseconds(),
+			Weight:         caseResult.Weight,
+			Group:          caseResult.Group,
+			EarnedWeight:   caseResult.EarnedWeight,
+			IsHidden:       caseResult.IsHidden,
+			Input:          caseResult.Input,
+			ExpectedOutput: caseResult.Expected,
+			Output:         caseResult.Output,
+		}
 	}
+	return caseResultProtos
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/fahmifan/autograd/pkg/core"
+	"github.com/fahmifan/autograd/pkg/core/grading"
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v4"
@@ -24,18 +25,58 @@ func (AssignmentReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID)
 	}
 
 	return Assignment{
-		ID:         assignmentModel.ID,
-		DeadlineAt: assignmentModel.DeadlineAt,
+		ID:               assignmentModel.ID,
+		DeadlineAt:       assignmentModel.DeadlineAt,
+		AllowedLanguages: grading.SplitLanguages(assignmentModel.AllowedLanguages),
+		FeedbackLevel:    assignmentModel.FeedbackLevel,
+		AttemptPolicy:    toAttemptPolicy(assignmentModel),
+		LatePolicy:       toLatePolicy(assignmentModel),
+		Release:          toRelease(assignmentModel),
+		TestFramework:    grading.TestFramework(assignmentModel.TestFramework),
+		BuildCommand:     assignmentModel.BuildCommand,
 	}, nil
 }
 
+func toRelease(assignmentModel dbmodel.Assignment) Release {
+	return Release{
+		Status:    assignmentModel.Status,
+		PublishAt: assignmentModel.PublishAt.Time,
+	}
+}
+
+func toLatePolicy(assignmentModel dbmodel.Assignment) grading.LatePolicy {
+	return grading.LatePolicy{
+		HardDeadlineAt:       assignmentModel.HardDeadlineAt.Time,
+		GracePeriod:          time.Duration(assignmentModel.LateGracePeriodSec) * time.Second,
+		PenaltyPercentPerDay: assignmentModel.LatePenaltyPercentPerDay,
+	}
+}
+
+func toLateness(isLate int, days, penaltyPercent int32) grading.Lateness {
+	return grading.Lateness{
+		IsLate:         isLate == 1,
+		Days:           days,
+		PenaltyPercent: penaltyPercent,
+	}
+}
+
+func toAttemptPolicy(assignmentModel dbmodel.Assignment) AttemptPolicy {
+	return AttemptPolicy{
+		MaxAttempts: assignmentModel.MaxAttempts,
+		Cooldown:    time.Duration(assignmentModel.AttemptCooldownSec) * time.Second,
+		ScorePolicy: assignmentModel.ScorePolicy,
+	}
+}
+
 type StudentAssignmentReader struct{}
 
 type FindAllAssignmentRequest struct {
 	core.PaginationRequest
+	Now       time.Time
 	StudentID uuid.UUID
-	From      time.Time
-	To        time.Time
+	// From & To filter the assignments by the student deadline, see GetFrom & GetTo
+	From time.Time
+	To   time.Time
 }
 
 func (req FindAllAssignmentRequest) GetFrom(now time.Time) time.Time {
@@ -67,12 +108,40 @@ func (StudentAssignmentReader) FindAllAssignments(ctx context.Context, tx *gorm.
 	assignmentModels := []dbmodel.Assignment{}
 	count := int64(0)
 
-	err := tx.Model(&dbmodel.Assignment{}).Count(&count).Error
+	from, to := req.GetFrom(req.Now), req.GetTo(req.Now)
+	// the deadline of the student is the extended deadline when there is one
+	studentDeadlineScope := func(db *gorm.DB) *gorm.DB {
+		return db.Joins(
+			"LEFT JOIN assignment_extensions ON assignment_extensions.assignment_id = assignments.id"+
+				" AND assignment_extensions.student_id = ? AND assignment_extensions.deleted_at IS NULL",
+			req.StudentID,
+		).Where("COALESCE(assignment_extensions.deadline_at, assignments.deadline_at) BETWEEN ? AND ?", from, to)
+	}
+	// the students only see the released assignments
+	publishedScope := func(db *gorm.DB) *gorm.DB {
+		return db.Where("assignments.status = ? AND (assignments.publish_at IS NULL OR assignments.publish_at <= ?)",
+			dbmodel.AssignmentStatusPublished, req.Now,
+		)
+	}
+	// of the courses the student is enrolled in, or without a course
+	enrolledScope := func(db *gorm.DB) *gorm.DB {
+		return db.Where("assignments.course_id IS NULL OR assignments.course_id IN (?)",
+			tx.Model(&dbmodel.CourseEnrollment{}).
+				Select("course_id").
+				Where("user_id = ? AND role = ?", req.StudentID, dbmodel.EnrollmentRoleStudent),
+		)
+	}
+
+	err := tx.Model(&dbmodel.Assignment{}).Scopes(studentDeadlineScope, publishedScope, enrolledScope).Count(&count).Error
 	if err != nil {
 		return FindAllAssignmentResponse{}, fmt.Errorf("count assignments: %w", err)
 	}
 
-	err = tx.Scopes(req.PaginateScope).Order("updated_at desc").Find(&assignmentModels).Error
+	err = tx.Model(&dbmodel.Assignment{}).
+		Select("assignments.*").
+		Scopes(studentDeadlineScope, publishedScope, enrolledScope, req.PaginateScope).
+		Order("assignments.updated_at desc").
+		Find(&assignmentModels).Error
 	if err != nil {
 		return FindAllAssignmentResponse{}, fmt.Errorf("find assignments: %w", err)
 	}
@@ -104,8 +173,38 @@ func (StudentAssignmentReader) FindAllAssignments(ctx context.Context, tx *gorm.
 		return FindAllAssignmentResponse{}, fmt.Errorf("find submissions: %w", err)
 	}
 
+	currentAttemptIDs := make([]uuid.UUID, len(submissionModels))
+	for i := range submissionModels {
+		currentAttemptIDs[i] = submissionModels[i].CurrentAttemptID
+	}
+
+	currentAttempts := []dbmodel.SubmissionAttempt{}
+	err = tx.Where("id IN (?)", currentAttemptIDs).Find(&currentAttempts).Error
+	if err != nil {
+		return FindAllAssignmentResponse{}, fmt.Errorf("find current attempts: %w", err)
+	}
+
+	extensionModels := []dbmodel.AssignmentExtension{}
+	err = tx.Where("assignment_id IN (?) and student_id = ?", assignmnetIDs, req.StudentID).
+		Find(&extensionModels).Error
+	if err != nil {
+		return FindAllAssignmentResponse{}, fmt.Errorf("find extensions: %w", err)
+	}
+
+	extendedDeadlines := make(map[uuid.UUID]time.Time, len(extensionModels))
+	for _, extensionModel := range extensionModels {
+		extendedDeadlines[extensionModel.AssignmentID] = extensionModel.DeadlineAt
+	}
+
+	assignments := toStudentAssignments(assignmentModels, assigners, submissionModels, currentAttempts)
+	for i := range assignments {
+		if deadlineAt, ok := extendedDeadlines[assignments[i].ID]; ok {
+			assignments[i] = assignments[i].WithExtendedDeadline(deadlineAt)
+		}
+	}
+
 	return FindAllAssignmentResponse{
-		Assignments: toStudentAssignments(assignmentModels, assigners, submissionModels),
+		Assignments: assignments,
 		Pagination: core.Pagination{
 			Page:  req.Page,
 			Limit: req.Limit,
@@ -159,50 +258,165 @@ func (StudentAssignmentReader) FindByID(ctx context.Context, tx *gorm.DB, req Fi
 		}
 	}
 
-	return toStudentAssignment(assignmentModel, assigner, submissionModel), nil
+	currentAttempt := dbmodel.SubmissionAttempt{}
+	if submissionModel.CurrentAttemptID != uuid.Nil {
+		err = tx.Where("id = ?", submissionModel.CurrentAttemptID).Take(&currentAttempt).Error
+		if err != nil {
+			return StudentAssignment{}, fmt.Errorf("find current attempt: %w", err)
+		}
+	}
+
+	studentAssignment := toStudentAssignment(assignmentModel, assigner, submissionModel, currentAttempt)
+	if req.WithStudentID {
+		studentAssignment.IsEnrolled, err = isEnrolled(tx, assignmentModel.CourseID, req.StudentID)
+		if err != nil {
+			return StudentAssignment{}, err
+		}
+
+		deadlineAt, ok, err := findExtendedDeadline(tx, assignmentModel.ID, req.StudentID)
+		if err != nil {
+			return StudentAssignment{}, err
+		}
+		if ok {
+			studentAssignment = studentAssignment.WithExtendedDeadline(deadlineAt)
+		}
+	}
+
+	if !studentAssignment.HasSubmission {
+		return studentAssignment, nil
+	}
+
+	caseResultModels := []dbmodel.SubmissionCaseResult{}
+	err = tx.Where("attempt_id = ?", submissionModel.CurrentAttemptID).
+		Order("case_number asc").
+		Find(&caseResultModels).Error
+	if err != nil {
+		return StudentAssignment{}, fmt.Errorf("find case results: %w", err)
+	}
+
+	studentAssignment.Submission.CaseResults = toCaseResults(caseResultModels)
+
+	return studentAssignment, nil
+}
+
+// isEnrolled checks the student is enrolled in the course of the assignment,
+// every student is enrolled in an assignment without a course
+func isEnrolled(tx *gorm.DB, courseID uuid.NullUUID, studentID uuid.UUID) (bool, error) {
+	if !courseID.Valid {
+		return true, nil
+	}
+
+	count := int64(0)
+	err := tx.Model(&dbmodel.CourseEnrollment{}).
+		Where("course_id = ? and user_id = ? and role = ?", courseID.UUID, studentID, dbmodel.EnrollmentRoleStudent).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("find enrollment: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// findExtendedDeadline finds the deadline of the student extension, ok is false when there is none
+func findExtendedDeadline(tx *gorm.DB, assignmentID, studentID uuid.UUID) (deadlineAt time.Time, ok bool, err error) {
+	extensionModel := dbmodel.AssignmentExtension{}
+	err = tx.Where("assignment_id = ? and student_id = ?", assignmentID, studentID).Take(&extensionModel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("find extension: %w", err)
+	}
+
+	return extensionModel.DeadlineAt, true, nil
 }
 
 type StudentSubmissionWriter struct{}
 
+// CreateSubmission creates the submission with its first attempt
 func (StudentSubmissionWriter) CreateSubmission(ctx context.Context, tx *gorm.DB, submission *StudentSubmission) error {
 	submissionModel := dbmodel.Submission{
 		Base: dbmodel.Base{
 			ID:       submission.ID,
 			Metadata: submission.ModelMetadata(),
 		},
-		AssignmentID: submission.Assignment.ID,
-		FileID:       submission.SubmissionFile.ID,
-		SubmittedBy:  submission.Student.ID,
-		Grade:        submission.Grade,
-		Feedback:     submission.Feedback,
+		AssignmentID:     submission.Assignment.ID,
+		FileID:           submission.SubmissionFile.ID,
+		SubmittedBy:      submission.Student.ID,
+		Language:         string(submission.Language),
+		Grade:            submission.Grade,
+		Feedback:         submission.Feedback,
+		CurrentAttemptID: submission.CurrentAttempt.ID,
+		ScoredAttemptID:  submission.ScoredAttemptID,
 	}
 	err := tx.Create(submissionModel).Error
 	if err != nil {
 		return fmt.Errorf("create submission: %w", err)
 	}
 
+	if err = createAttempt(tx, submission); err != nil {
+		return fmt.Errorf("create attempt: %w", err)
+	}
+
 	return nil
 }
 
+// UpdateSubmission saves the submission and appends its new current attempt,
+// the grade is only reset when the new attempt is the scored attempt
 func (StudentSubmissionWriter) UpdateSubmission(ctx context.Context, tx *gorm.DB, submission *StudentSubmission) error {
-	err := tx.Save(dbmodel.Submission{
-		Base: dbmodel.Base{
-			ID:       submission.ID,
-			Metadata: submission.ModelMetadata(),
-		},
-		AssignmentID: submission.Assignment.ID,
-		FileID:       submission.SubmissionFile.ID,
-		SubmittedBy:  submission.Student.ID,
-		Grade:        submission.Grade,
-		Feedback:     submission.Feedback,
-	}).Error
+	columns := map[string]any{
+		"file_id":            submission.SubmissionFile.ID,
+		"language":           string(submission.Language),
+		"current_attempt_id": submission.CurrentAttempt.ID,
+		"compile_output":     "",
+		"updated_at":         submission.UpdatedAt,
+	}
+	if submission.ScoredAttemptID == submission.CurrentAttempt.ID {
+		columns["grade"] = submission.Grade
+		columns["auto_grade"] = submission.Grade
+		columns["raw_grade"] = submission.RawGrade
+		columns["is_late"] = boolToInt(submission.Lateness.IsLate)
+		columns["late_days"] = submission.Lateness.Days
+		columns["late_penalty_percent"] = submission.Lateness.PenaltyPercent
+		columns["is_graded"] = boolToInt(submission.IsGraded)
+		columns["scored_attempt_id"] = submission.ScoredAttemptID
+	}
+
+	err := tx.Model(&dbmodel.Submission{}).
+		Where("id = ?", submission.ID).
+		UpdateColumns(columns).Error
 	if err != nil {
 		return fmt.Errorf("update submission: %w", err)
 	}
 
+	if err = createAttempt(tx, submission); err != nil {
+		return fmt.Errorf("create attempt: %w", err)
+	}
+
 	return nil
 }
 
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func createAttempt(tx *gorm.DB, submission *StudentSubmission) error {
+	attempt := submission.CurrentAttempt
+	return tx.Create(&dbmodel.SubmissionAttempt{
+		Base: dbmodel.Base{
+			ID:       attempt.ID,
+			Metadata: attempt.ModelMetadata(),
+		},
+		SubmissionID:  submission.ID,
+		AttemptNumber: attempt.Number,
+		FileID:        attempt.SubmissionFile.ID,
+		Language:      string(attempt.Language),
+	}).Error
+}
+
 type StudentSubmissionReader struct{}
 
 func (StudentSubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.UUID) (StudentSubmission, error) {
@@ -231,33 +445,125 @@ func (StudentSubmissionReader) FindByID(ctx context.Context, tx *gorm.DB, id uui
 		return StudentSubmission{}, fmt.Errorf("find file: %w", err)
 	}
 
+	attemptModel := dbmodel.SubmissionAttempt{}
+	err = tx.Where("id = ?", submissionModel.CurrentAttemptID).Take(&attemptModel).Error
+	if err != nil {
+		return StudentSubmission{}, fmt.Errorf("find current attempt: %w", err)
+	}
+
+	submissionFile := SubmissionFile{
+		ID:                fileModel.ID,
+		URL:               fileModel.URL,
+		Type:              fileModel.Type,
+		TimestampMetadata: core.TimestampMetaFromModel(fileModel.Metadata),
+	}
+
+	assignment := Assignment{
+		ID:               submissionModel.AssignmentID,
+		DeadlineAt:       assignmentModel.DeadlineAt,
+		HasAssignment:    true,
+		AllowedLanguages: grading.SplitLanguages(assignmentModel.AllowedLanguages),
+		FeedbackLevel:    assignmentModel.FeedbackLevel,
+		AttemptPolicy:    toAttemptPolicy(assignmentModel),
+		LatePolicy:       toLatePolicy(assignmentModel),
+		Release:          toRelease(assignmentModel),
+		CourseID:         assignmentModel.CourseID.UUID,
+		TestFramework:    grading.TestFramework(assignmentModel.TestFramework),
+		BuildCommand:     assignmentModel.BuildCommand,
+	}
+
+	assignment.IsEnrolled, err = isEnrolled(tx, assignmentModel.CourseID, submissionModel.SubmittedBy)
+	if err != nil {
+		return StudentSubmission{}, err
+	}
+
+	extendedDeadlineAt, hasExtension, err := findExtendedDeadline(tx, assignment.ID, submissionModel.SubmittedBy)
+	if err != nil {
+		return StudentSubmission{}, err
+	}
+	if hasExtension {
+		assignment = assignment.WithExtendedDeadline(extendedDeadlineAt)
+	}
+
 	return StudentSubmission{
 		ID: submissionModel.ID,
 		Student: Student{
 			ID:   studentModel.ID,
 			Name: studentModel.Name,
 		},
-		Assignment: Assignment{
-			ID:            submissionModel.AssignmentID,
-			DeadlineAt:    assignmentModel.DeadlineAt,
-			HasAssignment: true,
-		},
-		SubmissionFile: SubmissionFile{
-			ID:                fileModel.ID,
-			URL:               fileModel.URL,
-			Type:              fileModel.Type,
-			TimestampMetadata: core.TimestampMetaFromModel(fileModel.Metadata),
-		},
+		Assignment:        assignment,
+		Language:          grading.Language(submissionModel.Language),
+		SubmissionFile:    submissionFile,
 		Grade:             submissionModel.Grade,
+		RawGrade:          submissionModel.RawGrade,
+		Lateness:          toLateness(submissionModel.IsLate, submissionModel.LateDays, submissionModel.LatePenaltyPercent),
+		IsGraded:          submissionModel.IsGraded == 1,
 		Feedback:          submissionModel.Feedback,
+		CurrentAttempt:    toAttempt(attemptModel, nil),
+		ScoredAttemptID:   submissionModel.ScoredAttemptID,
 		TimestampMetadata: core.TimestampMetaFromModel(submissionModel.Metadata),
 	}, nil
 }
 
+type SubmissionAttemptReader struct{}
+
+// FindAllBySubmissionID finds the attempts with their case results, the latest attempt first
+func (SubmissionAttemptReader) FindAllBySubmissionID(ctx context.Context, tx *gorm.DB, submissionID uuid.UUID) ([]Attempt, error) {
+	attemptModels := []dbmodel.SubmissionAttempt{}
+	err := tx.Where("submission_id = ?", submissionID).
+		Order("attempt_number desc").
+		Find(&attemptModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("find attempts: %w", err)
+	}
+
+	attemptIDs := make([]uuid.UUID, len(attemptModels))
+	for i := range attemptModels {
+		attemptIDs[i] = attemptModels[i].ID
+	}
+
+	caseResultModels := []dbmodel.SubmissionCaseResult{}
+	err = tx.Where("attempt_id IN (?)", attemptIDs).
+		Order("case_number asc").
+		Find(&caseResultModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("find case results: %w", err)
+	}
+
+	attemptCaseResults := make(map[uuid.UUID][]dbmodel.SubmissionCaseResult, len(attemptModels))
+	for _, caseResultModel := range caseResultModels {
+		attemptCaseResults[caseResultModel.AttemptID] = append(attemptCaseResults[caseResultModel.AttemptID], caseResultModel)
+	}
+
+	attempts := make([]Attempt, len(attemptModels))
+	for i, attemptModel := range attemptModels {
+		attempts[i] = toAttempt(attemptModel, attemptCaseResults[attemptModel.ID])
+	}
+	return attempts, nil
+}
+
+func toAttempt(model dbmodel.SubmissionAttempt, caseResultModels []dbmodel.SubmissionCaseResult) Attempt {
+	return Attempt{
+		ID:                model.ID,
+		Number:            model.AttemptNumber,
+		SubmissionFile:    SubmissionFile{ID: model.FileID},
+		Language:          grading.Language(model.Language),
+		Grade:             model.Grade,
+		RawGrade:          model.RawGrade,
+		Lateness:          toLateness(model.IsLate, model.LateDays, model.LatePenaltyPercent),
+		MaxScore:          model.MaxScore,
+		IsGraded:          model.IsGraded == 1,
+		CompileOutput:     model.CompileOutput,
+		CaseResults:       toCaseResults(caseResultModels),
+		TimestampMetadata: core.TimestampMetaFromModel(model.Metadata),
+	}
+}
+
 func toStudentAssignments(
 	assignmentModels []dbmodel.Assignment,
 	assigners []Assigner,
 	submissions []dbmodel.Submission,
+	currentAttempts []dbmodel.SubmissionAttempt,
 ) []StudentAssignment {
 	mapAssigner := make(map[uuid.UUID]Assigner, len(assigners))
 	for _, assigner := range assigners {
@@ -269,16 +575,27 @@ func toStudentAssignments(
 		assignmentTosubmissionMap[submission.AssignmentID] = submission
 	}
 
+	mapCurrentAttempt := make(map[uuid.UUID]dbmodel.SubmissionAttempt, len(currentAttempts))
+	for _, attempt := range currentAttempts {
+		mapCurrentAttempt[attempt.ID] = attempt
+	}
+
 	assignments := make([]StudentAssignment, len(assignmentModels))
 	for i, assignmentModel := range assignmentModels {
 		submission := assignmentTosubmissionMap[assignmentModel.ID]
 		assigner := mapAssigner[assignmentModel.AssignedBy]
-		assignments[i] = toStudentAssignment(assignmentModel, assigner, submission)
+		currentAttempt := mapCurrentAttempt[submission.CurrentAttemptID]
+		assignments[i] = toStudentAssignment(assignmentModel, assigner, submission, currentAttempt)
 	}
 	return assignments
 }
 
-func toStudentAssignment(assignmentModel dbmodel.Assignment, assigner Assigner, submission dbmodel.Submission) StudentAssignment {
+func toStudentAssignment(
+	assignmentModel dbmodel.Assignment,
+	assigner Assigner,
+	submission dbmodel.Submission,
+	currentAttempt dbmodel.SubmissionAttempt,
+) StudentAssignment {
 	return StudentAssignment{
 		ID:           assignmentModel.ID,
 		Name:         assignmentModel.Name,
@@ -295,8 +612,24 @@ func toStudentAssignment(assignmentModel dbmodel.Assignment, assigner Assigner,
 			SubmissionFileID: submission.FileID,
 			UpdatedAt:        submission.UpdatedAt.Time,
 			IsGraded:         submission.IsGraded == 1,
+			Language:         grading.Language(submission.Language),
+			CompileOutput:    submission.CompileOutput,
+			MaxScore:         submission.MaxScore,
+			CurrentAttemptID: submission.CurrentAttemptID,
+			ScoredAttemptID:  submission.ScoredAttemptID,
+			AttemptCount:     currentAttempt.AttemptNumber,
+			LastAttemptAt:    currentAttempt.CreatedAt.Time,
+			RawGrade:         submission.RawGrade,
+			Lateness:         toLateness(submission.IsLate, submission.LateDays, submission.LatePenaltyPercent),
 		},
-		HasSubmission: (submission.ID != uuid.Nil && submission.ID.String() != ""),
+		HasSubmission:    (submission.ID != uuid.Nil && submission.ID.String() != ""),
+		AllowedLanguages: grading.SplitLanguages(assignmentModel.AllowedLanguages),
+		FeedbackLevel:    assignmentModel.FeedbackLevel,
+		AttemptPolicy:    toAttemptPolicy(assignmentModel),
+		LatePolicy:       toLatePolicy(assignmentModel),
+		Release:          toRelease(assignmentModel),
+		TestFramework:    grading.TestFramework(assignmentModel.TestFramework),
+		BuildCommand:     assignmentModel.BuildCommand,
 	}
 }
 
@@ -320,3 +653,26 @@ func (SubmissionFileReader) FindByID(ctx context.Context, tx *gorm.DB, id uuid.U
 		},
 	}, nil
 }
+
+func toCaseResults(models []dbmodel.SubmissionCaseResult) []CaseResult {
+	caseResults := make([]CaseResult, len(models))
+	for i, model := range models {
+		caseResults[i] = CaseResult{
+			Number:       model.CaseNumber,
+			Name:         model.Name,
+			Verdict:      model.Verdict,
+			ExitCode:     model.ExitCode,
+			Stderr:       model.Stderr,
+			WallTime:     time.Duration(model.WallTimeMs) * time.Millisecond,
+			CPUTime:      time.Duration(model.CPUTimeMs) * time.Millisecond,
+			Weight:       model.Weight,
+			Group:        model.GroupName,
+			EarnedWeight: model.EarnedWeight,
+			IsHidden:     model.IsHidden == 1,
+			Input:        model.Input,
+			Expected:     model.ExpectedOutput,
+			Output:       model.Output,
+		}
+	}
+	return caseResults
+}
@@ -0,0 +1,61 @@
+package student_assignment
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttemptPolicy(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		policy        AttemptPolicy
+		attemptCount  int32
+		lastAttemptAt time.Time
+		remaining     int32
+		nextAttemptAt time.Time
+		canAttempt    bool
+	}{
+		{"unlimited", AttemptPolicy{}, 10, now, UnlimitedAttempts, time.Time{}, true},
+		{"below max attempts", AttemptPolicy{MaxAttempts: 3}, 2, now, 1, time.Time{}, true},
+		{"at max attempts", AttemptPolicy{MaxAttempts: 3}, 3, now, 0, time.Time{}, false},
+		{"over max attempts", AttemptPolicy{MaxAttempts: 3}, 4, now, 0, time.Time{}, false},
+		{"first attempt has no cooldown", AttemptPolicy{Cooldown: time.Minute}, 0, time.Time{}, UnlimitedAttempts, time.Time{}, true},
+		{
+			"within cooldown",
+			AttemptPolicy{Cooldown: time.Minute}, 1, now.Add(-time.Minute + time.Second),
+			UnlimitedAttempts, now.Add(time.Second), false,
+		},
+		{
+			"cooldown just ended",
+			AttemptPolicy{Cooldown: time.Minute}, 1, now.Add(-time.Minute),
+			UnlimitedAttempts, now, true,
+		},
+		{
+			"max attempts with cooldown ended",
+			AttemptPolicy{MaxAttempts: 1, Cooldown: time.Minute}, 1, now.Add(-time.Hour),
+			0, now.Add(-time.Hour + time.Minute), false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if remaining := tt.policy.RemainingAttempts(tt.attemptCount); remaining != tt.remaining {
+				t.Errorf("want remaining attempts %d, got %d", tt.remaining, remaining)
+			}
+
+			if nextAttemptAt := tt.policy.NextAttemptAt(tt.lastAttemptAt); !nextAttemptAt.Equal(tt.nextAttemptAt) {
+				t.Errorf("want next attempt at %s, got %s", tt.nextAttemptAt, nextAttemptAt)
+			}
+
+			err := tt.policy.CanAttempt(now, tt.attemptCount, tt.lastAttemptAt)
+			if tt.canAttempt && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.canAttempt && err == nil {
+				t.Errorf("want error")
+			}
+		})
+	}
+}
@@ -55,13 +55,223 @@ func (ActivationToken) TableName() string {
 
 type Assignment struct {
 	Base
-	AssignedBy       uuid.UUID
-	Name             string
-	Description      string
-	Template         string
-	CaseInputFileID  uuid.UUID
-	CaseOutputFileID uuid.UUID
-	DeadlineAt       time.Time
+	AssignedBy        uuid.UUID
+	Name              string
+	Description       string
+	Template          string
+	CaseInputFileID   uuid.UUID
+	CaseOutputFileID  uuid.UUID
+	DeadlineAt        time.Time
+	CheckerType       CheckerType
+	CheckerAbsEpsilon float64
+	CheckerRelEpsilon float64
+	CheckerSource     string
+	// interactor limits, zero means the grading default
+	InteractorTimeLimitSec   int
+	InteractorMemoryLimitMib int
+	// AllowedLanguages is comma separated languages
+	AllowedLanguages string
+	// zero limit means the language default
+	TimeLimitSec   int
+	MemoryLimitMib int
+	OutputLimitKib int
+	ProcessLimit   int
+	MaxScore       int32
+	FeedbackLevel  FeedbackLevel
+	// MaxAttempts zero means unlimited attempts
+	MaxAttempts        int32
+	AttemptCooldownSec int32
+	ScorePolicy        ScorePolicy
+	// HardDeadlineAt is the late submission cutoff, null means no late submission
+	HardDeadlineAt           null.Time
+	LateGracePeriodSec       int32
+	LatePenaltyPercentPerDay int32
+	Status                   AssignmentStatus
+	// PublishAt is when a published assignment is visible to the students,
+	// null means right away
+	PublishAt null.Time
+	// CourseID null means the assignment is visible to every student
+	CourseID uuid.NullUUID
+	// TestVersion is bumped on every test change, the submissions are regraded on a bump
+	TestVersion int32
+	// TestFramework is the unit test framework of the harness files,
+	// empty means the submissions are graded with the test cases
+	TestFramework string
+	// BuildCommand replaces the language compile command, empty means the language default
+	BuildCommand string
+}
+
+// AssignmentHarnessFile is a teacher test file compiled with the student source
+type AssignmentHarnessFile struct {
+	Base
+	AssignmentID uuid.UUID
+	FileID       uuid.UUID
+	// Name is the file name inside the build dir, e.g. stack_test.cpp
+	Name string
+}
+
+// AssignmentStatus is whether the assignment is still prepared by the teacher
+type AssignmentStatus string
+
+const (
+	AssignmentStatusDraft     AssignmentStatus = "draft"
+	AssignmentStatusPublished AssignmentStatus = "published"
+)
+
+// ScorePolicy is which attempt grade is the submission grade
+type ScorePolicy string
+
+const (
+	ScorePolicyLast ScorePolicy = "last"
+	ScorePolicyBest ScorePolicy = "best"
+)
+
+// FeedbackLevel is how much of the case results the students see
+type FeedbackLevel string
+
+const (
+	FeedbackLevelScoreOnly FeedbackLevel = "score_only"
+	FeedbackLevelVerdicts  FeedbackLevel = "verdicts"
+	// FeedbackLevelFullDiff shows the input, expected & actual output of the sample cases
+	FeedbackLevelFullDiff FeedbackLevel = "full_diff"
+)
+
+type CheckerType string
+
+const (
+	CheckerTypeExact           CheckerType = "exact"
+	CheckerTypeToken           CheckerType = "token"
+	CheckerTypeCaseInsensitive CheckerType = "case_insensitive"
+	CheckerTypeFloat           CheckerType = "float"
+	CheckerTypeProgram         CheckerType = "program"
+	// CheckerTypeInteractor talks to the program instead of checking its output
+	CheckerTypeInteractor CheckerType = "interactor"
+)
+
+type AssignmentTestCase struct {
+	Base
+	AssignmentID   uuid.UUID
+	Name           string
+	Position       int32
+	Input          string
+	ExpectedOutput string
+	Weight         int32
+	IsHidden       int
+	// GroupName is the subtask of the case, empty when it's not in a group
+	GroupName string
+}
+
+// AssignmentRubricCriterion is a manually scored criterion of an assignment, e.g. code style
+type AssignmentRubricCriterion struct {
+	Base
+	AssignmentID uuid.UUID
+	Name         string
+	Description  string
+	MaxPoints    int32
+	Position     int32
+}
+
+func (AssignmentRubricCriterion) TableName() string {
+	return "assignment_rubric_criteria"
+}
+
+type SubmissionRubricScore struct {
+	Base
+	SubmissionID uuid.UUID
+	CriterionID  uuid.UUID
+	Points       int32
+	Comment      string
+	GradedBy     uuid.UUID
+}
+
+// SubmissionGradeAudit is the audit trail of the manual grading of a submission
+type SubmissionGradeAudit struct {
+	Base
+	SubmissionID     uuid.UUID
+	GradedBy         uuid.UUID
+	PreviousGrade    int32
+	PreviousMaxScore int32
+	Grade            int32
+	MaxScore         int32
+	AutoGrade        int32
+	RubricPoints     int32
+	IsOverridden     int
+	Reason           string
+}
+
+// SubmissionSimilarity is a pair of submissions of an assignment sharing source code,
+// it's replaced on every plagiarism check of the assignment
+type SubmissionSimilarity struct {
+	Base
+	AssignmentID       uuid.UUID
+	FirstSubmissionID  uuid.UUID
+	SecondSubmissionID uuid.UUID
+	// FirstFileID & SecondFileID are the compared source files,
+	// the submissions may be resubmitted after the check
+	FirstFileID  uuid.UUID
+	SecondFileID uuid.UUID
+	Score        int32
+}
+
+func (SubmissionSimilarity) TableName() string {
+	return "submission_similarities"
+}
+
+type SubmissionSimilarityRegion struct {
+	Base
+	SimilarityID    uuid.UUID
+	FirstStartLine  int32
+	FirstEndLine    int32
+	SecondStartLine int32
+	SecondEndLine   int32
+}
+
+type Term struct {
+	Base
+	Name    string
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+type Course struct {
+	Base
+	TermID      uuid.UUID
+	Code        string
+	Name        string
+	Description string
+}
+
+type CourseSection struct {
+	Base
+	CourseID uuid.UUID
+	Name     string
+}
+
+// EnrollmentRole is the role of a user in a course
+type EnrollmentRole string
+
+const (
+	EnrollmentRoleStudent    EnrollmentRole = "student"
+	EnrollmentRoleInstructor EnrollmentRole = "instructor"
+	EnrollmentRoleTA         EnrollmentRole = "ta"
+)
+
+type CourseEnrollment struct {
+	Base
+	CourseID  uuid.UUID
+	SectionID uuid.UUID
+	UserID    uuid.UUID
+	Role      EnrollmentRole
+}
+
+// AssignmentExtension replaces the assignment deadline for a student
+type AssignmentExtension struct {
+	Base
+	AssignmentID uuid.UUID
+	StudentID    uuid.UUID
+	DeadlineAt   time.Time
+	Reason       string
+	GrantedBy    uuid.UUID
 }
 
 type Submission struct {
@@ -69,9 +279,75 @@ type Submission struct {
 	AssignmentID uuid.UUID
 	FileID       uuid.UUID
 	SubmittedBy  uuid.UUID
+	Language     string
 	Grade        int32
 	Feedback     string
 	IsGraded     int
+	// CompileOutput is the truncated compiler diagnostics
+	CompileOutput string
+	// MaxScore is the assignment max score when it was graded
+	MaxScore int32
+	// CurrentAttemptID is the latest attempt
+	CurrentAttemptID uuid.UUID
+	// ScoredAttemptID is the attempt picked by the assignment score policy,
+	// the grade & late columns are copied from it
+	ScoredAttemptID uuid.UUID
+	// RawGrade is the grade before the late penalty
+	RawGrade           int32
+	IsLate             int
+	LateDays           int32
+	LatePenaltyPercent int32
+	// AutoGrade & AutoMaxScore are the automated grade of the scored attempt,
+	// Grade & MaxScore combine them with the manual grade
+	AutoGrade       int32
+	AutoMaxScore    int32
+	RubricPoints    int32
+	RubricMaxPoints int32
+	IsOverridden    int
+	OverrideGrade   int32
+	OverrideReason  string
+}
+
+// SubmissionAttempt is an immutable submit or resubmit of a submission,
+// only its grade is updated once it's graded
+type SubmissionAttempt struct {
+	Base
+	SubmissionID  uuid.UUID
+	AttemptNumber int32
+	FileID        uuid.UUID
+	Language      string
+	Grade         int32
+	MaxScore      int32
+	IsGraded      int
+	CompileOutput string
+	// RawGrade is the grade before the late penalty
+	RawGrade           int32
+	IsLate             int
+	LateDays           int32
+	LatePenaltyPercent int32
+}
+
+type SubmissionCaseResult struct {
+	Base
+	SubmissionID uuid.UUID
+	AttemptID    uuid.UUID
+	TestCaseID   uuid.NullUUID
+	CaseNumber   int32
+	// Name is the test name of a harness graded case
+	Name         string
+	Verdict      string
+	ExitCode     int32
+	Stderr       string
+	WallTimeMs   int64
+	CPUTimeMs    int64
+	Weight       int32
+	GroupName    string
+	EarnedWeight int32
+	IsHidden     int
+	// Input, ExpectedOutput & Output are only stored for the sample cases
+	Input          string
+	ExpectedOutput string
+	Output         string
 }
 
 type FileExt string
@@ -81,6 +357,7 @@ const (
 	FileTypeAssignmentCaseInput  FileType = "assignment_case_input"
 	FileTypeAssignmentCaseOutput FileType = "assignment_case_output"
 	FileTypeSubmission           FileType = "submission"
+	FileTypeAssignmentHarness    FileType = "assignment_harness"
 )
 
 type File struct {
@@ -99,4 +376,6 @@ type OutboxItem struct {
 	JobType       string
 	Payload       string
 	Version       int32
+	// RunAt is when the item is sent, null means right away
+	RunAt null.Time
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"slices"
+	"time"
 
 	"github.com/fahmifan/ulids"
 	"gorm.io/gorm"
@@ -51,6 +52,8 @@ type OutboxItem struct {
 	JobType       JobType
 	Payload       Payload
 	Version       int32
+	// RunAt schedules the item, zero means right away
+	RunAt time.Time
 }
 
 func NewOutboxItem(id ID, jobType JobType, key IdempotentKey, body Payload) (OutboxItem, error) {
@@ -77,6 +80,12 @@ func NewOutboxItem(id ID, jobType JobType, key IdempotentKey, body Payload) (Out
 	return item, nil
 }
 
+// ScheduleAt runs the item at the given time instead of right away
+func (item OutboxItem) ScheduleAt(runAt time.Time) OutboxItem {
+	item.RunAt = runAt
+	return item
+}
+
 func (item OutboxItem) IsEmpty() bool {
 	return item.ID.String() == EmptyIDStr
 }
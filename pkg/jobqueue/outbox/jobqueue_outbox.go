@@ -27,6 +27,8 @@ type EnqueueRequest struct {
 	Payload       any
 	JobType       jobqueue.JobType
 	IdempotentKey jobqueue.IdempotentKey
+	// RunAt schedules the job, zero means right away
+	RunAt time.Time
 }
 
 type OutboxService struct {
@@ -87,6 +89,10 @@ func (svc *OutboxService) Enqueue(ctx context.Context, tx *gorm.DB, req EnqueueR
 		return jobqueue.OutboxItem{}, logs.ErrWrapCtx(ctx, err, "OutboxService: Enqueue", "new item")
 	}
 
+	if !req.RunAt.IsZero() {
+		item = item.ScheduleAt(req.RunAt)
+	}
+
 	err = writer.CreateV2(ctx, dbtx, &item)
 	if err != nil {
 		return jobqueue.OutboxItem{}, logs.ErrWrapCtx(ctx, err, "OutboxService: Enqueue", "save item to db")
@@ -184,7 +190,7 @@ func (svc *OutboxService) run(ctx context.Context, limit int) error {
 
 	reader := OutboxItemReader{}
 
-	ids, err := reader.FindAllPendingIDs(ctx, svc.sqlDB, limit)
+	ids, err := reader.FindAllPendingIDs(ctx, svc.sqlDB, time.Now(), limit)
 	if err != nil {
 		return logs.ErrWrapCtx(context.Background(), err, "Run: OutboxService", "find items")
 	}
@@ -2,12 +2,15 @@ package outbox
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/fahmifan/autograd/pkg/dbmodel"
 	"github.com/fahmifan/autograd/pkg/jobqueue"
 	"github.com/fahmifan/autograd/pkg/xsqlc"
 	"github.com/fahmifan/ulids"
 	"github.com/samber/lo"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 )
 
@@ -30,9 +33,11 @@ func (r *OutboxItemReader) FindAllPending(ctx context.Context, tx *gorm.DB, limi
 	return items, err
 }
 
-func (r *OutboxItemReader) FindAllPendingIDs(ctx context.Context, tx xsqlc.DBTX, limit int) (ids []jobqueue.ID, err error) {
+// FindAllPendingIDs finds the pending items that are due at now
+func (r *OutboxItemReader) FindAllPendingIDs(ctx context.Context, tx xsqlc.DBTX, now time.Time, limit int) (ids []jobqueue.ID, err error) {
 	idStrs, err := xsqlc.New(tx).FindAllOutboxItemIDsByStatus(ctx, xsqlc.FindAllOutboxItemIDsByStatusParams{
 		Status:    string(jobqueue.StatusPending),
+		Now:       sql.NullTime{Time: now, Valid: true},
 		SizeLimit: int32(limit),
 	})
 
@@ -83,6 +88,7 @@ func (r *OutboxItemWriter) Create(ctx context.Context, tx *gorm.DB, item jobqueu
 		IdempotentKey: string(item.IdempotentKey),
 		Status:        string(item.Status),
 		Payload:       string(item.Payload),
+		RunAt:         null.NewTime(item.RunAt, !item.RunAt.IsZero()),
 	}
 
 	err := tx.Create(&outboxItem).Error
@@ -96,6 +102,7 @@ func (r *OutboxItemWriter) CreateV2(ctx context.Context, tx xsqlc.DBTX, item *jo
 		IdempotentKey: string(item.IdempotentKey),
 		Status:        string(item.Status),
 		Payload:       string(item.Payload),
+		RunAt:         null.NewTime(item.RunAt, !item.RunAt.IsZero()),
 	}
 
 	res, err := xsqlc.New(tx).CreateOutboxItem(ctx, xsqlc.CreateOutboxItemParams{
@@ -104,6 +111,7 @@ func (r *OutboxItemWriter) CreateV2(ctx context.Context, tx xsqlc.DBTX, item *jo
 		Status:        outboxItem.Status,
 		JobType:       outboxItem.JobType,
 		Payload:       outboxItem.Payload,
+		RunAt:         outboxItem.RunAt.NullTime,
 	})
 
 	res.Version = outboxItem.Version
@@ -148,6 +156,7 @@ func outboxItemFromModel(model dbmodel.OutboxItem) jobqueue.OutboxItem {
 		Status:        jobqueue.Status(model.Status),
 		Payload:       jobqueue.Payload(model.Payload),
 		Version:       model.Version,
+		RunAt:         model.RunAt.Time,
 	}
 }
 
@@ -159,6 +168,7 @@ func outboxItemFromSQLCModel(model xsqlc.OutboxItem) jobqueue.OutboxItem {
 		Status:        jobqueue.Status(model.Status),
 		Payload:       jobqueue.Payload(model.Payload),
 		Version:       model.Version,
+		RunAt:         model.RunAt.Time,
 	}
 }
 
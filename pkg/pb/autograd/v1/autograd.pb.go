@@ -9,6 +9,7 @@ package autogradv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -805,6 +806,27 @@ type Assignment struct {
 	DeadlineAt        string             `protobuf:"bytes,7,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
 	TimestampMetadata *TimestampMetadata `protobuf:"bytes,8,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
 	Template          string             `protobuf:"bytes,9,opt,name=template,proto3" json:"template,omitempty"`
+	Checker           *AssignmentChecker `protobuf:"bytes,10,opt,name=checker,proto3" json:"checker,omitempty"`
+	// one of cpp, python, java, go, rust
+	AllowedLanguages []string          `protobuf:"bytes,11,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	Limits           *AssignmentLimits `protobuf:"bytes,12,opt,name=limits,proto3" json:"limits,omitempty"`
+	MaxScore         int32             `protobuf:"varint,13,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// one of score_only, verdicts, full_diff
+	FeedbackLevel string                   `protobuf:"bytes,14,opt,name=feedback_level,json=feedbackLevel,proto3" json:"feedback_level,omitempty"`
+	AttemptPolicy *AssignmentAttemptPolicy `protobuf:"bytes,15,opt,name=attempt_policy,json=attemptPolicy,proto3" json:"attempt_policy,omitempty"`
+	LatePolicy    *AssignmentLatePolicy    `protobuf:"bytes,16,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`
+	// one of draft, published
+	Status string `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	// when a published assignment is visible to the students, RFC3339
+	PublishAt string `protobuf:"bytes,18,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// empty when the assignment is visible to every student
+	CourseId string `protobuf:"bytes,19,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// one of gtest, pytest, junit, empty grades the submissions with the test cases
+	TestFramework string                   `protobuf:"bytes,20,opt,name=test_framework,json=testFramework,proto3" json:"test_framework,omitempty"`
+	HarnessFiles  []*AssignmentHarnessFile `protobuf:"bytes,21,rep,name=harness_files,json=harnessFiles,proto3" json:"harness_files,omitempty"`
+	// build_command replaces the language compile command, e.g. make,
+	// empty means the language default
+	BuildCommand string `protobuf:"bytes,22,opt,name=build_command,json=buildCommand,proto3" json:"build_command,omitempty"`
 }
 
 func (x *Assignment) Reset() {
@@ -902,124 +924,126 @@ func (x *Assignment) GetTemplate() string {
 	return ""
 }
 
-type Submission struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Assignment) GetChecker() *AssignmentChecker {
+	if x != nil {
+		return x.Checker
+	}
+	return nil
+}
 
-	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Assignment        *Assignment        `protobuf:"bytes,2,opt,name=assignment,proto3" json:"assignment,omitempty"`
-	Submitter         *Submitter         `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	SubmissionFile    *SubmissionFile    `protobuf:"bytes,4,opt,name=submission_file,json=submissionFile,proto3" json:"submission_file,omitempty"`
-	TimestampMetadata *TimestampMetadata `protobuf:"bytes,5,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
-	SubmissionCode    string             `protobuf:"bytes,6,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+func (x *Assignment) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Assignment) GetLimits() *AssignmentLimits {
+	if x != nil {
+		return x.Limits
 	}
+	return nil
 }
 
-func (x *Submission) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Assignment) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
 }
 
-func (*Submission) ProtoMessage() {}
+func (x *Assignment) GetFeedbackLevel() string {
+	if x != nil {
+		return x.FeedbackLevel
+	}
+	return ""
+}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Assignment) GetAttemptPolicy() *AssignmentAttemptPolicy {
+	if x != nil {
+		return x.AttemptPolicy
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{14}
+func (x *Assignment) GetLatePolicy() *AssignmentLatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return nil
 }
 
-func (x *Submission) GetId() string {
+func (x *Assignment) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Submission) GetAssignment() *Assignment {
+func (x *Assignment) GetPublishAt() string {
 	if x != nil {
-		return x.Assignment
+		return x.PublishAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Submission) GetSubmitter() *Submitter {
+func (x *Assignment) GetCourseId() string {
 	if x != nil {
-		return x.Submitter
+		return x.CourseId
 	}
-	return nil
+	return ""
 }
 
-func (x *Submission) GetSubmissionFile() *SubmissionFile {
+func (x *Assignment) GetTestFramework() string {
 	if x != nil {
-		return x.SubmissionFile
+		return x.TestFramework
 	}
-	return nil
+	return ""
 }
 
-func (x *Submission) GetTimestampMetadata() *TimestampMetadata {
+func (x *Assignment) GetHarnessFiles() []*AssignmentHarnessFile {
 	if x != nil {
-		return x.TimestampMetadata
+		return x.HarnessFiles
 	}
 	return nil
 }
 
-func (x *Submission) GetSubmissionCode() string {
+func (x *Assignment) GetBuildCommand() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.BuildCommand
 	}
 	return ""
 }
 
-type UpdateAssignmentRequest struct {
+// AssignmentHarnessFile is a teacher test file compiled with the student source
+type AssignmentHarnessFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CaseInputFileId  string `protobuf:"bytes,4,opt,name=case_input_file_id,json=caseInputFileId,proto3" json:"case_input_file_id,omitempty"`
-	CaseOutputFileId string `protobuf:"bytes,5,opt,name=case_output_file_id,json=caseOutputFileId,proto3" json:"case_output_file_id,omitempty"`
-	DeadlineAt       string `protobuf:"bytes,6,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
-	Template         string `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
+	// file_id is a media uploaded with the assignment_harness media type
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// name is the file name the harness is compiled as, e.g. stack_test.cpp
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UpdateAssignmentRequest) Reset() {
-	*x = UpdateAssignmentRequest{}
+func (x *AssignmentHarnessFile) Reset() {
+	*x = AssignmentHarnessFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[15]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAssignmentRequest) String() string {
+func (x *AssignmentHarnessFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAssignmentRequest) ProtoMessage() {}
+func (*AssignmentHarnessFile) ProtoMessage() {}
 
-func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[15]
+func (x *AssignmentHarnessFile) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,75 +1054,107 @@ func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AssignmentHarnessFile.ProtoReflect.Descriptor instead.
+func (*AssignmentHarnessFile) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateAssignmentRequest) GetId() string {
+func (x *AssignmentHarnessFile) GetFileId() string {
 	if x != nil {
-		return x.Id
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetName() string {
+func (x *AssignmentHarnessFile) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
+type AssignmentAttemptPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// zero means unlimited attempts
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// cooldown_sec is the minimum interval between attempts
+	CooldownSec int32 `protobuf:"varint,2,opt,name=cooldown_sec,json=cooldownSec,proto3" json:"cooldown_sec,omitempty"`
+	// one of last, best, defaults to last
+	ScorePolicy string `protobuf:"bytes,3,opt,name=score_policy,json=scorePolicy,proto3" json:"score_policy,omitempty"`
+}
+
+func (x *AssignmentAttemptPolicy) Reset() {
+	*x = AssignmentAttemptPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UpdateAssignmentRequest) GetCaseInputFileId() string {
-	if x != nil {
-		return x.CaseInputFileId
+func (x *AssignmentAttemptPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentAttemptPolicy) ProtoMessage() {}
+
+func (x *AssignmentAttemptPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateAssignmentRequest) GetCaseOutputFileId() string {
+// Deprecated: Use AssignmentAttemptPolicy.ProtoReflect.Descriptor instead.
+func (*AssignmentAttemptPolicy) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignmentAttemptPolicy) GetMaxAttempts() int32 {
 	if x != nil {
-		return x.CaseOutputFileId
+		return x.MaxAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAssignmentRequest) GetDeadlineAt() string {
+func (x *AssignmentAttemptPolicy) GetCooldownSec() int32 {
 	if x != nil {
-		return x.DeadlineAt
+		return x.CooldownSec
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAssignmentRequest) GetTemplate() string {
+func (x *AssignmentAttemptPolicy) GetScorePolicy() string {
 	if x != nil {
-		return x.Template
+		return x.ScorePolicy
 	}
 	return ""
 }
 
-type CreateAssignmentRequest struct {
+// AssignmentLatePolicy accepts submissions after the deadline with a penalty
+type AssignmentLatePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CaseInputFileId  string `protobuf:"bytes,3,opt,name=case_input_file_id,json=caseInputFileId,proto3" json:"case_input_file_id,omitempty"`
-	CaseOutputFileId string `protobuf:"bytes,4,opt,name=case_output_file_id,json=caseOutputFileId,proto3" json:"case_output_file_id,omitempty"`
-	DeadlineAt       string `protobuf:"bytes,5,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
-	Template         string `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	// hard_deadline_at is the RFC3339 cutoff, empty means no late submission
+	HardDeadlineAt string `protobuf:"bytes,1,opt,name=hard_deadline_at,json=hardDeadlineAt,proto3" json:"hard_deadline_at,omitempty"`
+	// grace_period_sec after the deadline is not late
+	GracePeriodSec int32 `protobuf:"varint,2,opt,name=grace_period_sec,json=gracePeriodSec,proto3" json:"grace_period_sec,omitempty"`
+	// penalty_percent_per_day is deducted for each started day late
+	PenaltyPercentPerDay int32 `protobuf:"varint,3,opt,name=penalty_percent_per_day,json=penaltyPercentPerDay,proto3" json:"penalty_percent_per_day,omitempty"`
 }
 
-func (x *CreateAssignmentRequest) Reset() {
-	*x = CreateAssignmentRequest{}
+func (x *AssignmentLatePolicy) Reset() {
+	*x = AssignmentLatePolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_autograd_v1_autograd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,13 +1162,13 @@ func (x *CreateAssignmentRequest) Reset() {
 	}
 }
 
-func (x *CreateAssignmentRequest) String() string {
+func (x *AssignmentLatePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAssignmentRequest) ProtoMessage() {}
+func (*AssignmentLatePolicy) ProtoMessage() {}
 
-func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignmentLatePolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_autograd_v1_autograd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,65 +1180,44 @@ func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignmentLatePolicy.ProtoReflect.Descriptor instead.
+func (*AssignmentLatePolicy) Descriptor() ([]byte, []int) {
 	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateAssignmentRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateAssignmentRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateAssignmentRequest) GetCaseInputFileId() string {
-	if x != nil {
-		return x.CaseInputFileId
-	}
-	return ""
-}
-
-func (x *CreateAssignmentRequest) GetCaseOutputFileId() string {
+func (x *AssignmentLatePolicy) GetHardDeadlineAt() string {
 	if x != nil {
-		return x.CaseOutputFileId
+		return x.HardDeadlineAt
 	}
 	return ""
 }
 
-func (x *CreateAssignmentRequest) GetDeadlineAt() string {
+func (x *AssignmentLatePolicy) GetGracePeriodSec() int32 {
 	if x != nil {
-		return x.DeadlineAt
+		return x.GracePeriodSec
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAssignmentRequest) GetTemplate() string {
+func (x *AssignmentLatePolicy) GetPenaltyPercentPerDay() int32 {
 	if x != nil {
-		return x.Template
+		return x.PenaltyPercentPerDay
 	}
-	return ""
+	return 0
 }
 
-type CreateSubmissionRequest struct {
+type SubmissionLateness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	SubmitterId  string `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
-	SourceFileId string `protobuf:"bytes,3,opt,name=source_file_id,json=sourceFileId,proto3" json:"source_file_id,omitempty"`
+	IsLate         bool  `protobuf:"varint,1,opt,name=is_late,json=isLate,proto3" json:"is_late,omitempty"`
+	Days           int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	PenaltyPercent int32 `protobuf:"varint,3,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
 }
 
-func (x *CreateSubmissionRequest) Reset() {
-	*x = CreateSubmissionRequest{}
+func (x *SubmissionLateness) Reset() {
+	*x = SubmissionLateness{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_autograd_v1_autograd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,13 +1225,13 @@ func (x *CreateSubmissionRequest) Reset() {
 	}
 }
 
-func (x *CreateSubmissionRequest) String() string {
+func (x *SubmissionLateness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubmissionRequest) ProtoMessage() {}
+func (*SubmissionLateness) ProtoMessage() {}
 
-func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmissionLateness) ProtoReflect() protoreflect.Message {
 	mi := &file_autograd_v1_autograd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,44 +1243,46 @@ func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmissionLateness.ProtoReflect.Descriptor instead.
+func (*SubmissionLateness) Descriptor() ([]byte, []int) {
 	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateSubmissionRequest) GetAssignmentId() string {
+func (x *SubmissionLateness) GetIsLate() bool {
 	if x != nil {
-		return x.AssignmentId
+		return x.IsLate
 	}
-	return ""
+	return false
 }
 
-func (x *CreateSubmissionRequest) GetSubmitterId() string {
+func (x *SubmissionLateness) GetDays() int32 {
 	if x != nil {
-		return x.SubmitterId
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSubmissionRequest) GetSourceFileId() string {
+func (x *SubmissionLateness) GetPenaltyPercent() int32 {
 	if x != nil {
-		return x.SourceFileId
+		return x.PenaltyPercent
 	}
-	return ""
+	return 0
 }
 
-type UpdateSubmissionRequest struct {
+// AssignmentLimits zero value means the default of the submission language
+type AssignmentLimits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubmitterId  string `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
-	SourceFileId string `protobuf:"bytes,3,opt,name=source_file_id,json=sourceFileId,proto3" json:"source_file_id,omitempty"`
+	TimeLimitSec   int32 `protobuf:"varint,1,opt,name=time_limit_sec,json=timeLimitSec,proto3" json:"time_limit_sec,omitempty"`
+	MemoryLimitMib int32 `protobuf:"varint,2,opt,name=memory_limit_mib,json=memoryLimitMib,proto3" json:"memory_limit_mib,omitempty"`
+	OutputLimitKib int32 `protobuf:"varint,3,opt,name=output_limit_kib,json=outputLimitKib,proto3" json:"output_limit_kib,omitempty"`
+	ProcessLimit   int32 `protobuf:"varint,4,opt,name=process_limit,json=processLimit,proto3" json:"process_limit,omitempty"`
 }
 
-func (x *UpdateSubmissionRequest) Reset() {
-	*x = UpdateSubmissionRequest{}
+func (x *AssignmentLimits) Reset() {
+	*x = AssignmentLimits{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_autograd_v1_autograd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1253,13 +1290,13 @@ func (x *UpdateSubmissionRequest) Reset() {
 	}
 }
 
-func (x *UpdateSubmissionRequest) String() string {
+func (x *AssignmentLimits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubmissionRequest) ProtoMessage() {}
+func (*AssignmentLimits) ProtoMessage() {}
 
-func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignmentLimits) ProtoReflect() protoreflect.Message {
 	mi := &file_autograd_v1_autograd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1271,43 +1308,57 @@ func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignmentLimits.ProtoReflect.Descriptor instead.
+func (*AssignmentLimits) Descriptor() ([]byte, []int) {
 	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateSubmissionRequest) GetId() string {
+func (x *AssignmentLimits) GetTimeLimitSec() int32 {
 	if x != nil {
-		return x.Id
+		return x.TimeLimitSec
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetSubmitterId() string {
+func (x *AssignmentLimits) GetMemoryLimitMib() int32 {
 	if x != nil {
-		return x.SubmitterId
+		return x.MemoryLimitMib
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubmissionRequest) GetSourceFileId() string {
+func (x *AssignmentLimits) GetOutputLimitKib() int32 {
 	if x != nil {
-		return x.SourceFileId
+		return x.OutputLimitKib
 	}
-	return ""
+	return 0
 }
 
-type LoginRequest struct {
+func (x *AssignmentLimits) GetProcessLimit() int32 {
+	if x != nil {
+		return x.ProcessLimit
+	}
+	return 0
+}
+
+type AssignmentChecker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// one of exact, token, case_insensitive, float, program, interactor
+	Type       string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	AbsEpsilon float64 `protobuf:"fixed64,2,opt,name=abs_epsilon,json=absEpsilon,proto3" json:"abs_epsilon,omitempty"`
+	RelEpsilon float64 `protobuf:"fixed64,3,opt,name=rel_epsilon,json=relEpsilon,proto3" json:"rel_epsilon,omitempty"`
+	// c++ source of testlib style checker or interactor program
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// limits of the interactor program, zero means the default
+	InteractorTimeLimitSec   int32 `protobuf:"varint,5,opt,name=interactor_time_limit_sec,json=interactorTimeLimitSec,proto3" json:"interactor_time_limit_sec,omitempty"`
+	InteractorMemoryLimitMib int32 `protobuf:"varint,6,opt,name=interactor_memory_limit_mib,json=interactorMemoryLimitMib,proto3" json:"interactor_memory_limit_mib,omitempty"`
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *AssignmentChecker) Reset() {
+	*x = AssignmentChecker{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_autograd_v1_autograd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1315,13 +1366,13 @@ func (x *LoginRequest) Reset() {
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *AssignmentChecker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*AssignmentChecker) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignmentChecker) ProtoReflect() protoreflect.Message {
 	mi := &file_autograd_v1_autograd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1333,49 +1384,86 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignmentChecker.ProtoReflect.Descriptor instead.
+func (*AssignmentChecker) Descriptor() ([]byte, []int) {
 	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LoginRequest) GetEmail() string {
+func (x *AssignmentChecker) GetType() string {
 	if x != nil {
-		return x.Email
+		return x.Type
 	}
 	return ""
 }
 
-func (x *LoginRequest) GetPassword() string {
+func (x *AssignmentChecker) GetAbsEpsilon() float64 {
 	if x != nil {
-		return x.Password
+		return x.AbsEpsilon
 	}
-	return ""
+	return 0
 }
 
-type LoginResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AssignmentChecker) GetRelEpsilon() float64 {
+	if x != nil {
+		return x.RelEpsilon
+	}
+	return 0
+}
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+func (x *AssignmentChecker) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AssignmentChecker) GetInteractorTimeLimitSec() int32 {
+	if x != nil {
+		return x.InteractorTimeLimitSec
 	}
+	return 0
 }
 
-func (x *LoginResponse) String() string {
+func (x *AssignmentChecker) GetInteractorMemoryLimitMib() int32 {
+	if x != nil {
+		return x.InteractorMemoryLimitMib
+	}
+	return 0
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Assignment        *Assignment        `protobuf:"bytes,2,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	Submitter         *Submitter         `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	SubmissionFile    *SubmissionFile    `protobuf:"bytes,4,opt,name=submission_file,json=submissionFile,proto3" json:"submission_file,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,5,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+	// submission_code of a multi file submission is its source files each headed by its path
+	SubmissionCode string           `protobuf:"bytes,6,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	Grade          *SubmissionGrade `protobuf:"bytes,7,opt,name=grade,proto3" json:"grade,omitempty"`
+	// source_files are only set for a multi file submission
+	SourceFiles []*SourceFile `protobuf:"bytes,8,rep,name=source_files,json=sourceFiles,proto3" json:"source_files,omitempty"`
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*Submission) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+func (x *Submission) ProtoReflect() protoreflect.Message {
 	mi := &file_autograd_v1_autograd_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,91 +1475,95 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
 	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *LoginResponse) GetToken() string {
+func (x *Submission) GetId() string {
 	if x != nil {
-		return x.Token
+		return x.Id
 	}
 	return ""
 }
 
-type FindAllAssignmentsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+func (x *Submission) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
 }
 
-func (x *FindAllAssignmentsRequest) Reset() {
-	*x = FindAllAssignmentsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Submission) GetSubmitter() *Submitter {
+	if x != nil {
+		return x.Submitter
 	}
+	return nil
 }
 
-func (x *FindAllAssignmentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Submission) GetSubmissionFile() *SubmissionFile {
+	if x != nil {
+		return x.SubmissionFile
+	}
+	return nil
 }
 
-func (*FindAllAssignmentsRequest) ProtoMessage() {}
+func (x *Submission) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
 
-func (x *FindAllAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Submission) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FindAllAssignmentsRequest.ProtoReflect.Descriptor instead.
-func (*FindAllAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{21}
+func (x *Submission) GetGrade() *SubmissionGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
 }
 
-func (x *FindAllAssignmentsRequest) GetPaginationRequest() *PaginationRequest {
+func (x *Submission) GetSourceFiles() []*SourceFile {
 	if x != nil {
-		return x.PaginationRequest
+		return x.SourceFiles
 	}
 	return nil
 }
 
-type FindAllAssignmentsResponse struct {
+// SourceFile is a file of a multi file submission
+type SourceFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignments        []*Assignment       `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+	// path is slash separated & relative to the project root, e.g. src/main.cpp
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *FindAllAssignmentsResponse) Reset() {
-	*x = FindAllAssignmentsResponse{}
+func (x *SourceFile) Reset() {
+	*x = SourceFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[22]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAllAssignmentsResponse) String() string {
+func (x *SourceFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAllAssignmentsResponse) ProtoMessage() {}
+func (*SourceFile) ProtoMessage() {}
 
-func (x *FindAllAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[22]
+func (x *SourceFile) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,54 +1574,62 @@ func (x *FindAllAssignmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAllAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*FindAllAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SourceFile.ProtoReflect.Descriptor instead.
+func (*SourceFile) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FindAllAssignmentsResponse) GetAssignments() []*Assignment {
+func (x *SourceFile) GetPath() string {
 	if x != nil {
-		return x.Assignments
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *FindAllAssignmentsResponse) GetPaginationMetadata() *PaginationMetadata {
+func (x *SourceFile) GetContent() string {
 	if x != nil {
-		return x.PaginationMetadata
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type ManagedUser struct {
+// SubmissionGrade combines the automated grade with the manual grade,
+// the rubric points are added to the automated grade and an override replaces both
+type SubmissionGrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email             string             `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role              string             `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	TimestampMetadata *TimestampMetadata `protobuf:"bytes,5,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
-}
-
-func (x *ManagedUser) Reset() {
-	*x = ManagedUser{}
+	Grade           int32                    `protobuf:"varint,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	MaxScore        int32                    `protobuf:"varint,2,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AutoGrade       int32                    `protobuf:"varint,3,opt,name=auto_grade,json=autoGrade,proto3" json:"auto_grade,omitempty"`
+	AutoMaxScore    int32                    `protobuf:"varint,4,opt,name=auto_max_score,json=autoMaxScore,proto3" json:"auto_max_score,omitempty"`
+	RubricPoints    int32                    `protobuf:"varint,5,opt,name=rubric_points,json=rubricPoints,proto3" json:"rubric_points,omitempty"`
+	RubricMaxPoints int32                    `protobuf:"varint,6,opt,name=rubric_max_points,json=rubricMaxPoints,proto3" json:"rubric_max_points,omitempty"`
+	IsOverridden    bool                     `protobuf:"varint,7,opt,name=is_overridden,json=isOverridden,proto3" json:"is_overridden,omitempty"`
+	OverrideGrade   int32                    `protobuf:"varint,8,opt,name=override_grade,json=overrideGrade,proto3" json:"override_grade,omitempty"`
+	OverrideReason  string                   `protobuf:"bytes,9,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	Feedback        string                   `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	RubricScores    []*SubmissionRubricScore `protobuf:"bytes,11,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+}
+
+func (x *SubmissionGrade) Reset() {
+	*x = SubmissionGrade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[23]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ManagedUser) String() string {
+func (x *SubmissionGrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ManagedUser) ProtoMessage() {}
+func (*SubmissionGrade) ProtoMessage() {}
 
-func (x *ManagedUser) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[23]
+func (x *SubmissionGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,119 +1640,146 @@ func (x *ManagedUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ManagedUser.ProtoReflect.Descriptor instead.
-func (*ManagedUser) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SubmissionGrade.ProtoReflect.Descriptor instead.
+func (*SubmissionGrade) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ManagedUser) GetId() string {
+func (x *SubmissionGrade) GetGrade() int32 {
 	if x != nil {
-		return x.Id
+		return x.Grade
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedUser) GetName() string {
+func (x *SubmissionGrade) GetMaxScore() int32 {
 	if x != nil {
-		return x.Name
+		return x.MaxScore
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedUser) GetEmail() string {
+func (x *SubmissionGrade) GetAutoGrade() int32 {
 	if x != nil {
-		return x.Email
+		return x.AutoGrade
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedUser) GetRole() string {
+func (x *SubmissionGrade) GetAutoMaxScore() int32 {
 	if x != nil {
-		return x.Role
+		return x.AutoMaxScore
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedUser) GetTimestampMetadata() *TimestampMetadata {
+func (x *SubmissionGrade) GetRubricPoints() int32 {
 	if x != nil {
-		return x.TimestampMetadata
+		return x.RubricPoints
 	}
-	return nil
+	return 0
 }
 
-type FindAllManagedUsersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+func (x *SubmissionGrade) GetRubricMaxPoints() int32 {
+	if x != nil {
+		return x.RubricMaxPoints
+	}
+	return 0
 }
 
-func (x *FindAllManagedUsersRequest) Reset() {
-	*x = FindAllManagedUsersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SubmissionGrade) GetIsOverridden() bool {
+	if x != nil {
+		return x.IsOverridden
 	}
+	return false
 }
 
-func (x *FindAllManagedUsersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubmissionGrade) GetOverrideGrade() int32 {
+	if x != nil {
+		return x.OverrideGrade
+	}
+	return 0
 }
 
-func (*FindAllManagedUsersRequest) ProtoMessage() {}
-
-func (x *FindAllManagedUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SubmissionGrade) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FindAllManagedUsersRequest.ProtoReflect.Descriptor instead.
-func (*FindAllManagedUsersRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{24}
+func (x *SubmissionGrade) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
 }
 
-func (x *FindAllManagedUsersRequest) GetPaginationRequest() *PaginationRequest {
+func (x *SubmissionGrade) GetRubricScores() []*SubmissionRubricScore {
 	if x != nil {
-		return x.PaginationRequest
+		return x.RubricScores
 	}
 	return nil
 }
 
-type FindAllManagedUsersResponse struct {
+type UpdateAssignmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManagedUsers       []*ManagedUser      `protobuf:"bytes,1,rep,name=managed_users,json=managedUsers,proto3" json:"managed_users,omitempty"`
-	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+	Id               string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CaseInputFileId  string             `protobuf:"bytes,4,opt,name=case_input_file_id,json=caseInputFileId,proto3" json:"case_input_file_id,omitempty"`
+	CaseOutputFileId string             `protobuf:"bytes,5,opt,name=case_output_file_id,json=caseOutputFileId,proto3" json:"case_output_file_id,omitempty"`
+	DeadlineAt       string             `protobuf:"bytes,6,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Template         string             `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
+	Checker          *AssignmentChecker `protobuf:"bytes,8,opt,name=checker,proto3" json:"checker,omitempty"`
+	AllowedLanguages []string           `protobuf:"bytes,9,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	Limits           *AssignmentLimits  `protobuf:"bytes,10,opt,name=limits,proto3" json:"limits,omitempty"`
+	// zero means 100
+	MaxScore int32 `protobuf:"varint,11,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// one of score_only, verdicts, full_diff, defaults to verdicts
+	FeedbackLevel string                   `protobuf:"bytes,12,opt,name=feedback_level,json=feedbackLevel,proto3" json:"feedback_level,omitempty"`
+	AttemptPolicy *AssignmentAttemptPolicy `protobuf:"bytes,13,opt,name=attempt_policy,json=attemptPolicy,proto3" json:"attempt_policy,omitempty"`
+	LatePolicy    *AssignmentLatePolicy    `protobuf:"bytes,14,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`
+	// one of draft, published, empty keeps the current status & publish time
+	Status string `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	// empty publishes right away, or keeps the publish time of a published assignment
+	PublishAt string `protobuf:"bytes,16,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// only the students enrolled in the course see the assignment,
+	// empty makes the assignment visible to every student
+	CourseId string `protobuf:"bytes,17,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// one of gtest, pytest, junit, empty grades the submissions with the test cases
+	TestFramework string                   `protobuf:"bytes,18,opt,name=test_framework,json=testFramework,proto3" json:"test_framework,omitempty"`
+	HarnessFiles  []*AssignmentHarnessFile `protobuf:"bytes,19,rep,name=harness_files,json=harnessFiles,proto3" json:"harness_files,omitempty"`
+	// build_command is run in /build with the submission files, it must write
+	// the program where the language runs it, e.g. /build/app for c++
+	BuildCommand string `protobuf:"bytes,20,opt,name=build_command,json=buildCommand,proto3" json:"build_command,omitempty"`
+	// update_mask lists the settings the update replaces: checker, allowed_languages, limits,
+	// max_score, feedback_level, attempt_policy, late_policy, course_id, test_framework,
+	// harness_files & build_command. The settings not listed keep their current value.
+	// The name, description, case files, deadline & template are always replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,21,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *FindAllManagedUsersResponse) Reset() {
-	*x = FindAllManagedUsersResponse{}
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[25]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAllManagedUsersResponse) String() string {
+func (x *UpdateAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAllManagedUsersResponse) ProtoMessage() {}
+func (*UpdateAssignmentRequest) ProtoMessage() {}
 
-func (x *FindAllManagedUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[25]
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,186 +1790,210 @@ func (x *FindAllManagedUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAllManagedUsersResponse.ProtoReflect.Descriptor instead.
-func (*FindAllManagedUsersResponse) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *FindAllManagedUsersResponse) GetManagedUsers() []*ManagedUser {
+func (x *UpdateAssignmentRequest) GetId() string {
 	if x != nil {
-		return x.ManagedUsers
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *FindAllManagedUsersResponse) GetPaginationMetadata() *PaginationMetadata {
+func (x *UpdateAssignmentRequest) GetName() string {
 	if x != nil {
-		return x.PaginationMetadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type FindAllSubmissionsForAssignmentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
-	AssignmentId      string             `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+func (x *UpdateAssignmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentRequest) Reset() {
-	*x = FindAllSubmissionsForAssignmentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateAssignmentRequest) GetCaseInputFileId() string {
+	if x != nil {
+		return x.CaseInputFileId
 	}
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateAssignmentRequest) GetCaseOutputFileId() string {
+	if x != nil {
+		return x.CaseOutputFileId
+	}
+	return ""
 }
 
-func (*FindAllSubmissionsForAssignmentRequest) ProtoMessage() {}
-
-func (x *FindAllSubmissionsForAssignmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateAssignmentRequest) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FindAllSubmissionsForAssignmentRequest.ProtoReflect.Descriptor instead.
-func (*FindAllSubmissionsForAssignmentRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{26}
+func (x *UpdateAssignmentRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentRequest) GetPaginationRequest() *PaginationRequest {
+func (x *UpdateAssignmentRequest) GetChecker() *AssignmentChecker {
 	if x != nil {
-		return x.PaginationRequest
+		return x.Checker
 	}
 	return nil
 }
 
-func (x *FindAllSubmissionsForAssignmentRequest) GetAssignmentId() string {
+func (x *UpdateAssignmentRequest) GetAllowedLanguages() []string {
 	if x != nil {
-		return x.AssignmentId
+		return x.AllowedLanguages
 	}
-	return ""
+	return nil
 }
 
-type FindAllSubmissionsForAssignmentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Submissions    []*FindAllSubmissionsForAssignmentResponse_Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	AssignmentId   string                                                `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	AssignmentName string                                                `protobuf:"bytes,3,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
-	AssignerId     string                                                `protobuf:"bytes,4,opt,name=assigner_id,json=assignerId,proto3" json:"assigner_id,omitempty"`
-	AssignerName   string                                                `protobuf:"bytes,5,opt,name=assigner_name,json=assignerName,proto3" json:"assigner_name,omitempty"`
+func (x *UpdateAssignmentRequest) GetLimits() *AssignmentLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) Reset() {
-	*x = FindAllSubmissionsForAssignmentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateAssignmentRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
 	}
+	return 0
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateAssignmentRequest) GetFeedbackLevel() string {
+	if x != nil {
+		return x.FeedbackLevel
+	}
+	return ""
 }
 
-func (*FindAllSubmissionsForAssignmentResponse) ProtoMessage() {}
-
-func (x *FindAllSubmissionsForAssignmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateAssignmentRequest) GetAttemptPolicy() *AssignmentAttemptPolicy {
+	if x != nil {
+		return x.AttemptPolicy
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FindAllSubmissionsForAssignmentResponse.ProtoReflect.Descriptor instead.
-func (*FindAllSubmissionsForAssignmentResponse) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{27}
+func (x *UpdateAssignmentRequest) GetLatePolicy() *AssignmentLatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return nil
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) GetSubmissions() []*FindAllSubmissionsForAssignmentResponse_Submission {
+func (x *UpdateAssignmentRequest) GetStatus() string {
 	if x != nil {
-		return x.Submissions
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) GetAssignmentId() string {
+func (x *UpdateAssignmentRequest) GetPublishAt() string {
 	if x != nil {
-		return x.AssignmentId
+		return x.PublishAt
 	}
 	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) GetAssignmentName() string {
+func (x *UpdateAssignmentRequest) GetCourseId() string {
 	if x != nil {
-		return x.AssignmentName
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) GetAssignerId() string {
+func (x *UpdateAssignmentRequest) GetTestFramework() string {
 	if x != nil {
-		return x.AssignerId
+		return x.TestFramework
 	}
 	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse) GetAssignerName() string {
+func (x *UpdateAssignmentRequest) GetHarnessFiles() []*AssignmentHarnessFile {
 	if x != nil {
-		return x.AssignerName
+		return x.HarnessFiles
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentRequest) GetBuildCommand() string {
+	if x != nil {
+		return x.BuildCommand
 	}
 	return ""
 }
 
-type FindAllStudentAssignmentsRequest struct {
+func (x *UpdateAssignmentRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CreateAssignmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
-	FromDate          string             `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
-	ToDate            string             `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Name             string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CaseInputFileId  string             `protobuf:"bytes,3,opt,name=case_input_file_id,json=caseInputFileId,proto3" json:"case_input_file_id,omitempty"`
+	CaseOutputFileId string             `protobuf:"bytes,4,opt,name=case_output_file_id,json=caseOutputFileId,proto3" json:"case_output_file_id,omitempty"`
+	DeadlineAt       string             `protobuf:"bytes,5,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Template         string             `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	Checker          *AssignmentChecker `protobuf:"bytes,7,opt,name=checker,proto3" json:"checker,omitempty"`
+	AllowedLanguages []string           `protobuf:"bytes,8,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	Limits           *AssignmentLimits  `protobuf:"bytes,9,opt,name=limits,proto3" json:"limits,omitempty"`
+	// zero means 100
+	MaxScore int32 `protobuf:"varint,10,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// one of score_only, verdicts, full_diff, defaults to verdicts
+	FeedbackLevel string                   `protobuf:"bytes,11,opt,name=feedback_level,json=feedbackLevel,proto3" json:"feedback_level,omitempty"`
+	AttemptPolicy *AssignmentAttemptPolicy `protobuf:"bytes,12,opt,name=attempt_policy,json=attemptPolicy,proto3" json:"attempt_policy,omitempty"`
+	LatePolicy    *AssignmentLatePolicy    `protobuf:"bytes,13,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`
+	// one of draft, published, defaults to published
+	Status string `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	// empty publishes right away
+	PublishAt string `protobuf:"bytes,15,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// only the students enrolled in the course see the assignment,
+	// empty makes the assignment visible to every student
+	CourseId string `protobuf:"bytes,16,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// one of gtest, pytest, junit, empty grades the submissions with the test cases
+	TestFramework string                   `protobuf:"bytes,17,opt,name=test_framework,json=testFramework,proto3" json:"test_framework,omitempty"`
+	HarnessFiles  []*AssignmentHarnessFile `protobuf:"bytes,18,rep,name=harness_files,json=harnessFiles,proto3" json:"harness_files,omitempty"`
+	// build_command is run in /build with the submission files, it must write
+	// the program where the language runs it, e.g. /build/app for c++
+	BuildCommand string `protobuf:"bytes,19,opt,name=build_command,json=buildCommand,proto3" json:"build_command,omitempty"`
 }
 
-func (x *FindAllStudentAssignmentsRequest) Reset() {
-	*x = FindAllStudentAssignmentsRequest{}
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[28]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAllStudentAssignmentsRequest) String() string {
+func (x *CreateAssignmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAllStudentAssignmentsRequest) ProtoMessage() {}
+func (*CreateAssignmentRequest) ProtoMessage() {}
 
-func (x *FindAllStudentAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[28]
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,239 +2004,171 @@ func (x *FindAllStudentAssignmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAllStudentAssignmentsRequest.ProtoReflect.Descriptor instead.
-func (*FindAllStudentAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *FindAllStudentAssignmentsRequest) GetPaginationRequest() *PaginationRequest {
+func (x *CreateAssignmentRequest) GetName() string {
 	if x != nil {
-		return x.PaginationRequest
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *FindAllStudentAssignmentsRequest) GetFromDate() string {
+func (x *CreateAssignmentRequest) GetDescription() string {
 	if x != nil {
-		return x.FromDate
+		return x.Description
 	}
 	return ""
 }
 
-func (x *FindAllStudentAssignmentsRequest) GetToDate() string {
+func (x *CreateAssignmentRequest) GetCaseInputFileId() string {
 	if x != nil {
-		return x.ToDate
+		return x.CaseInputFileId
 	}
 	return ""
 }
 
-type FindAllStudentAssignmentsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Assignments        []*StudentAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	PaginationMetadata *PaginationMetadata  `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
-}
-
-func (x *FindAllStudentAssignmentsResponse) Reset() {
-	*x = FindAllStudentAssignmentsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateAssignmentRequest) GetCaseOutputFileId() string {
+	if x != nil {
+		return x.CaseOutputFileId
 	}
+	return ""
 }
 
-func (x *FindAllStudentAssignmentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindAllStudentAssignmentsResponse) ProtoMessage() {}
-
-func (x *FindAllStudentAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateAssignmentRequest) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FindAllStudentAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*FindAllStudentAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{29}
+func (x *CreateAssignmentRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
 }
 
-func (x *FindAllStudentAssignmentsResponse) GetAssignments() []*StudentAssignment {
+func (x *CreateAssignmentRequest) GetChecker() *AssignmentChecker {
 	if x != nil {
-		return x.Assignments
+		return x.Checker
 	}
 	return nil
 }
 
-func (x *FindAllStudentAssignmentsResponse) GetPaginationMetadata() *PaginationMetadata {
+func (x *CreateAssignmentRequest) GetAllowedLanguages() []string {
 	if x != nil {
-		return x.PaginationMetadata
+		return x.AllowedLanguages
 	}
 	return nil
 }
 
-type StudentAssignment struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id            string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	AssignerId    string                        `protobuf:"bytes,4,opt,name=assigner_id,json=assignerId,proto3" json:"assigner_id,omitempty"`
-	AssignerName  string                        `protobuf:"bytes,5,opt,name=assigner_name,json=assignerName,proto3" json:"assigner_name,omitempty"`
-	UpdatedAt     string                        `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeadlineAt    string                        `protobuf:"bytes,7,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
-	Submission    *StudentAssignment_Submission `protobuf:"bytes,8,opt,name=submission,proto3" json:"submission,omitempty"`
-	HasSubmission bool                          `protobuf:"varint,9,opt,name=has_submission,json=hasSubmission,proto3" json:"has_submission,omitempty"`
-	CodeTemplate  string                        `protobuf:"bytes,10,opt,name=code_template,json=codeTemplate,proto3" json:"code_template,omitempty"`
+func (x *CreateAssignmentRequest) GetLimits() *AssignmentLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
 }
 
-func (x *StudentAssignment) Reset() {
-	*x = StudentAssignment{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateAssignmentRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
 	}
+	return 0
 }
 
-func (x *StudentAssignment) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateAssignmentRequest) GetFeedbackLevel() string {
+	if x != nil {
+		return x.FeedbackLevel
+	}
+	return ""
 }
 
-func (*StudentAssignment) ProtoMessage() {}
-
-func (x *StudentAssignment) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateAssignmentRequest) GetAttemptPolicy() *AssignmentAttemptPolicy {
+	if x != nil {
+		return x.AttemptPolicy
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StudentAssignment.ProtoReflect.Descriptor instead.
-func (*StudentAssignment) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{30}
+func (x *CreateAssignmentRequest) GetLatePolicy() *AssignmentLatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return nil
 }
 
-func (x *StudentAssignment) GetId() string {
+func (x *CreateAssignmentRequest) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
 	return ""
 }
 
-func (x *StudentAssignment) GetName() string {
+func (x *CreateAssignmentRequest) GetPublishAt() string {
 	if x != nil {
-		return x.Name
+		return x.PublishAt
 	}
 	return ""
 }
 
-func (x *StudentAssignment) GetDescription() string {
+func (x *CreateAssignmentRequest) GetCourseId() string {
 	if x != nil {
-		return x.Description
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *StudentAssignment) GetAssignerId() string {
+func (x *CreateAssignmentRequest) GetTestFramework() string {
 	if x != nil {
-		return x.AssignerId
+		return x.TestFramework
 	}
 	return ""
 }
 
-func (x *StudentAssignment) GetAssignerName() string {
+func (x *CreateAssignmentRequest) GetHarnessFiles() []*AssignmentHarnessFile {
 	if x != nil {
-		return x.AssignerName
+		return x.HarnessFiles
 	}
-	return ""
+	return nil
 }
 
-func (x *StudentAssignment) GetUpdatedAt() string {
+func (x *CreateAssignmentRequest) GetBuildCommand() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.BuildCommand
 	}
 	return ""
 }
 
-func (x *StudentAssignment) GetDeadlineAt() string {
-	if x != nil {
-		return x.DeadlineAt
-	}
-	return ""
-}
-
-func (x *StudentAssignment) GetSubmission() *StudentAssignment_Submission {
-	if x != nil {
-		return x.Submission
-	}
-	return nil
-}
-
-func (x *StudentAssignment) GetHasSubmission() bool {
-	if x != nil {
-		return x.HasSubmission
-	}
-	return false
-}
-
-func (x *StudentAssignment) GetCodeTemplate() string {
-	if x != nil {
-		return x.CodeTemplate
-	}
-	return ""
-}
-
-type StudentSubmission struct {
+type CreateSubmissionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AssignmentId           string `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	AssignmentName         string `protobuf:"bytes,3,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
-	AssignmentDescription  string `protobuf:"bytes,4,opt,name=assignment_description,json=assignmentDescription,proto3" json:"assignment_description,omitempty"`
-	AssignmentAssignerId   string `protobuf:"bytes,5,opt,name=assignment_assigner_id,json=assignmentAssignerId,proto3" json:"assignment_assigner_id,omitempty"`
-	AssignmentAssignerName string `protobuf:"bytes,6,opt,name=assignment_assigner_name,json=assignmentAssignerName,proto3" json:"assignment_assigner_name,omitempty"`
-	AssignmentDeadlineAt   string `protobuf:"bytes,7,opt,name=assignment_deadline_at,json=assignmentDeadlineAt,proto3" json:"assignment_deadline_at,omitempty"`
-	SubmissionCode         string `protobuf:"bytes,8,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
-	UpdatedAt              string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmitterId  string `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
+	SourceFileId string `protobuf:"bytes,3,opt,name=source_file_id,json=sourceFileId,proto3" json:"source_file_id,omitempty"`
 }
 
-func (x *StudentSubmission) Reset() {
-	*x = StudentSubmission{}
+func (x *CreateSubmissionRequest) Reset() {
+	*x = CreateSubmissionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[31]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StudentSubmission) String() string {
+func (x *CreateSubmissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentSubmission) ProtoMessage() {}
+func (*CreateSubmissionRequest) ProtoMessage() {}
 
-func (x *StudentSubmission) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[31]
+func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,100 +2179,121 @@ func (x *StudentSubmission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentSubmission.ProtoReflect.Descriptor instead.
-func (*StudentSubmission) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *StudentSubmission) GetId() string {
+func (x *CreateSubmissionRequest) GetAssignmentId() string {
 	if x != nil {
-		return x.Id
+		return x.AssignmentId
 	}
 	return ""
 }
 
-func (x *StudentSubmission) GetAssignmentId() string {
+func (x *CreateSubmissionRequest) GetSubmitterId() string {
 	if x != nil {
-		return x.AssignmentId
+		return x.SubmitterId
 	}
 	return ""
 }
 
-func (x *StudentSubmission) GetAssignmentName() string {
+func (x *CreateSubmissionRequest) GetSourceFileId() string {
 	if x != nil {
-		return x.AssignmentName
+		return x.SourceFileId
 	}
 	return ""
 }
 
-func (x *StudentSubmission) GetAssignmentDescription() string {
-	if x != nil {
-		return x.AssignmentDescription
-	}
-	return ""
+type UpdateSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmitterId  string `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
+	SourceFileId string `protobuf:"bytes,3,opt,name=source_file_id,json=sourceFileId,proto3" json:"source_file_id,omitempty"`
 }
 
-func (x *StudentSubmission) GetAssignmentAssignerId() string {
-	if x != nil {
-		return x.AssignmentAssignerId
+func (x *UpdateSubmissionRequest) Reset() {
+	*x = UpdateSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *StudentSubmission) GetAssignmentAssignerName() string {
-	if x != nil {
-		return x.AssignmentAssignerName
+func (x *UpdateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubmissionRequest) ProtoMessage() {}
+
+func (x *UpdateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StudentSubmission) GetAssignmentDeadlineAt() string {
+// Deprecated: Use UpdateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateSubmissionRequest) GetId() string {
 	if x != nil {
-		return x.AssignmentDeadlineAt
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StudentSubmission) GetSubmissionCode() string {
+func (x *UpdateSubmissionRequest) GetSubmitterId() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.SubmitterId
 	}
 	return ""
 }
 
-func (x *StudentSubmission) GetUpdatedAt() string {
+func (x *UpdateSubmissionRequest) GetSourceFileId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SourceFileId
 	}
 	return ""
 }
 
-type SubmitStudentSubmissionRequest struct {
+type LoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssignmentId   string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
-	SubmissionCode string `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *SubmitStudentSubmissionRequest) Reset() {
-	*x = SubmitStudentSubmissionRequest{}
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[32]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitStudentSubmissionRequest) String() string {
+func (x *LoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitStudentSubmissionRequest) ProtoMessage() {}
+func (*LoginRequest) ProtoMessage() {}
 
-func (x *SubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[32]
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2200,51 +2304,50 @@ func (x *SubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitStudentSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*SubmitStudentSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SubmitStudentSubmissionRequest) GetAssignmentId() string {
+func (x *LoginRequest) GetEmail() string {
 	if x != nil {
-		return x.AssignmentId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *SubmitStudentSubmissionRequest) GetSubmissionCode() string {
+func (x *LoginRequest) GetPassword() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.Password
 	}
 	return ""
 }
 
-type ResubmitStudentSubmissionRequest struct {
+type LoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionId   string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
-	SubmissionCode string `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *ResubmitStudentSubmissionRequest) Reset() {
-	*x = ResubmitStudentSubmissionRequest{}
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[33]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResubmitStudentSubmissionRequest) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResubmitStudentSubmissionRequest) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *ResubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[33]
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,53 +2358,53 @@ func (x *ResubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResubmitStudentSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*ResubmitStudentSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *ResubmitStudentSubmissionRequest) GetSubmissionId() string {
-	if x != nil {
-		return x.SubmissionId
-	}
-	return ""
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ResubmitStudentSubmissionRequest) GetSubmissionCode() string {
+func (x *LoginResponse) GetToken() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.Token
 	}
 	return ""
 }
 
-type ActivateManagedUserRequest struct {
+type AssignmentTestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId               string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ActivationToken      string `protobuf:"bytes,2,opt,name=activation_token,json=activationToken,proto3" json:"activation_token,omitempty"`
-	Password             string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordConfirmation string `protobuf:"bytes,4,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
-}
-
-func (x *ActivateManagedUserRequest) Reset() {
-	*x = ActivateManagedUserRequest{}
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId      string             `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Name              string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position          int32              `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Input             string             `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	ExpectedOutput    string             `protobuf:"bytes,6,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	Weight            int32              `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	IsHidden          bool               `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,9,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+	// cases of the same group are scored all or nothing
+	Group string `protobuf:"bytes,10,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *AssignmentTestCase) Reset() {
+	*x = AssignmentTestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[34]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateManagedUserRequest) String() string {
+func (x *AssignmentTestCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateManagedUserRequest) ProtoMessage() {}
+func (*AssignmentTestCase) ProtoMessage() {}
 
-func (x *ActivateManagedUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[34]
+func (x *AssignmentTestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2312,66 +2415,114 @@ func (x *ActivateManagedUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateManagedUserRequest.ProtoReflect.Descriptor instead.
-func (*ActivateManagedUserRequest) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AssignmentTestCase.ProtoReflect.Descriptor instead.
+func (*AssignmentTestCase) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ActivateManagedUserRequest) GetUserId() string {
+func (x *AssignmentTestCase) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ActivateManagedUserRequest) GetActivationToken() string {
+func (x *AssignmentTestCase) GetAssignmentId() string {
 	if x != nil {
-		return x.ActivationToken
+		return x.AssignmentId
 	}
 	return ""
 }
 
-func (x *ActivateManagedUserRequest) GetPassword() string {
+func (x *AssignmentTestCase) GetName() string {
 	if x != nil {
-		return x.Password
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ActivateManagedUserRequest) GetPasswordConfirmation() string {
+func (x *AssignmentTestCase) GetPosition() int32 {
 	if x != nil {
-		return x.PasswordConfirmation
+		return x.Position
 	}
-	return ""
+	return 0
 }
 
-type FindAllSubmissionsForAssignmentResponse_Submission struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubmitterId   string `protobuf:"bytes,4,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
-	SubmitterName string `protobuf:"bytes,5,opt,name=submitter_name,json=submitterName,proto3" json:"submitter_name,omitempty"`
+func (x *AssignmentTestCase) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) Reset() {
-	*x = FindAllSubmissionsForAssignmentResponse_Submission{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AssignmentTestCase) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
 	}
+	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) String() string {
+func (x *AssignmentTestCase) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AssignmentTestCase) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *AssignmentTestCase) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+func (x *AssignmentTestCase) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type CreateAssignmentTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position       int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Input          string `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExpectedOutput string `protobuf:"bytes,5,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	// defaults to 1, a zero weight case is not scored
+	Weight   *int32 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	IsHidden bool   `protobuf:"varint,7,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	Group    string `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CreateAssignmentTestCaseRequest) Reset() {
+	*x = CreateAssignmentTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssignmentTestCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAllSubmissionsForAssignmentResponse_Submission) ProtoMessage() {}
+func (*CreateAssignmentTestCaseRequest) ProtoMessage() {}
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[35]
+func (x *CreateAssignmentTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,61 +2533,100 @@ func (x *FindAllSubmissionsForAssignmentResponse_Submission) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAllSubmissionsForAssignmentResponse_Submission.ProtoReflect.Descriptor instead.
-func (*FindAllSubmissionsForAssignmentResponse_Submission) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use CreateAssignmentTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetId() string {
+func (x *CreateAssignmentTestCaseRequest) GetAssignmentId() string {
 	if x != nil {
-		return x.Id
+		return x.AssignmentId
 	}
 	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSubmitterId() string {
+func (x *CreateAssignmentTestCaseRequest) GetName() string {
 	if x != nil {
-		return x.SubmitterId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSubmitterName() string {
+func (x *CreateAssignmentTestCaseRequest) GetPosition() int32 {
 	if x != nil {
-		return x.SubmitterName
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CreateAssignmentTestCaseRequest) GetInput() string {
+	if x != nil {
+		return x.Input
 	}
 	return ""
 }
 
-type StudentAssignment_Submission struct {
+func (x *CreateAssignmentTestCaseRequest) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+func (x *CreateAssignmentTestCaseRequest) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *CreateAssignmentTestCaseRequest) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *CreateAssignmentTestCaseRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type UpdateAssignmentTestCaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubmissionCode string `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
-	Grade          int32  `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	UpdatedAt      string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	IsGraded       bool   `protobuf:"varint,5,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
-}
-
-func (x *StudentAssignment_Submission) Reset() {
-	*x = StudentAssignment_Submission{}
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position       int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Input          string `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExpectedOutput string `protobuf:"bytes,5,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	// defaults to 1, a zero weight case is not scored
+	Weight   *int32 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	IsHidden bool   `protobuf:"varint,7,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	Group    string `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *UpdateAssignmentTestCaseRequest) Reset() {
+	*x = UpdateAssignmentTestCaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_autograd_v1_autograd_proto_msgTypes[36]
+		mi := &file_autograd_v1_autograd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StudentAssignment_Submission) String() string {
+func (x *UpdateAssignmentTestCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentAssignment_Submission) ProtoMessage() {}
+func (*UpdateAssignmentTestCaseRequest) ProtoMessage() {}
 
-func (x *StudentAssignment_Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_autograd_v1_autograd_proto_msgTypes[36]
+func (x *UpdateAssignmentTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2447,683 +2637,7200 @@ func (x *StudentAssignment_Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentAssignment_Submission.ProtoReflect.Descriptor instead.
-func (*StudentAssignment_Submission) Descriptor() ([]byte, []int) {
-	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use UpdateAssignmentTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *StudentAssignment_Submission) GetId() string {
+func (x *UpdateAssignmentTestCaseRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *StudentAssignment_Submission) GetSubmissionCode() string {
+func (x *UpdateAssignmentTestCaseRequest) GetName() string {
 	if x != nil {
-		return x.SubmissionCode
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StudentAssignment_Submission) GetGrade() int32 {
+func (x *UpdateAssignmentTestCaseRequest) GetPosition() int32 {
 	if x != nil {
-		return x.Grade
+		return x.Position
 	}
 	return 0
 }
 
-func (x *StudentAssignment_Submission) GetUpdatedAt() string {
+func (x *UpdateAssignmentTestCaseRequest) GetInput() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Input
 	}
 	return ""
 }
 
-func (x *StudentAssignment_Submission) GetIsGraded() bool {
+func (x *UpdateAssignmentTestCaseRequest) GetExpectedOutput() string {
 	if x != nil {
-		return x.IsGraded
+		return x.ExpectedOutput
 	}
-	return false
+	return ""
 }
 
-var File_autograd_v1_autograd_proto protoreflect.FileDescriptor
+func (x *UpdateAssignmentTestCaseRequest) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
 
-var file_autograd_v1_autograd_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x46, 0x69, 0x6e,
-	0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x73, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x58, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
-	0x51, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4d, 0x0a,
-	0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f, 0x0a, 0x09,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a,
-	0x08, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9d, 0x03,
-	0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x08, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0d, 0x63, 0x61, 0x73,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x61,
-	0x73, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x41, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xc9, 0x02,
-	0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0f, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x17, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x63,
-	0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x61, 0x73, 0x65,
-	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
-	0x87, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x17, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x40, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x13,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaa,
-	0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x12,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x1a, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x26, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x27, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46,
-	0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x66, 0x0a, 0x0a, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb7, 0x01,
-	0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x04, 0x0a, 0x11, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61,
-	0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x1a, 0x97, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x11, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35,
-	0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a,
-	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x64, 0x0a, 0x0e, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x41,
-	0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x53, 0x53, 0x49,
-	0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x50, 0x55,
-	0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x02, 0x32,
-	0xc2, 0x0d, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x19, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
-	0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46,
-	0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x10, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x7c, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x57, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x40, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x9e, 0x03, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x00, 0x12, 0x67, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x46, 0x69,
-	0x6e, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
-	0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f,
-	0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x68, 0x6d, 0x69, 0x66, 0x61, 0x6e, 0x2f, 0x61, 0x75, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
-	0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *UpdateAssignmentTestCaseRequest) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
 }
 
-var (
-	file_autograd_v1_autograd_proto_rawDescOnce sync.Once
-	file_autograd_v1_autograd_proto_rawDescData = file_autograd_v1_autograd_proto_rawDesc
-)
+func (x *UpdateAssignmentTestCaseRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
 
-func file_autograd_v1_autograd_proto_rawDescGZIP() []byte {
-	file_autograd_v1_autograd_proto_rawDescOnce.Do(func() {
-		file_autograd_v1_autograd_proto_rawDescData = protoimpl.X.CompressGZIP(file_autograd_v1_autograd_proto_rawDescData)
-	})
-	return file_autograd_v1_autograd_proto_rawDescData
+type FindAllAssignmentTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
 }
 
-var file_autograd_v1_autograd_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_autograd_v1_autograd_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_autograd_v1_autograd_proto_goTypes = []interface{}{
-	(AssignmentType)(0),                                        // 0: autograd.v1.AssignmentType
-	(*Empty)(nil),                                              // 1: autograd.v1.Empty
-	(*CreatedResponse)(nil),                                    // 2: autograd.v1.CreatedResponse
-	(*PingResponse)(nil),                                       // 3: autograd.v1.PingResponse
-	(*FindByIDRequest)(nil),                                    // 4: autograd.v1.FindByIDRequest
-	(*DeleteByIDRequest)(nil),                                  // 5: autograd.v1.DeleteByIDRequest
-	(*PaginationMetadata)(nil),                                 // 6: autograd.v1.PaginationMetadata
-	(*PaginationRequest)(nil),                                  // 7: autograd.v1.PaginationRequest
-	(*CreateManagedUserRequest)(nil),                           // 8: autograd.v1.CreateManagedUserRequest
-	(*TimestampMetadata)(nil),                                  // 9: autograd.v1.TimestampMetadata
-	(*AssignmentFile)(nil),                                     // 10: autograd.v1.AssignmentFile
-	(*SubmissionFile)(nil),                                     // 11: autograd.v1.SubmissionFile
-	(*Submitter)(nil),                                          // 12: autograd.v1.Submitter
-	(*Assigner)(nil),                                           // 13: autograd.v1.Assigner
-	(*Assignment)(nil),                                         // 14: autograd.v1.Assignment
-	(*Submission)(nil),                                         // 15: autograd.v1.Submission
-	(*UpdateAssignmentRequest)(nil),                            // 16: autograd.v1.UpdateAssignmentRequest
-	(*CreateAssignmentRequest)(nil),                            // 17: autograd.v1.CreateAssignmentRequest
-	(*CreateSubmissionRequest)(nil),                            // 18: autograd.v1.CreateSubmissionRequest
-	(*UpdateSubmissionRequest)(nil),                            // 19: autograd.v1.UpdateSubmissionRequest
-	(*LoginRequest)(nil),                                       // 20: autograd.v1.LoginRequest
-	(*LoginResponse)(nil),                                      // 21: autograd.v1.LoginResponse
-	(*FindAllAssignmentsRequest)(nil),                          // 22: autograd.v1.FindAllAssignmentsRequest
-	(*FindAllAssignmentsResponse)(nil),                         // 23: autograd.v1.FindAllAssignmentsResponse
-	(*ManagedUser)(nil),                                        // 24: autograd.v1.ManagedUser
-	(*FindAllManagedUsersRequest)(nil),                         // 25: autograd.v1.FindAllManagedUsersRequest
-	(*FindAllManagedUsersResponse)(nil),                        // 26: autograd.v1.FindAllManagedUsersResponse
-	(*FindAllSubmissionsForAssignmentRequest)(nil),             // 27: autograd.v1.FindAllSubmissionsForAssignmentRequest
-	(*FindAllSubmissionsForAssignmentResponse)(nil),            // 28: autograd.v1.FindAllSubmissionsForAssignmentResponse
-	(*FindAllStudentAssignmentsRequest)(nil),                   // 29: autograd.v1.FindAllStudentAssignmentsRequest
-	(*FindAllStudentAssignmentsResponse)(nil),                  // 30: autograd.v1.FindAllStudentAssignmentsResponse
-	(*StudentAssignment)(nil),                                  // 31: autograd.v1.StudentAssignment
-	(*StudentSubmission)(nil),                                  // 32: autograd.v1.StudentSubmission
-	(*SubmitStudentSubmissionRequest)(nil),                     // 33: autograd.v1.SubmitStudentSubmissionRequest
-	(*ResubmitStudentSubmissionRequest)(nil),                   // 34: autograd.v1.ResubmitStudentSubmissionRequest
-	(*ActivateManagedUserRequest)(nil),                         // 35: autograd.v1.ActivateManagedUserRequest
-	(*FindAllSubmissionsForAssignmentResponse_Submission)(nil), // 36: autograd.v1.FindAllSubmissionsForAssignmentResponse.Submission
-	(*StudentAssignment_Submission)(nil),                       // 37: autograd.v1.StudentAssignment.Submission
+func (x *FindAllAssignmentTestCasesRequest) Reset() {
+	*x = FindAllAssignmentTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_autograd_v1_autograd_proto_depIdxs = []int32{
-	9,  // 0: autograd.v1.AssignmentFile.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
-	9,  // 1: autograd.v1.SubmissionFile.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
-	13, // 2: autograd.v1.Assignment.assigner:type_name -> autograd.v1.Assigner
-	10, // 3: autograd.v1.Assignment.case_input_file:type_name -> autograd.v1.AssignmentFile
-	10, // 4: autograd.v1.Assignment.case_output_file:type_name -> autograd.v1.AssignmentFile
-	9,  // 5: autograd.v1.Assignment.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
-	14, // 6: autograd.v1.Submission.assignment:type_name -> autograd.v1.Assignment
-	12, // 7: autograd.v1.Submission.submitter:type_name -> autograd.v1.Submitter
-	11, // 8: autograd.v1.Submission.submission_file:type_name -> autograd.v1.SubmissionFile
-	9,  // 9: autograd.v1.Submission.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
-	7,  // 10: autograd.v1.FindAllAssignmentsRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
-	14, // 11: autograd.v1.FindAllAssignmentsResponse.assignments:type_name -> autograd.v1.Assignment
-	6,  // 12: autograd.v1.FindAllAssignmentsResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
-	9,  // 13: autograd.v1.ManagedUser.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
-	7,  // 14: autograd.v1.FindAllManagedUsersRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
-	24, // 15: autograd.v1.FindAllManagedUsersResponse.managed_users:type_name -> autograd.v1.ManagedUser
-	6,  // 16: autograd.v1.FindAllManagedUsersResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
-	7,  // 17: autograd.v1.FindAllSubmissionsForAssignmentRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
-	36, // 18: autograd.v1.FindAllSubmissionsForAssignmentResponse.submissions:type_name -> autograd.v1.FindAllSubmissionsForAssignmentResponse.Submission
-	7,  // 19: autograd.v1.FindAllStudentAssignmentsRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
-	31, // 20: autograd.v1.FindAllStudentAssignmentsResponse.assignments:type_name -> autograd.v1.StudentAssignment
-	6,  // 21: autograd.v1.FindAllStudentAssignmentsResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
-	37, // 22: autograd.v1.StudentAssignment.submission:type_name -> autograd.v1.StudentAssignment.Submission
-	1,  // 23: autograd.v1.AutogradService.Ping:input_type -> autograd.v1.Empty
-	8,  // 24: autograd.v1.AutogradService.CreateManagedUser:input_type -> autograd.v1.CreateManagedUserRequest
-	35, // 25: autograd.v1.AutogradService.ActivateManagedUser:input_type -> autograd.v1.ActivateManagedUserRequest
-	25, // 26: autograd.v1.AutogradService.FindAllManagedUsers:input_type -> autograd.v1.FindAllManagedUsersRequest
-	4,  // 27: autograd.v1.AutogradService.FindAssignment:input_type -> autograd.v1.FindByIDRequest
-	22, // 28: autograd.v1.AutogradService.FindAllAssignments:input_type -> autograd.v1.FindAllAssignmentsRequest
-	4,  // 29: autograd.v1.AutogradService.FindSubmission:input_type -> autograd.v1.FindByIDRequest
-	27, // 30: autograd.v1.AutogradService.FindAllSubmissionForAssignment:input_type -> autograd.v1.FindAllSubmissionsForAssignmentRequest
-	17, // 31: autograd.v1.AutogradService.CreateAssignment:input_type -> autograd.v1.CreateAssignmentRequest
-	16, // 32: autograd.v1.AutogradService.UpdateAssignment:input_type -> autograd.v1.UpdateAssignmentRequest
-	5,  // 33: autograd.v1.AutogradService.DeleteAssignment:input_type -> autograd.v1.DeleteByIDRequest
-	18, // 34: autograd.v1.AutogradService.CreateSubmission:input_type -> autograd.v1.CreateSubmissionRequest
-	19, // 35: autograd.v1.AutogradService.UpdateSubmission:input_type -> autograd.v1.UpdateSubmissionRequest
-	5,  // 36: autograd.v1.AutogradService.DeleteSubmission:input_type -> autograd.v1.DeleteByIDRequest
-	29, // 37: autograd.v1.AutogradService.FindAllStudentAssignments:input_type -> autograd.v1.FindAllStudentAssignmentsRequest
-	4,  // 38: autograd.v1.AutogradService.FindStudentAssignment:input_type -> autograd.v1.FindByIDRequest
-	33, // 39: autograd.v1.AutogradService.SubmitStudentSubmission:input_type -> autograd.v1.SubmitStudentSubmissionRequest
-	34, // 40: autograd.v1.AutogradService.ResubmitStudentSubmission:input_type -> autograd.v1.ResubmitStudentSubmissionRequest
-	20, // 41: autograd.v1.AutogradService.Login:input_type -> autograd.v1.LoginRequest
-	4,  // 42: autograd.v1.AutogradQuery.FindAssignment:input_type -> autograd.v1.FindByIDRequest
-	22, // 43: autograd.v1.AutogradQuery.FindAllAssignments:input_type -> autograd.v1.FindAllAssignmentsRequest
-	4,  // 44: autograd.v1.AutogradQuery.FindSubmission:input_type -> autograd.v1.FindByIDRequest
-	27, // 45: autograd.v1.AutogradQuery.FindAllSubmissionForAssignment:input_type -> autograd.v1.FindAllSubmissionsForAssignmentRequest
-	3,  // 46: autograd.v1.AutogradService.Ping:output_type -> autograd.v1.PingResponse
-	2,  // 47: autograd.v1.AutogradService.CreateManagedUser:output_type -> autograd.v1.CreatedResponse
-	1,  // 48: autograd.v1.AutogradService.ActivateManagedUser:output_type -> autograd.v1.Empty
-	26, // 49: autograd.v1.AutogradService.FindAllManagedUsers:output_type -> autograd.v1.FindAllManagedUsersResponse
-	14, // 50: autograd.v1.AutogradService.FindAssignment:output_type -> autograd.v1.Assignment
-	23, // 51: autograd.v1.AutogradService.FindAllAssignments:output_type -> autograd.v1.FindAllAssignmentsResponse
-	15, // 52: autograd.v1.AutogradService.FindSubmission:output_type -> autograd.v1.Submission
-	28, // 53: autograd.v1.AutogradService.FindAllSubmissionForAssignment:output_type -> autograd.v1.FindAllSubmissionsForAssignmentResponse
-	2,  // 54: autograd.v1.AutogradService.CreateAssignment:output_type -> autograd.v1.CreatedResponse
-	1,  // 55: autograd.v1.AutogradService.UpdateAssignment:output_type -> autograd.v1.Empty
-	1,  // 56: autograd.v1.AutogradService.DeleteAssignment:output_type -> autograd.v1.Empty
-	2,  // 57: autograd.v1.AutogradService.CreateSubmission:output_type -> autograd.v1.CreatedResponse
-	1,  // 58: autograd.v1.AutogradService.UpdateSubmission:output_type -> autograd.v1.Empty
-	1,  // 59: autograd.v1.AutogradService.DeleteSubmission:output_type -> autograd.v1.Empty
-	30, // 60: autograd.v1.AutogradService.FindAllStudentAssignments:output_type -> autograd.v1.FindAllStudentAssignmentsResponse
-	31, // 61: autograd.v1.AutogradService.FindStudentAssignment:output_type -> autograd.v1.StudentAssignment
-	2,  // 62: autograd.v1.AutogradService.SubmitStudentSubmission:output_type -> autograd.v1.CreatedResponse
-	1,  // 63: autograd.v1.AutogradService.ResubmitStudentSubmission:output_type -> autograd.v1.Empty
-	21, // 64: autograd.v1.AutogradService.Login:output_type -> autograd.v1.LoginResponse
-	14, // 65: autograd.v1.AutogradQuery.FindAssignment:output_type -> autograd.v1.Assignment
-	23, // 66: autograd.v1.AutogradQuery.FindAllAssignments:output_type -> autograd.v1.FindAllAssignmentsResponse
-	15, // 67: autograd.v1.AutogradQuery.FindSubmission:output_type -> autograd.v1.Submission
-	28, // 68: autograd.v1.AutogradQuery.FindAllSubmissionForAssignment:output_type -> autograd.v1.FindAllSubmissionsForAssignmentResponse
-	46, // [46:69] is the sub-list for method output_type
-	23, // [23:46] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+func (x *FindAllAssignmentTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_autograd_v1_autograd_proto_init() }
-func file_autograd_v1_autograd_proto_init() {
-	if File_autograd_v1_autograd_proto != nil {
-		return
+func (*FindAllAssignmentTestCasesRequest) ProtoMessage() {}
+
+func (x *FindAllAssignmentTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_autograd_v1_autograd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FindAllAssignmentTestCasesRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type FindAllAssignmentTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCases []*AssignmentTestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+}
+
+func (x *FindAllAssignmentTestCasesResponse) Reset() {
+	*x = FindAllAssignmentTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentTestCasesResponse) ProtoMessage() {}
+
+func (x *FindAllAssignmentTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FindAllAssignmentTestCasesResponse) GetTestCases() []*AssignmentTestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+// AssignmentExtension replaces the assignment deadline for a student
+type AssignmentExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId      string             `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId         string             `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentName       string             `protobuf:"bytes,4,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	DeadlineAt        string             `protobuf:"bytes,5,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Reason            string             `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedById       string             `protobuf:"bytes,7,opt,name=granted_by_id,json=grantedById,proto3" json:"granted_by_id,omitempty"`
+	GrantedByName     string             `protobuf:"bytes,8,opt,name=granted_by_name,json=grantedByName,proto3" json:"granted_by_name,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,9,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *AssignmentExtension) Reset() {
+	*x = AssignmentExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentExtension) ProtoMessage() {}
+
+func (x *AssignmentExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentExtension.ProtoReflect.Descriptor instead.
+func (*AssignmentExtension) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AssignmentExtension) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetGrantedById() string {
+	if x != nil {
+		return x.GrantedById
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetGrantedByName() string {
+	if x != nil {
+		return x.GrantedByName
+	}
+	return ""
+}
+
+func (x *AssignmentExtension) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type CreateAssignmentExtensionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId    string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// deadline_at is RFC3339, it must be after the assignment deadline
+	DeadlineAt string `protobuf:"bytes,3,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CreateAssignmentExtensionRequest) Reset() {
+	*x = CreateAssignmentExtensionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssignmentExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentExtensionRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentExtensionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateAssignmentExtensionRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentExtensionRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentExtensionRequest) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return ""
+}
+
+func (x *CreateAssignmentExtensionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateAssignmentExtensionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeadlineAt string `protobuf:"bytes,2,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateAssignmentExtensionRequest) Reset() {
+	*x = UpdateAssignmentExtensionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssignmentExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentExtensionRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentExtensionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateAssignmentExtensionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentExtensionRequest) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentExtensionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FindAllAssignmentExtensionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *FindAllAssignmentExtensionsRequest) Reset() {
+	*x = FindAllAssignmentExtensionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentExtensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentExtensionsRequest) ProtoMessage() {}
+
+func (x *FindAllAssignmentExtensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentExtensionsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentExtensionsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FindAllAssignmentExtensionsRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type FindAllAssignmentExtensionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extensions []*AssignmentExtension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+}
+
+func (x *FindAllAssignmentExtensionsResponse) Reset() {
+	*x = FindAllAssignmentExtensionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentExtensionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentExtensionsResponse) ProtoMessage() {}
+
+func (x *FindAllAssignmentExtensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentExtensionsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentExtensionsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FindAllAssignmentExtensionsResponse) GetExtensions() []*AssignmentExtension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// AssignmentRubricCriterion is scored manually by a grader, e.g. code style
+type AssignmentRubricCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId      string             `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Name              string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	MaxPoints         int32              `protobuf:"varint,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Position          int32              `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,7,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *AssignmentRubricCriterion) Reset() {
+	*x = AssignmentRubricCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentRubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRubricCriterion) ProtoMessage() {}
+
+func (x *AssignmentRubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRubricCriterion.ProtoReflect.Descriptor instead.
+func (*AssignmentRubricCriterion) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssignmentRubricCriterion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignmentRubricCriterion) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentRubricCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignmentRubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AssignmentRubricCriterion) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *AssignmentRubricCriterion) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *AssignmentRubricCriterion) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type CreateAssignmentRubricCriterionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxPoints    int32  `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Position     int32  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) Reset() {
+	*x = CreateAssignmentRubricCriterionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRubricCriterionRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRubricCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRubricCriterionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRubricCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRubricCriterionRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type UpdateAssignmentRubricCriterionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxPoints   int32  `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Position    int32  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) Reset() {
+	*x = UpdateAssignmentRubricCriterionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRubricCriterionRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRubricCriterionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRubricCriterionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRubricCriterionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRubricCriterionRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type FindAllAssignmentRubricCriteriaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *FindAllAssignmentRubricCriteriaRequest) Reset() {
+	*x = FindAllAssignmentRubricCriteriaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentRubricCriteriaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentRubricCriteriaRequest) ProtoMessage() {}
+
+func (x *FindAllAssignmentRubricCriteriaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentRubricCriteriaRequest.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentRubricCriteriaRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FindAllAssignmentRubricCriteriaRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type FindAllAssignmentRubricCriteriaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Criteria []*AssignmentRubricCriterion `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
+}
+
+func (x *FindAllAssignmentRubricCriteriaResponse) Reset() {
+	*x = FindAllAssignmentRubricCriteriaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentRubricCriteriaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentRubricCriteriaResponse) ProtoMessage() {}
+
+func (x *FindAllAssignmentRubricCriteriaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentRubricCriteriaResponse.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentRubricCriteriaResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FindAllAssignmentRubricCriteriaResponse) GetCriteria() []*AssignmentRubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type SubmissionRubricScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CriterionId  string `protobuf:"bytes,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	Points       int32  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	Comment      string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	GradedById   string `protobuf:"bytes,4,opt,name=graded_by_id,json=gradedById,proto3" json:"graded_by_id,omitempty"`
+	GradedByName string `protobuf:"bytes,5,opt,name=graded_by_name,json=gradedByName,proto3" json:"graded_by_name,omitempty"`
+	GradedAt     string `protobuf:"bytes,6,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+}
+
+func (x *SubmissionRubricScore) Reset() {
+	*x = SubmissionRubricScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionRubricScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionRubricScore) ProtoMessage() {}
+
+func (x *SubmissionRubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionRubricScore.ProtoReflect.Descriptor instead.
+func (*SubmissionRubricScore) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SubmissionRubricScore) GetCriterionId() string {
+	if x != nil {
+		return x.CriterionId
+	}
+	return ""
+}
+
+func (x *SubmissionRubricScore) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SubmissionRubricScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SubmissionRubricScore) GetGradedById() string {
+	if x != nil {
+		return x.GradedById
+	}
+	return ""
+}
+
+func (x *SubmissionRubricScore) GetGradedByName() string {
+	if x != nil {
+		return x.GradedByName
+	}
+	return ""
+}
+
+func (x *SubmissionRubricScore) GetGradedAt() string {
+	if x != nil {
+		return x.GradedAt
+	}
+	return ""
+}
+
+type GradeSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	// rubric_scores replace the scores of their criteria, the other scores are kept
+	RubricScores []*GradeSubmissionRequest_RubricScore `protobuf:"bytes,2,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	IsOverridden bool                                  `protobuf:"varint,3,opt,name=is_overridden,json=isOverridden,proto3" json:"is_overridden,omitempty"`
+	// override_grade replaces the combined grade when is_overridden
+	OverrideGrade int32 `protobuf:"varint,4,opt,name=override_grade,json=overrideGrade,proto3" json:"override_grade,omitempty"`
+	// reason is required to override the grade
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Feedback string `protobuf:"bytes,6,opt,name=feedback,proto3" json:"feedback,omitempty"`
+}
+
+func (x *GradeSubmissionRequest) Reset() {
+	*x = GradeSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeSubmissionRequest) ProtoMessage() {}
+
+func (x *GradeSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GradeSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GradeSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *GradeSubmissionRequest) GetRubricScores() []*GradeSubmissionRequest_RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *GradeSubmissionRequest) GetIsOverridden() bool {
+	if x != nil {
+		return x.IsOverridden
+	}
+	return false
+}
+
+func (x *GradeSubmissionRequest) GetOverrideGrade() int32 {
+	if x != nil {
+		return x.OverrideGrade
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GradeSubmissionRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+// SubmissionGradeAudit is appended on every manual grading of a submission
+type SubmissionGradeAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmissionId      string             `protobuf:"bytes,2,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	GradedById        string             `protobuf:"bytes,3,opt,name=graded_by_id,json=gradedById,proto3" json:"graded_by_id,omitempty"`
+	GradedByName      string             `protobuf:"bytes,4,opt,name=graded_by_name,json=gradedByName,proto3" json:"graded_by_name,omitempty"`
+	PreviousGrade     int32              `protobuf:"varint,5,opt,name=previous_grade,json=previousGrade,proto3" json:"previous_grade,omitempty"`
+	PreviousMaxScore  int32              `protobuf:"varint,6,opt,name=previous_max_score,json=previousMaxScore,proto3" json:"previous_max_score,omitempty"`
+	Grade             int32              `protobuf:"varint,7,opt,name=grade,proto3" json:"grade,omitempty"`
+	MaxScore          int32              `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AutoGrade         int32              `protobuf:"varint,9,opt,name=auto_grade,json=autoGrade,proto3" json:"auto_grade,omitempty"`
+	RubricPoints      int32              `protobuf:"varint,10,opt,name=rubric_points,json=rubricPoints,proto3" json:"rubric_points,omitempty"`
+	IsOverridden      bool               `protobuf:"varint,11,opt,name=is_overridden,json=isOverridden,proto3" json:"is_overridden,omitempty"`
+	Reason            string             `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,13,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *SubmissionGradeAudit) Reset() {
+	*x = SubmissionGradeAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionGradeAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionGradeAudit) ProtoMessage() {}
+
+func (x *SubmissionGradeAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionGradeAudit.ProtoReflect.Descriptor instead.
+func (*SubmissionGradeAudit) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SubmissionGradeAudit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmissionGradeAudit) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *SubmissionGradeAudit) GetGradedById() string {
+	if x != nil {
+		return x.GradedById
+	}
+	return ""
+}
+
+func (x *SubmissionGradeAudit) GetGradedByName() string {
+	if x != nil {
+		return x.GradedByName
+	}
+	return ""
+}
+
+func (x *SubmissionGradeAudit) GetPreviousGrade() int32 {
+	if x != nil {
+		return x.PreviousGrade
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetPreviousMaxScore() int32 {
+	if x != nil {
+		return x.PreviousMaxScore
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetAutoGrade() int32 {
+	if x != nil {
+		return x.AutoGrade
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetRubricPoints() int32 {
+	if x != nil {
+		return x.RubricPoints
+	}
+	return 0
+}
+
+func (x *SubmissionGradeAudit) GetIsOverridden() bool {
+	if x != nil {
+		return x.IsOverridden
+	}
+	return false
+}
+
+func (x *SubmissionGradeAudit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubmissionGradeAudit) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type FindAllSubmissionGradeAuditsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+}
+
+func (x *FindAllSubmissionGradeAuditsRequest) Reset() {
+	*x = FindAllSubmissionGradeAuditsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionGradeAuditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionGradeAuditsRequest) ProtoMessage() {}
+
+func (x *FindAllSubmissionGradeAuditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionGradeAuditsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionGradeAuditsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FindAllSubmissionGradeAuditsRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+type FindAllSubmissionGradeAuditsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audits []*SubmissionGradeAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"`
+}
+
+func (x *FindAllSubmissionGradeAuditsResponse) Reset() {
+	*x = FindAllSubmissionGradeAuditsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionGradeAuditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionGradeAuditsResponse) ProtoMessage() {}
+
+func (x *FindAllSubmissionGradeAuditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionGradeAuditsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionGradeAuditsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FindAllSubmissionGradeAuditsResponse) GetAudits() []*SubmissionGradeAudit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type RegradeAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *RegradeAssignmentRequest) Reset() {
+	*x = RegradeAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeAssignmentRequest) ProtoMessage() {}
+
+func (x *RegradeAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*RegradeAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RegradeAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type RegradeAssignmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// test_version is the bumped test version of the assignment
+	TestVersion int32 `protobuf:"varint,1,opt,name=test_version,json=testVersion,proto3" json:"test_version,omitempty"`
+	// submission_count is the number of the submissions enqueued for regrading
+	SubmissionCount int32 `protobuf:"varint,2,opt,name=submission_count,json=submissionCount,proto3" json:"submission_count,omitempty"`
+}
+
+func (x *RegradeAssignmentResponse) Reset() {
+	*x = RegradeAssignmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeAssignmentResponse) ProtoMessage() {}
+
+func (x *RegradeAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*RegradeAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RegradeAssignmentResponse) GetTestVersion() int32 {
+	if x != nil {
+		return x.TestVersion
+	}
+	return 0
+}
+
+func (x *RegradeAssignmentResponse) GetSubmissionCount() int32 {
+	if x != nil {
+		return x.SubmissionCount
+	}
+	return 0
+}
+
+type CheckAssignmentPlagiarismRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *CheckAssignmentPlagiarismRequest) Reset() {
+	*x = CheckAssignmentPlagiarismRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAssignmentPlagiarismRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAssignmentPlagiarismRequest) ProtoMessage() {}
+
+func (x *CheckAssignmentPlagiarismRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAssignmentPlagiarismRequest.ProtoReflect.Descriptor instead.
+func (*CheckAssignmentPlagiarismRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CheckAssignmentPlagiarismRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+// SubmissionSimilarity is a pair of submissions sharing source code
+type SubmissionSimilarity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	First  *SubmissionSimilarity_Source `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Second *SubmissionSimilarity_Source `protobuf:"bytes,3,opt,name=second,proto3" json:"second,omitempty"`
+	// score is the percentage of the shared fingerprints
+	Score             int32                          `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Regions           []*SubmissionSimilarity_Region `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty"`
+	TimestampMetadata *TimestampMetadata             `protobuf:"bytes,6,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *SubmissionSimilarity) Reset() {
+	*x = SubmissionSimilarity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionSimilarity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionSimilarity) ProtoMessage() {}
+
+func (x *SubmissionSimilarity) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionSimilarity.ProtoReflect.Descriptor instead.
+func (*SubmissionSimilarity) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SubmissionSimilarity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmissionSimilarity) GetFirst() *SubmissionSimilarity_Source {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *SubmissionSimilarity) GetSecond() *SubmissionSimilarity_Source {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *SubmissionSimilarity) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmissionSimilarity) GetRegions() []*SubmissionSimilarity_Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *SubmissionSimilarity) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type FindAllSubmissionSimilaritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	// min_score is the score a pair is suspicious from, zero means 50
+	MinScore int32 `protobuf:"varint,2,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+}
+
+func (x *FindAllSubmissionSimilaritiesRequest) Reset() {
+	*x = FindAllSubmissionSimilaritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionSimilaritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionSimilaritiesRequest) ProtoMessage() {}
+
+func (x *FindAllSubmissionSimilaritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionSimilaritiesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionSimilaritiesRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FindAllSubmissionSimilaritiesRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionSimilaritiesRequest) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+type FindAllSubmissionSimilaritiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Similarities []*SubmissionSimilarity `protobuf:"bytes,1,rep,name=similarities,proto3" json:"similarities,omitempty"`
+}
+
+func (x *FindAllSubmissionSimilaritiesResponse) Reset() {
+	*x = FindAllSubmissionSimilaritiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionSimilaritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionSimilaritiesResponse) ProtoMessage() {}
+
+func (x *FindAllSubmissionSimilaritiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionSimilaritiesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionSimilaritiesResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FindAllSubmissionSimilaritiesResponse) GetSimilarities() []*SubmissionSimilarity {
+	if x != nil {
+		return x.Similarities
+	}
+	return nil
+}
+
+type Term struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartAt           string             `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt             string             `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,5,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Term) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Term) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *Term) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type CreateTermRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartAt string `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt   string `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+}
+
+func (x *CreateTermRequest) Reset() {
+	*x = CreateTermRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTermRequest) ProtoMessage() {}
+
+func (x *CreateTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTermRequest.ProtoReflect.Descriptor instead.
+func (*CreateTermRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateTermRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTermRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *CreateTermRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+type FindAllTermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAllTermsRequest) Reset() {
+	*x = FindAllTermsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllTermsRequest) ProtoMessage() {}
+
+func (x *FindAllTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllTermsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllTermsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{57}
+}
+
+type FindAllTermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms []*Term `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *FindAllTermsResponse) Reset() {
+	*x = FindAllTermsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllTermsResponse) ProtoMessage() {}
+
+func (x *FindAllTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllTermsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllTermsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FindAllTermsResponse) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type Course struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term              *Term              `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Code              string             `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string             `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string             `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Sections          []*CourseSection   `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,7,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Course) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Course) GetTerm() *Term {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+func (x *Course) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Course) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Course) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Course) GetSections() []*CourseSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Course) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type CourseSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId          string             `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name              string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,4,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *CourseSection) Reset() {
+	*x = CourseSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSection) ProtoMessage() {}
+
+func (x *CourseSection) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSection.ProtoReflect.Descriptor instead.
+func (*CourseSection) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CourseSection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseSection) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseSection) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type CreateCourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermId      string `protobuf:"bytes,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseRequest) ProtoMessage() {}
+
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateCourseRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateCourseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpdateCourseRequest) Reset() {
+	*x = UpdateCourseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCourseRequest) ProtoMessage() {}
+
+func (x *UpdateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCourseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateCourseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FindAllCoursesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+	// optional
+	TermId string `protobuf:"bytes,2,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+}
+
+func (x *FindAllCoursesRequest) Reset() {
+	*x = FindAllCoursesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllCoursesRequest) ProtoMessage() {}
+
+func (x *FindAllCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllCoursesRequest.ProtoReflect.Descriptor instead.
+func (*FindAllCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FindAllCoursesRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+func (x *FindAllCoursesRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+type FindAllCoursesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Courses            []*Course           `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+}
+
+func (x *FindAllCoursesResponse) Reset() {
+	*x = FindAllCoursesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllCoursesResponse) ProtoMessage() {}
+
+func (x *FindAllCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllCoursesResponse.ProtoReflect.Descriptor instead.
+func (*FindAllCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FindAllCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *FindAllCoursesResponse) GetPaginationMetadata() *PaginationMetadata {
+	if x != nil {
+		return x.PaginationMetadata
+	}
+	return nil
+}
+
+type CreateCourseSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId string `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateCourseSectionRequest) Reset() {
+	*x = CreateCourseSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCourseSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseSectionRequest) ProtoMessage() {}
+
+func (x *CreateCourseSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseSectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseSectionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateCourseSectionRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CreateCourseSectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CourseEnrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId  string         `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Section   *CourseSection `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	UserId    string         `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName  string         `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail string         `protobuf:"bytes,6,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	// one of student, instructor, ta
+	Role              string             `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,8,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *CourseEnrollment) Reset() {
+	*x = CourseEnrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CourseEnrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseEnrollment) ProtoMessage() {}
+
+func (x *CourseEnrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseEnrollment.ProtoReflect.Descriptor instead.
+func (*CourseEnrollment) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CourseEnrollment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetSection() *CourseSection {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *CourseEnrollment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CourseEnrollment) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type EnrollCourseMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId  string `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	SectionId string `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// one of student, instructor, ta
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *EnrollCourseMemberRequest) Reset() {
+	*x = EnrollCourseMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollCourseMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollCourseMemberRequest) ProtoMessage() {}
+
+func (x *EnrollCourseMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollCourseMemberRequest.ProtoReflect.Descriptor instead.
+func (*EnrollCourseMemberRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EnrollCourseMemberRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *EnrollCourseMemberRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *EnrollCourseMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnrollCourseMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type FindAllCourseEnrollmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+	CourseId          string             `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// optional
+	SectionId string `protobuf:"bytes,3,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// optional, one of student, instructor, ta
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *FindAllCourseEnrollmentsRequest) Reset() {
+	*x = FindAllCourseEnrollmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllCourseEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllCourseEnrollmentsRequest) ProtoMessage() {}
+
+func (x *FindAllCourseEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllCourseEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllCourseEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FindAllCourseEnrollmentsRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+func (x *FindAllCourseEnrollmentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *FindAllCourseEnrollmentsRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *FindAllCourseEnrollmentsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type FindAllCourseEnrollmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollments        []*CourseEnrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+}
+
+func (x *FindAllCourseEnrollmentsResponse) Reset() {
+	*x = FindAllCourseEnrollmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllCourseEnrollmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllCourseEnrollmentsResponse) ProtoMessage() {}
+
+func (x *FindAllCourseEnrollmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllCourseEnrollmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllCourseEnrollmentsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FindAllCourseEnrollmentsResponse) GetEnrollments() []*CourseEnrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *FindAllCourseEnrollmentsResponse) GetPaginationMetadata() *PaginationMetadata {
+	if x != nil {
+		return x.PaginationMetadata
+	}
+	return nil
+}
+
+type FindAllAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+	// optional
+	CourseId string `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+}
+
+func (x *FindAllAssignmentsRequest) Reset() {
+	*x = FindAllAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentsRequest) ProtoMessage() {}
+
+func (x *FindAllAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FindAllAssignmentsRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+func (x *FindAllAssignmentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+type FindAllAssignmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments        []*Assignment       `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+}
+
+func (x *FindAllAssignmentsResponse) Reset() {
+	*x = FindAllAssignmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllAssignmentsResponse) ProtoMessage() {}
+
+func (x *FindAllAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FindAllAssignmentsResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *FindAllAssignmentsResponse) GetPaginationMetadata() *PaginationMetadata {
+	if x != nil {
+		return x.PaginationMetadata
+	}
+	return nil
+}
+
+type ManagedUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email             string             `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role              string             `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	TimestampMetadata *TimestampMetadata `protobuf:"bytes,5,opt,name=timestamp_metadata,json=timestampMetadata,proto3" json:"timestamp_metadata,omitempty"`
+}
+
+func (x *ManagedUser) Reset() {
+	*x = ManagedUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManagedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedUser) ProtoMessage() {}
+
+func (x *ManagedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagedUser.ProtoReflect.Descriptor instead.
+func (*ManagedUser) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ManagedUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ManagedUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManagedUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ManagedUser) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ManagedUser) GetTimestampMetadata() *TimestampMetadata {
+	if x != nil {
+		return x.TimestampMetadata
+	}
+	return nil
+}
+
+type FindAllManagedUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+}
+
+func (x *FindAllManagedUsersRequest) Reset() {
+	*x = FindAllManagedUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllManagedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllManagedUsersRequest) ProtoMessage() {}
+
+func (x *FindAllManagedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllManagedUsersRequest.ProtoReflect.Descriptor instead.
+func (*FindAllManagedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FindAllManagedUsersRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+type FindAllManagedUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManagedUsers       []*ManagedUser      `protobuf:"bytes,1,rep,name=managed_users,json=managedUsers,proto3" json:"managed_users,omitempty"`
+	PaginationMetadata *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+}
+
+func (x *FindAllManagedUsersResponse) Reset() {
+	*x = FindAllManagedUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllManagedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllManagedUsersResponse) ProtoMessage() {}
+
+func (x *FindAllManagedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllManagedUsersResponse.ProtoReflect.Descriptor instead.
+func (*FindAllManagedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FindAllManagedUsersResponse) GetManagedUsers() []*ManagedUser {
+	if x != nil {
+		return x.ManagedUsers
+	}
+	return nil
+}
+
+func (x *FindAllManagedUsersResponse) GetPaginationMetadata() *PaginationMetadata {
+	if x != nil {
+		return x.PaginationMetadata
+	}
+	return nil
+}
+
+type FindAllSubmissionsForAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+	AssignmentId      string             `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	// optional, only for an assignment of a course
+	SectionId string `protobuf:"bytes,3,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+}
+
+func (x *FindAllSubmissionsForAssignmentRequest) Reset() {
+	*x = FindAllSubmissionsForAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionsForAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionsForAssignmentRequest) ProtoMessage() {}
+
+func (x *FindAllSubmissionsForAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionsForAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionsForAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FindAllSubmissionsForAssignmentRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+func (x *FindAllSubmissionsForAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type FindAllSubmissionsForAssignmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions    []*FindAllSubmissionsForAssignmentResponse_Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	AssignmentId   string                                                `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	AssignmentName string                                                `protobuf:"bytes,3,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
+	AssignerId     string                                                `protobuf:"bytes,4,opt,name=assigner_id,json=assignerId,proto3" json:"assigner_id,omitempty"`
+	AssignerName   string                                                `protobuf:"bytes,5,opt,name=assigner_name,json=assignerName,proto3" json:"assigner_name,omitempty"`
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) Reset() {
+	*x = FindAllSubmissionsForAssignmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionsForAssignmentResponse) ProtoMessage() {}
+
+func (x *FindAllSubmissionsForAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionsForAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionsForAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) GetSubmissions() []*FindAllSubmissionsForAssignmentResponse_Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) GetAssignmentName() string {
+	if x != nil {
+		return x.AssignmentName
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) GetAssignerId() string {
+	if x != nil {
+		return x.AssignerId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse) GetAssignerName() string {
+	if x != nil {
+		return x.AssignerName
+	}
+	return ""
+}
+
+type FindAllStudentAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaginationRequest *PaginationRequest `protobuf:"bytes,1,opt,name=pagination_request,json=paginationRequest,proto3" json:"pagination_request,omitempty"`
+	FromDate          string             `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate            string             `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+}
+
+func (x *FindAllStudentAssignmentsRequest) Reset() {
+	*x = FindAllStudentAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllStudentAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllStudentAssignmentsRequest) ProtoMessage() {}
+
+func (x *FindAllStudentAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllStudentAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllStudentAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FindAllStudentAssignmentsRequest) GetPaginationRequest() *PaginationRequest {
+	if x != nil {
+		return x.PaginationRequest
+	}
+	return nil
+}
+
+func (x *FindAllStudentAssignmentsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *FindAllStudentAssignmentsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type FindAllStudentAssignmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments        []*StudentAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	PaginationMetadata *PaginationMetadata  `protobuf:"bytes,2,opt,name=pagination_metadata,json=paginationMetadata,proto3" json:"pagination_metadata,omitempty"`
+}
+
+func (x *FindAllStudentAssignmentsResponse) Reset() {
+	*x = FindAllStudentAssignmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllStudentAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllStudentAssignmentsResponse) ProtoMessage() {}
+
+func (x *FindAllStudentAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllStudentAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllStudentAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FindAllStudentAssignmentsResponse) GetAssignments() []*StudentAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *FindAllStudentAssignmentsResponse) GetPaginationMetadata() *PaginationMetadata {
+	if x != nil {
+		return x.PaginationMetadata
+	}
+	return nil
+}
+
+type StudentAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AssignerId       string                        `protobuf:"bytes,4,opt,name=assigner_id,json=assignerId,proto3" json:"assigner_id,omitempty"`
+	AssignerName     string                        `protobuf:"bytes,5,opt,name=assigner_name,json=assignerName,proto3" json:"assigner_name,omitempty"`
+	UpdatedAt        string                        `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeadlineAt       string                        `protobuf:"bytes,7,opt,name=deadline_at,json=deadlineAt,proto3" json:"deadline_at,omitempty"`
+	Submission       *StudentAssignment_Submission `protobuf:"bytes,8,opt,name=submission,proto3" json:"submission,omitempty"`
+	HasSubmission    bool                          `protobuf:"varint,9,opt,name=has_submission,json=hasSubmission,proto3" json:"has_submission,omitempty"`
+	CodeTemplate     string                        `protobuf:"bytes,10,opt,name=code_template,json=codeTemplate,proto3" json:"code_template,omitempty"`
+	AllowedLanguages []string                      `protobuf:"bytes,11,rep,name=allowed_languages,json=allowedLanguages,proto3" json:"allowed_languages,omitempty"`
+	// feedback_level decides which case results details are shown
+	FeedbackLevel string                   `protobuf:"bytes,12,opt,name=feedback_level,json=feedbackLevel,proto3" json:"feedback_level,omitempty"`
+	AttemptPolicy *AssignmentAttemptPolicy `protobuf:"bytes,13,opt,name=attempt_policy,json=attemptPolicy,proto3" json:"attempt_policy,omitempty"`
+	// remaining_attempts is -1 when the attempts are unlimited
+	RemainingAttempts int32 `protobuf:"varint,14,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"`
+	// next_attempt_at is empty when there is no cooldown
+	NextAttemptAt string                `protobuf:"bytes,15,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LatePolicy    *AssignmentLatePolicy `protobuf:"bytes,16,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`
+	// has_extension is true when deadline_at is the student extended deadline
+	HasExtension bool `protobuf:"varint,17,opt,name=has_extension,json=hasExtension,proto3" json:"has_extension,omitempty"`
+	// build_command compiles a multi file submission, empty means the language default
+	// which compiles the entry file, e.g. main.cpp
+	BuildCommand string `protobuf:"bytes,18,opt,name=build_command,json=buildCommand,proto3" json:"build_command,omitempty"`
+}
+
+func (x *StudentAssignment) Reset() {
+	*x = StudentAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAssignment) ProtoMessage() {}
+
+func (x *StudentAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAssignment.ProtoReflect.Descriptor instead.
+func (*StudentAssignment) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StudentAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetAssignerId() string {
+	if x != nil {
+		return x.AssignerId
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetAssignerName() string {
+	if x != nil {
+		return x.AssignerName
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetDeadlineAt() string {
+	if x != nil {
+		return x.DeadlineAt
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetSubmission() *StudentAssignment_Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *StudentAssignment) GetHasSubmission() bool {
+	if x != nil {
+		return x.HasSubmission
+	}
+	return false
+}
+
+func (x *StudentAssignment) GetCodeTemplate() string {
+	if x != nil {
+		return x.CodeTemplate
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetAllowedLanguages() []string {
+	if x != nil {
+		return x.AllowedLanguages
+	}
+	return nil
+}
+
+func (x *StudentAssignment) GetFeedbackLevel() string {
+	if x != nil {
+		return x.FeedbackLevel
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetAttemptPolicy() *AssignmentAttemptPolicy {
+	if x != nil {
+		return x.AttemptPolicy
+	}
+	return nil
+}
+
+func (x *StudentAssignment) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *StudentAssignment) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *StudentAssignment) GetLatePolicy() *AssignmentLatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return nil
+}
+
+func (x *StudentAssignment) GetHasExtension() bool {
+	if x != nil {
+		return x.HasExtension
+	}
+	return false
+}
+
+func (x *StudentAssignment) GetBuildCommand() string {
+	if x != nil {
+		return x.BuildCommand
+	}
+	return ""
+}
+
+type SubmissionAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttemptNumber int32                   `protobuf:"varint,2,opt,name=attempt_number,json=attemptNumber,proto3" json:"attempt_number,omitempty"`
+	Language      string                  `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Grade         int32                   `protobuf:"varint,4,opt,name=grade,proto3" json:"grade,omitempty"`
+	MaxScore      int32                   `protobuf:"varint,5,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	IsGraded      bool                    `protobuf:"varint,6,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
+	CompileOutput string                  `protobuf:"bytes,7,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+	CaseResults   []*SubmissionCaseResult `protobuf:"bytes,8,rep,name=case_results,json=caseResults,proto3" json:"case_results,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsCurrent     bool                    `protobuf:"varint,10,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// raw_grade is the grade before the late penalty
+	RawGrade int32               `protobuf:"varint,11,opt,name=raw_grade,json=rawGrade,proto3" json:"raw_grade,omitempty"`
+	Lateness *SubmissionLateness `protobuf:"bytes,12,opt,name=lateness,proto3" json:"lateness,omitempty"`
+}
+
+func (x *SubmissionAttempt) Reset() {
+	*x = SubmissionAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionAttempt) ProtoMessage() {}
+
+func (x *SubmissionAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionAttempt.ProtoReflect.Descriptor instead.
+func (*SubmissionAttempt) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubmissionAttempt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetAttemptNumber() int32 {
+	if x != nil {
+		return x.AttemptNumber
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetIsGraded() bool {
+	if x != nil {
+		return x.IsGraded
+	}
+	return false
+}
+
+func (x *SubmissionAttempt) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetCaseResults() []*SubmissionCaseResult {
+	if x != nil {
+		return x.CaseResults
+	}
+	return nil
+}
+
+func (x *SubmissionAttempt) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SubmissionAttempt) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *SubmissionAttempt) GetRawGrade() int32 {
+	if x != nil {
+		return x.RawGrade
+	}
+	return 0
+}
+
+func (x *SubmissionAttempt) GetLateness() *SubmissionLateness {
+	if x != nil {
+		return x.Lateness
+	}
+	return nil
+}
+
+type FindAllSubmissionAttemptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+}
+
+func (x *FindAllSubmissionAttemptsRequest) Reset() {
+	*x = FindAllSubmissionAttemptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionAttemptsRequest) ProtoMessage() {}
+
+func (x *FindAllSubmissionAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FindAllSubmissionAttemptsRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+type FindAllSubmissionAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the latest attempt first
+	Attempts []*SubmissionAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *FindAllSubmissionAttemptsResponse) Reset() {
+	*x = FindAllSubmissionAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionAttemptsResponse) ProtoMessage() {}
+
+func (x *FindAllSubmissionAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FindAllSubmissionAttemptsResponse) GetAttempts() []*SubmissionAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type DiffSubmissionAttemptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId  string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	FromAttemptId string `protobuf:"bytes,2,opt,name=from_attempt_id,json=fromAttemptId,proto3" json:"from_attempt_id,omitempty"`
+	ToAttemptId   string `protobuf:"bytes,3,opt,name=to_attempt_id,json=toAttemptId,proto3" json:"to_attempt_id,omitempty"`
+}
+
+func (x *DiffSubmissionAttemptsRequest) Reset() {
+	*x = DiffSubmissionAttemptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSubmissionAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSubmissionAttemptsRequest) ProtoMessage() {}
+
+func (x *DiffSubmissionAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSubmissionAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*DiffSubmissionAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DiffSubmissionAttemptsRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *DiffSubmissionAttemptsRequest) GetFromAttemptId() string {
+	if x != nil {
+		return x.FromAttemptId
+	}
+	return ""
+}
+
+func (x *DiffSubmissionAttemptsRequest) GetToAttemptId() string {
+	if x != nil {
+		return x.ToAttemptId
+	}
+	return ""
+}
+
+type DiffSubmissionAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// diff is the unified diff of the attempts source code, empty when they are the same
+	Diff string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *DiffSubmissionAttemptsResponse) Reset() {
+	*x = DiffSubmissionAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffSubmissionAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSubmissionAttemptsResponse) ProtoMessage() {}
+
+func (x *DiffSubmissionAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSubmissionAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*DiffSubmissionAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DiffSubmissionAttemptsResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type RunCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one of cpp, python, java, go, rust
+	Language   string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	SourceCode string `protobuf:"bytes,2,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	Stdin      string `protobuf:"bytes,3,opt,name=stdin,proto3" json:"stdin,omitempty"`
+}
+
+func (x *RunCodeRequest) Reset() {
+	*x = RunCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCodeRequest) ProtoMessage() {}
+
+func (x *RunCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCodeRequest.ProtoReflect.Descriptor instead.
+func (*RunCodeRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RunCodeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *RunCodeRequest) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *RunCodeRequest) GetStdin() string {
+	if x != nil {
+		return x.Stdin
+	}
+	return ""
+}
+
+type RunCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one of ok, compile_error, time_limit_exceeded, memory_limit_exceeded,
+	// output_limit_exceeded, runtime_error
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stdout     string `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr     string `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode   int32  `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	WallTimeMs int64  `protobuf:"varint,5,opt,name=wall_time_ms,json=wallTimeMs,proto3" json:"wall_time_ms,omitempty"`
+	CpuTimeMs  int64  `protobuf:"varint,6,opt,name=cpu_time_ms,json=cpuTimeMs,proto3" json:"cpu_time_ms,omitempty"`
+	// memory_kib is the peak memory usage, zero when it's not measured
+	MemoryKib     int64  `protobuf:"varint,7,opt,name=memory_kib,json=memoryKib,proto3" json:"memory_kib,omitempty"`
+	CompileOutput string `protobuf:"bytes,8,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+}
+
+func (x *RunCodeResponse) Reset() {
+	*x = RunCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCodeResponse) ProtoMessage() {}
+
+func (x *RunCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCodeResponse.ProtoReflect.Descriptor instead.
+func (*RunCodeResponse) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RunCodeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RunCodeResponse) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *RunCodeResponse) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *RunCodeResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *RunCodeResponse) GetWallTimeMs() int64 {
+	if x != nil {
+		return x.WallTimeMs
+	}
+	return 0
+}
+
+func (x *RunCodeResponse) GetCpuTimeMs() int64 {
+	if x != nil {
+		return x.CpuTimeMs
+	}
+	return 0
+}
+
+func (x *RunCodeResponse) GetMemoryKib() int64 {
+	if x != nil {
+		return x.MemoryKib
+	}
+	return 0
+}
+
+func (x *RunCodeResponse) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+type SubmissionCaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaseNumber int32 `protobuf:"varint,1,opt,name=case_number,json=caseNumber,proto3" json:"case_number,omitempty"`
+	// one of AC, WA, TLE, MLE, RE, CE, PC
+	Verdict    string `protobuf:"bytes,2,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	ExitCode   int32  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stderr     string `protobuf:"bytes,4,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	WallTimeMs int64  `protobuf:"varint,5,opt,name=wall_time_ms,json=wallTimeMs,proto3" json:"wall_time_ms,omitempty"`
+	CpuTimeMs  int64  `protobuf:"varint,6,opt,name=cpu_time_ms,json=cpuTimeMs,proto3" json:"cpu_time_ms,omitempty"`
+	Weight     int32  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	Group      string `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+	// earned_weight is the weight counted in the grade
+	EarnedWeight int32 `protobuf:"varint,9,opt,name=earned_weight,json=earnedWeight,proto3" json:"earned_weight,omitempty"`
+	IsHidden     bool  `protobuf:"varint,10,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	// input, expected_output & output are only set for the sample cases
+	// of an assignment with full_diff feedback
+	Input          string `protobuf:"bytes,11,opt,name=input,proto3" json:"input,omitempty"`
+	ExpectedOutput string `protobuf:"bytes,12,opt,name=expected_output,json=expectedOutput,proto3" json:"expected_output,omitempty"`
+	Output         string `protobuf:"bytes,13,opt,name=output,proto3" json:"output,omitempty"`
+	// name is the test name of a unit test assignment
+	Name string `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SubmissionCaseResult) Reset() {
+	*x = SubmissionCaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionCaseResult) ProtoMessage() {}
+
+func (x *SubmissionCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionCaseResult.ProtoReflect.Descriptor instead.
+func (*SubmissionCaseResult) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubmissionCaseResult) GetCaseNumber() int32 {
+	if x != nil {
+		return x.CaseNumber
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetWallTimeMs() int64 {
+	if x != nil {
+		return x.WallTimeMs
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetCpuTimeMs() int64 {
+	if x != nil {
+		return x.CpuTimeMs
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetEarnedWeight() int32 {
+	if x != nil {
+		return x.EarnedWeight
+	}
+	return 0
+}
+
+func (x *SubmissionCaseResult) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *SubmissionCaseResult) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *SubmissionCaseResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StudentSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId           string `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	AssignmentName         string `protobuf:"bytes,3,opt,name=assignment_name,json=assignmentName,proto3" json:"assignment_name,omitempty"`
+	AssignmentDescription  string `protobuf:"bytes,4,opt,name=assignment_description,json=assignmentDescription,proto3" json:"assignment_description,omitempty"`
+	AssignmentAssignerId   string `protobuf:"bytes,5,opt,name=assignment_assigner_id,json=assignmentAssignerId,proto3" json:"assignment_assigner_id,omitempty"`
+	AssignmentAssignerName string `protobuf:"bytes,6,opt,name=assignment_assigner_name,json=assignmentAssignerName,proto3" json:"assignment_assigner_name,omitempty"`
+	AssignmentDeadlineAt   string `protobuf:"bytes,7,opt,name=assignment_deadline_at,json=assignmentDeadlineAt,proto3" json:"assignment_deadline_at,omitempty"`
+	SubmissionCode         string `protobuf:"bytes,8,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	UpdatedAt              string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *StudentSubmission) Reset() {
+	*x = StudentSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSubmission) ProtoMessage() {}
+
+func (x *StudentSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSubmission.ProtoReflect.Descriptor instead.
+func (*StudentSubmission) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *StudentSubmission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentName() string {
+	if x != nil {
+		return x.AssignmentName
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentDescription() string {
+	if x != nil {
+		return x.AssignmentDescription
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentAssignerId() string {
+	if x != nil {
+		return x.AssignmentAssignerId
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentAssignerName() string {
+	if x != nil {
+		return x.AssignmentAssignerName
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetAssignmentDeadlineAt() string {
+	if x != nil {
+		return x.AssignmentDeadlineAt
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
+	}
+	return ""
+}
+
+func (x *StudentSubmission) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// a submission is either a single file submission_code,
+// or a multi file submission of source_files or a zip source_archive
+type SubmitStudentSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId   string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	SubmissionCode string `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	// default to the first allowed language of the assignment
+	Language      string        `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	SourceFiles   []*SourceFile `protobuf:"bytes,4,rep,name=source_files,json=sourceFiles,proto3" json:"source_files,omitempty"`
+	SourceArchive []byte        `protobuf:"bytes,5,opt,name=source_archive,json=sourceArchive,proto3" json:"source_archive,omitempty"`
+}
+
+func (x *SubmitStudentSubmissionRequest) Reset() {
+	*x = SubmitStudentSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitStudentSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStudentSubmissionRequest) ProtoMessage() {}
+
+func (x *SubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStudentSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStudentSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SubmitStudentSubmissionRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *SubmitStudentSubmissionRequest) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
+	}
+	return ""
+}
+
+func (x *SubmitStudentSubmissionRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubmitStudentSubmissionRequest) GetSourceFiles() []*SourceFile {
+	if x != nil {
+		return x.SourceFiles
+	}
+	return nil
+}
+
+func (x *SubmitStudentSubmissionRequest) GetSourceArchive() []byte {
+	if x != nil {
+		return x.SourceArchive
+	}
+	return nil
+}
+
+type ResubmitStudentSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId   string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	SubmissionCode string `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	// default to the language of the previous submission
+	Language      string        `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	SourceFiles   []*SourceFile `protobuf:"bytes,4,rep,name=source_files,json=sourceFiles,proto3" json:"source_files,omitempty"`
+	SourceArchive []byte        `protobuf:"bytes,5,opt,name=source_archive,json=sourceArchive,proto3" json:"source_archive,omitempty"`
+}
+
+func (x *ResubmitStudentSubmissionRequest) Reset() {
+	*x = ResubmitStudentSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResubmitStudentSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResubmitStudentSubmissionRequest) ProtoMessage() {}
+
+func (x *ResubmitStudentSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResubmitStudentSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ResubmitStudentSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ResubmitStudentSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *ResubmitStudentSubmissionRequest) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
+	}
+	return ""
+}
+
+func (x *ResubmitStudentSubmissionRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ResubmitStudentSubmissionRequest) GetSourceFiles() []*SourceFile {
+	if x != nil {
+		return x.SourceFiles
+	}
+	return nil
+}
+
+func (x *ResubmitStudentSubmissionRequest) GetSourceArchive() []byte {
+	if x != nil {
+		return x.SourceArchive
+	}
+	return nil
+}
+
+type ActivateManagedUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId               string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActivationToken      string `protobuf:"bytes,2,opt,name=activation_token,json=activationToken,proto3" json:"activation_token,omitempty"`
+	Password             string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string `protobuf:"bytes,4,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
+}
+
+func (x *ActivateManagedUserRequest) Reset() {
+	*x = ActivateManagedUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateManagedUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateManagedUserRequest) ProtoMessage() {}
+
+func (x *ActivateManagedUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateManagedUserRequest.ProtoReflect.Descriptor instead.
+func (*ActivateManagedUserRequest) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ActivateManagedUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ActivateManagedUserRequest) GetActivationToken() string {
+	if x != nil {
+		return x.ActivationToken
+	}
+	return ""
+}
+
+func (x *ActivateManagedUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ActivateManagedUserRequest) GetPasswordConfirmation() string {
+	if x != nil {
+		return x.PasswordConfirmation
+	}
+	return ""
+}
+
+type GradeSubmissionRequest_RubricScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CriterionId string `protobuf:"bytes,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	Points      int32  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	Comment     string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *GradeSubmissionRequest_RubricScore) Reset() {
+	*x = GradeSubmissionRequest_RubricScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeSubmissionRequest_RubricScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeSubmissionRequest_RubricScore) ProtoMessage() {}
+
+func (x *GradeSubmissionRequest_RubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeSubmissionRequest_RubricScore.ProtoReflect.Descriptor instead.
+func (*GradeSubmissionRequest_RubricScore) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *GradeSubmissionRequest_RubricScore) GetCriterionId() string {
+	if x != nil {
+		return x.CriterionId
+	}
+	return ""
+}
+
+func (x *GradeSubmissionRequest_RubricScore) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest_RubricScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SubmissionSimilarity_Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId  string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	SubmitterId   string `protobuf:"bytes,2,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
+	SubmitterName string `protobuf:"bytes,3,opt,name=submitter_name,json=submitterName,proto3" json:"submitter_name,omitempty"`
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	// code is the compared source code
+	Code string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *SubmissionSimilarity_Source) Reset() {
+	*x = SubmissionSimilarity_Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionSimilarity_Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionSimilarity_Source) ProtoMessage() {}
+
+func (x *SubmissionSimilarity_Source) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionSimilarity_Source.ProtoReflect.Descriptor instead.
+func (*SubmissionSimilarity_Source) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *SubmissionSimilarity_Source) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *SubmissionSimilarity_Source) GetSubmitterId() string {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return ""
+}
+
+func (x *SubmissionSimilarity_Source) GetSubmitterName() string {
+	if x != nil {
+		return x.SubmitterName
+	}
+	return ""
+}
+
+func (x *SubmissionSimilarity_Source) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubmissionSimilarity_Source) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Region is a matched passage, the lines are 1-based & inclusive
+type SubmissionSimilarity_Region struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstStartLine  int32 `protobuf:"varint,1,opt,name=first_start_line,json=firstStartLine,proto3" json:"first_start_line,omitempty"`
+	FirstEndLine    int32 `protobuf:"varint,2,opt,name=first_end_line,json=firstEndLine,proto3" json:"first_end_line,omitempty"`
+	SecondStartLine int32 `protobuf:"varint,3,opt,name=second_start_line,json=secondStartLine,proto3" json:"second_start_line,omitempty"`
+	SecondEndLine   int32 `protobuf:"varint,4,opt,name=second_end_line,json=secondEndLine,proto3" json:"second_end_line,omitempty"`
+}
+
+func (x *SubmissionSimilarity_Region) Reset() {
+	*x = SubmissionSimilarity_Region{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionSimilarity_Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionSimilarity_Region) ProtoMessage() {}
+
+func (x *SubmissionSimilarity_Region) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionSimilarity_Region.ProtoReflect.Descriptor instead.
+func (*SubmissionSimilarity_Region) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{52, 1}
+}
+
+func (x *SubmissionSimilarity_Region) GetFirstStartLine() int32 {
+	if x != nil {
+		return x.FirstStartLine
+	}
+	return 0
+}
+
+func (x *SubmissionSimilarity_Region) GetFirstEndLine() int32 {
+	if x != nil {
+		return x.FirstEndLine
+	}
+	return 0
+}
+
+func (x *SubmissionSimilarity_Region) GetSecondStartLine() int32 {
+	if x != nil {
+		return x.SecondStartLine
+	}
+	return 0
+}
+
+func (x *SubmissionSimilarity_Region) GetSecondEndLine() int32 {
+	if x != nil {
+		return x.SecondEndLine
+	}
+	return 0
+}
+
+type FindAllSubmissionsForAssignmentResponse_Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmitterId   string `protobuf:"bytes,4,opt,name=submitter_id,json=submitterId,proto3" json:"submitter_id,omitempty"`
+	SubmitterName string `protobuf:"bytes,5,opt,name=submitter_name,json=submitterName,proto3" json:"submitter_name,omitempty"`
+	Grade         int32  `protobuf:"varint,6,opt,name=grade,proto3" json:"grade,omitempty"`
+	// raw_grade is the grade before the late penalty
+	RawGrade int32               `protobuf:"varint,7,opt,name=raw_grade,json=rawGrade,proto3" json:"raw_grade,omitempty"`
+	MaxScore int32               `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	IsGraded bool                `protobuf:"varint,9,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
+	Lateness *SubmissionLateness `protobuf:"bytes,10,opt,name=lateness,proto3" json:"lateness,omitempty"`
+	// empty when the assignment has no course
+	SectionId    string `protobuf:"bytes,11,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	SectionName  string `protobuf:"bytes,12,opt,name=section_name,json=sectionName,proto3" json:"section_name,omitempty"`
+	IsOverridden bool   `protobuf:"varint,13,opt,name=is_overridden,json=isOverridden,proto3" json:"is_overridden,omitempty"`
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) Reset() {
+	*x = FindAllSubmissionsForAssignmentResponse_Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAllSubmissionsForAssignmentResponse_Submission) ProtoMessage() {}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAllSubmissionsForAssignmentResponse_Submission.ProtoReflect.Descriptor instead.
+func (*FindAllSubmissionsForAssignmentResponse_Submission) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{76, 0}
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSubmitterId() string {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSubmitterName() string {
+	if x != nil {
+		return x.SubmitterName
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetRawGrade() int32 {
+	if x != nil {
+		return x.RawGrade
+	}
+	return 0
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetIsGraded() bool {
+	if x != nil {
+		return x.IsGraded
+	}
+	return false
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetLateness() *SubmissionLateness {
+	if x != nil {
+		return x.Lateness
+	}
+	return nil
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetSectionName() string {
+	if x != nil {
+		return x.SectionName
+	}
+	return ""
+}
+
+func (x *FindAllSubmissionsForAssignmentResponse_Submission) GetIsOverridden() bool {
+	if x != nil {
+		return x.IsOverridden
+	}
+	return false
+}
+
+type StudentAssignment_Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmissionCode string                  `protobuf:"bytes,2,opt,name=submission_code,json=submissionCode,proto3" json:"submission_code,omitempty"`
+	Grade          int32                   `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	UpdatedAt      string                  `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsGraded       bool                    `protobuf:"varint,5,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
+	CaseResults    []*SubmissionCaseResult `protobuf:"bytes,6,rep,name=case_results,json=caseResults,proto3" json:"case_results,omitempty"`
+	Language       string                  `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// compile_output is the truncated compiler diagnostics
+	CompileOutput    string `protobuf:"bytes,8,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+	MaxScore         int32  `protobuf:"varint,9,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	CurrentAttemptId string `protobuf:"bytes,10,opt,name=current_attempt_id,json=currentAttemptId,proto3" json:"current_attempt_id,omitempty"`
+	// scored_attempt_id is the attempt of the grade
+	ScoredAttemptId string `protobuf:"bytes,11,opt,name=scored_attempt_id,json=scoredAttemptId,proto3" json:"scored_attempt_id,omitempty"`
+	AttemptCount    int32  `protobuf:"varint,12,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	// raw_grade is the grade before the late penalty
+	RawGrade int32               `protobuf:"varint,13,opt,name=raw_grade,json=rawGrade,proto3" json:"raw_grade,omitempty"`
+	Lateness *SubmissionLateness `protobuf:"bytes,14,opt,name=lateness,proto3" json:"lateness,omitempty"`
+	// source_files are only set for a multi file submission,
+	// its submission_code is the source files each headed by its path
+	SourceFiles []*SourceFile `protobuf:"bytes,15,rep,name=source_files,json=sourceFiles,proto3" json:"source_files,omitempty"`
+}
+
+func (x *StudentAssignment_Submission) Reset() {
+	*x = StudentAssignment_Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autograd_v1_autograd_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentAssignment_Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAssignment_Submission) ProtoMessage() {}
+
+func (x *StudentAssignment_Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_autograd_v1_autograd_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAssignment_Submission.ProtoReflect.Descriptor instead.
+func (*StudentAssignment_Submission) Descriptor() ([]byte, []int) {
+	return file_autograd_v1_autograd_proto_rawDescGZIP(), []int{79, 0}
+}
+
+func (x *StudentAssignment_Submission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetSubmissionCode() string {
+	if x != nil {
+		return x.SubmissionCode
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *StudentAssignment_Submission) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetIsGraded() bool {
+	if x != nil {
+		return x.IsGraded
+	}
+	return false
+}
+
+func (x *StudentAssignment_Submission) GetCaseResults() []*SubmissionCaseResult {
+	if x != nil {
+		return x.CaseResults
+	}
+	return nil
+}
+
+func (x *StudentAssignment_Submission) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *StudentAssignment_Submission) GetCurrentAttemptId() string {
+	if x != nil {
+		return x.CurrentAttemptId
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetScoredAttemptId() string {
+	if x != nil {
+		return x.ScoredAttemptId
+	}
+	return ""
+}
+
+func (x *StudentAssignment_Submission) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *StudentAssignment_Submission) GetRawGrade() int32 {
+	if x != nil {
+		return x.RawGrade
+	}
+	return 0
+}
+
+func (x *StudentAssignment_Submission) GetLateness() *SubmissionLateness {
+	if x != nil {
+		return x.Lateness
+	}
+	return nil
+}
+
+func (x *StudentAssignment_Submission) GetSourceFiles() []*SourceFile {
+	if x != nil {
+		return x.SourceFiles
+	}
+	return nil
+}
+
+var File_autograd_v1_autograd_proto protoreflect.FileDescriptor
+
+var file_autograd_v1_autograd_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x46,
+	0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x58, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x22, 0x51, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x4d, 0x0a, 0x12, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2f,
+	0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x2e, 0x0a, 0x08, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xf9, 0x07, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x08, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0d, 0x63,
+	0x61, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x10,
+	0x63, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x0e, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x42,
+	0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x65, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x47, 0x0a,
+	0x0d, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x72,
+	0x6e, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0c, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73,
+	0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x15, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x82, 0x01, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x53, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x53, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x22, 0x6a, 0x0a, 0x12, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65,
+	0x63, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x6d, 0x69, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x62, 0x12, 0x28, 0x0a, 0x10, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6b, 0x69, 0x62, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4b, 0x69, 0x62, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x11, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x5f, 0x65, 0x70, 0x73, 0x69,
+	0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x45, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x5f, 0x65, 0x70, 0x73,
+	0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x45,
+	0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x39,
+	0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x63, 0x12, 0x3d, 0x0a, 0x1b, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x69, 0x62, 0x22, 0xb9, 0x03, 0x0a, 0x0a, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x12,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0xb4, 0x03, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x6f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x75, 0x74, 0x6f, 0x4d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x4d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x47,
+	0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x91, 0x07, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x42, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x47, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0c, 0x68, 0x61, 0x72,
+	0x6e, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x3b,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xc4, 0x06, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x12, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x61,
+	0x73, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x73, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x35, 0x0a,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x47, 0x0a, 0x0d, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x0c, 0x68, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd2, 0x02, 0x0a, 0x12, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x90,
+	0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xfb, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x48, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x22, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x22,
+	0xe0, 0x02, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x9f, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x49, 0x0a, 0x22, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x23,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbe, 0x01, 0x0a, 0x26, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x26, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x26, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x27, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x42, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x1a, 0x62, 0x0a,
+	0x0b, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xeb, 0x03, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x4d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x4a, 0x0a, 0x23, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x24, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0x3f,
+	0x0a, 0x18, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x69, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x20, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0xaa, 0x05, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x05,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xa7, 0x01, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x1a, 0xac, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x45, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+	0x22, 0x68, 0x0a, 0x24, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6e, 0x0a, 0x25, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x73, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x04, 0x54,
+	0x65, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e,
+	0x64, 0x41, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65,
+	0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x14, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x06,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9f,
+	0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x78, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x15, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a,
+	0x16, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xc0,
+	0x01, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x22, 0xb5, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a,
+	0x13, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xaa, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x4d, 0x0a,
+	0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x1a,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x1b, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x01, 0x0a, 0x26, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9a, 0x05, 0x0a, 0x27, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xf7, 0x02, 0x0a, 0x0a,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61,
+	0x77, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x61, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0xb7, 0x01, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd1, 0x0a, 0x0a, 0x11, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x68, 0x61, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4b, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0xd2, 0x04, 0x0a, 0x0a, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x63, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x61, 0x77, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xbb, 0x03,
+	0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x44, 0x0a,
+	0x0c, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x63, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x61, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x47, 0x0a, 0x20, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x44, 0x69, 0x66, 0x66, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x1e, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x63,
+	0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x64, 0x69, 0x6e, 0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c,
+	0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1e,
+	0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6b, 0x69, 0x62, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4b, 0x69, 0x62, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0xa3, 0x03, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x20, 0x0a,
+	0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x1e, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x96, 0x03, 0x0a, 0x11, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x64, 0x0a, 0x0e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x41,
+	0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x50, 0x55,
+	0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x02, 0x32,
+	0x87, 0x1b, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x19, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a,
+	0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x68, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x2c,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x76, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69,
+	0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x60, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x12,
+	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x5c, 0x0a, 0x12, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x14, 0x55, 0x6e, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x19, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x15, 0x46, 0x69, 0x6e,
+	0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x00, 0x12, 0x7c, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x73, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x19, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xba, 0x0b, 0x0a, 0x0d, 0x41, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x0e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f,
+	0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x1a, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x1b,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x8e, 0x01, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x33, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54,
+	0x65, 0x72, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x18, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x61, 0x68, 0x6d, 0x69, 0x66, 0x61, 0x6e, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x64, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_autograd_v1_autograd_proto_rawDescOnce sync.Once
+	file_autograd_v1_autograd_proto_rawDescData = file_autograd_v1_autograd_proto_rawDesc
+)
+
+func file_autograd_v1_autograd_proto_rawDescGZIP() []byte {
+	file_autograd_v1_autograd_proto_rawDescOnce.Do(func() {
+		file_autograd_v1_autograd_proto_rawDescData = protoimpl.X.CompressGZIP(file_autograd_v1_autograd_proto_rawDescData)
+	})
+	return file_autograd_v1_autograd_proto_rawDescData
+}
+
+var file_autograd_v1_autograd_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_autograd_v1_autograd_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_autograd_v1_autograd_proto_goTypes = []interface{}{
+	(AssignmentType)(0),                                        // 0: autograd.v1.AssignmentType
+	(*Empty)(nil),                                              // 1: autograd.v1.Empty
+	(*CreatedResponse)(nil),                                    // 2: autograd.v1.CreatedResponse
+	(*PingResponse)(nil),                                       // 3: autograd.v1.PingResponse
+	(*FindByIDRequest)(nil),                                    // 4: autograd.v1.FindByIDRequest
+	(*DeleteByIDRequest)(nil),                                  // 5: autograd.v1.DeleteByIDRequest
+	(*PaginationMetadata)(nil),                                 // 6: autograd.v1.PaginationMetadata
+	(*PaginationRequest)(nil),                                  // 7: autograd.v1.PaginationRequest
+	(*CreateManagedUserRequest)(nil),                           // 8: autograd.v1.CreateManagedUserRequest
+	(*TimestampMetadata)(nil),                                  // 9: autograd.v1.TimestampMetadata
+	(*AssignmentFile)(nil),                                     // 10: autograd.v1.AssignmentFile
+	(*SubmissionFile)(nil),                                     // 11: autograd.v1.SubmissionFile
+	(*Submitter)(nil),                                          // 12: autograd.v1.Submitter
+	(*Assigner)(nil),                                           // 13: autograd.v1.Assigner
+	(*Assignment)(nil),                                         // 14: autograd.v1.Assignment
+	(*AssignmentHarnessFile)(nil),                              // 15: autograd.v1.AssignmentHarnessFile
+	(*AssignmentAttemptPolicy)(nil),                            // 16: autograd.v1.AssignmentAttemptPolicy
+	(*AssignmentLatePolicy)(nil),                               // 17: autograd.v1.AssignmentLatePolicy
+	(*SubmissionLateness)(nil),                                 // 18: autograd.v1.SubmissionLateness
+	(*AssignmentLimits)(nil),                                   // 19: autograd.v1.AssignmentLimits
+	(*AssignmentChecker)(nil),                                  // 20: autograd.v1.AssignmentChecker
+	(*Submission)(nil),                                         // 21: autograd.v1.Submission
+	(*SourceFile)(nil),                                         // 22: autograd.v1.SourceFile
+	(*SubmissionGrade)(nil),                                    // 23: autograd.v1.SubmissionGrade
+	(*UpdateAssignmentRequest)(nil),                            // 24: autograd.v1.UpdateAssignmentRequest
+	(*CreateAssignmentRequest)(nil),                            // 25: autograd.v1.CreateAssignmentRequest
+	(*CreateSubmissionRequest)(nil),                            // 26: autograd.v1.CreateSubmissionRequest
+	(*UpdateSubmissionRequest)(nil),                            // 27: autograd.v1.UpdateSubmissionRequest
+	(*LoginRequest)(nil),                                       // 28: autograd.v1.LoginRequest
+	(*LoginResponse)(nil),                                      // 29: autograd.v1.LoginResponse
+	(*AssignmentTestCase)(nil),                                 // 30: autograd.v1.AssignmentTestCase
+	(*CreateAssignmentTestCaseRequest)(nil),                    // 31: autograd.v1.CreateAssignmentTestCaseRequest
+	(*UpdateAssignmentTestCaseRequest)(nil),                    // 32: autograd.v1.UpdateAssignmentTestCaseRequest
+	(*FindAllAssignmentTestCasesRequest)(nil),                  // 33: autograd.v1.FindAllAssignmentTestCasesRequest
+	(*FindAllAssignmentTestCasesResponse)(nil),                 // 34: autograd.v1.FindAllAssignmentTestCasesResponse
+	(*AssignmentExtension)(nil),                                // 35: autograd.v1.AssignmentExtension
+	(*CreateAssignmentExtensionRequest)(nil),                   // 36: autograd.v1.CreateAssignmentExtensionRequest
+	(*UpdateAssignmentExtensionRequest)(nil),                   // 37: autograd.v1.UpdateAssignmentExtensionRequest
+	(*FindAllAssignmentExtensionsRequest)(nil),                 // 38: autograd.v1.FindAllAssignmentExtensionsRequest
+	(*FindAllAssignmentExtensionsResponse)(nil),                // 39: autograd.v1.FindAllAssignmentExtensionsResponse
+	(*AssignmentRubricCriterion)(nil),                          // 40: autograd.v1.AssignmentRubricCriterion
+	(*CreateAssignmentRubricCriterionRequest)(nil),             // 41: autograd.v1.CreateAssignmentRubricCriterionRequest
+	(*UpdateAssignmentRubricCriterionRequest)(nil),             // 42: autograd.v1.UpdateAssignmentRubricCriterionRequest
+	(*FindAllAssignmentRubricCriteriaRequest)(nil),             // 43: autograd.v1.FindAllAssignmentRubricCriteriaRequest
+	(*FindAllAssignmentRubricCriteriaResponse)(nil),            // 44: autograd.v1.FindAllAssignmentRubricCriteriaResponse
+	(*SubmissionRubricScore)(nil),                              // 45: autograd.v1.SubmissionRubricScore
+	(*GradeSubmissionRequest)(nil),                             // 46: autograd.v1.GradeSubmissionRequest
+	(*SubmissionGradeAudit)(nil),                               // 47: autograd.v1.SubmissionGradeAudit
+	(*FindAllSubmissionGradeAuditsRequest)(nil),                // 48: autograd.v1.FindAllSubmissionGradeAuditsRequest
+	(*FindAllSubmissionGradeAuditsResponse)(nil),               // 49: autograd.v1.FindAllSubmissionGradeAuditsResponse
+	(*RegradeAssignmentRequest)(nil),                           // 50: autograd.v1.RegradeAssignmentRequest
+	(*RegradeAssignmentResponse)(nil),                          // 51: autograd.v1.RegradeAssignmentResponse
+	(*CheckAssignmentPlagiarismRequest)(nil),                   // 52: autograd.v1.CheckAssignmentPlagiarismRequest
+	(*SubmissionSimilarity)(nil),                               // 53: autograd.v1.SubmissionSimilarity
+	(*FindAllSubmissionSimilaritiesRequest)(nil),               // 54: autograd.v1.FindAllSubmissionSimilaritiesRequest
+	(*FindAllSubmissionSimilaritiesResponse)(nil),              // 55: autograd.v1.FindAllSubmissionSimilaritiesResponse
+	(*Term)(nil),                                               // 56: autograd.v1.Term
+	(*CreateTermRequest)(nil),                                  // 57: autograd.v1.CreateTermRequest
+	(*FindAllTermsRequest)(nil),                                // 58: autograd.v1.FindAllTermsRequest
+	(*FindAllTermsResponse)(nil),                               // 59: autograd.v1.FindAllTermsResponse
+	(*Course)(nil),                                             // 60: autograd.v1.Course
+	(*CourseSection)(nil),                                      // 61: autograd.v1.CourseSection
+	(*CreateCourseRequest)(nil),                                // 62: autograd.v1.CreateCourseRequest
+	(*UpdateCourseRequest)(nil),                                // 63: autograd.v1.UpdateCourseRequest
+	(*FindAllCoursesRequest)(nil),                              // 64: autograd.v1.FindAllCoursesRequest
+	(*FindAllCoursesResponse)(nil),                             // 65: autograd.v1.FindAllCoursesResponse
+	(*CreateCourseSectionRequest)(nil),                         // 66: autograd.v1.CreateCourseSectionRequest
+	(*CourseEnrollment)(nil),                                   // 67: autograd.v1.CourseEnrollment
+	(*EnrollCourseMemberRequest)(nil),                          // 68: autograd.v1.EnrollCourseMemberRequest
+	(*FindAllCourseEnrollmentsRequest)(nil),                    // 69: autograd.v1.FindAllCourseEnrollmentsRequest
+	(*FindAllCourseEnrollmentsResponse)(nil),                   // 70: autograd.v1.FindAllCourseEnrollmentsResponse
+	(*FindAllAssignmentsRequest)(nil),                          // 71: autograd.v1.FindAllAssignmentsRequest
+	(*FindAllAssignmentsResponse)(nil),                         // 72: autograd.v1.FindAllAssignmentsResponse
+	(*ManagedUser)(nil),                                        // 73: autograd.v1.ManagedUser
+	(*FindAllManagedUsersRequest)(nil),                         // 74: autograd.v1.FindAllManagedUsersRequest
+	(*FindAllManagedUsersResponse)(nil),                        // 75: autograd.v1.FindAllManagedUsersResponse
+	(*FindAllSubmissionsForAssignmentRequest)(nil),             // 76: autograd.v1.FindAllSubmissionsForAssignmentRequest
+	(*FindAllSubmissionsForAssignmentResponse)(nil),            // 77: autograd.v1.FindAllSubmissionsForAssignmentResponse
+	(*FindAllStudentAssignmentsRequest)(nil),                   // 78: autograd.v1.FindAllStudentAssignmentsRequest
+	(*FindAllStudentAssignmentsResponse)(nil),                  // 79: autograd.v1.FindAllStudentAssignmentsResponse
+	(*StudentAssignment)(nil),                                  // 80: autograd.v1.StudentAssignment
+	(*SubmissionAttempt)(nil),                                  // 81: autograd.v1.SubmissionAttempt
+	(*FindAllSubmissionAttemptsRequest)(nil),                   // 82: autograd.v1.FindAllSubmissionAttemptsRequest
+	(*FindAllSubmissionAttemptsResponse)(nil),                  // 83: autograd.v1.FindAllSubmissionAttemptsResponse
+	(*DiffSubmissionAttemptsRequest)(nil),                      // 84: autograd.v1.DiffSubmissionAttemptsRequest
+	(*DiffSubmissionAttemptsResponse)(nil),                     // 85: autograd.v1.DiffSubmissionAttemptsResponse
+	(*RunCodeRequest)(nil),                                     // 86: autograd.v1.RunCodeRequest
+	(*RunCodeResponse)(nil),                                    // 87: autograd.v1.RunCodeResponse
+	(*SubmissionCaseResult)(nil),                               // 88: autograd.v1.SubmissionCaseResult
+	(*StudentSubmission)(nil),                                  // 89: autograd.v1.StudentSubmission
+	(*SubmitStudentSubmissionRequest)(nil),                     // 90: autograd.v1.SubmitStudentSubmissionRequest
+	(*ResubmitStudentSubmissionRequest)(nil),                   // 91: autograd.v1.ResubmitStudentSubmissionRequest
+	(*ActivateManagedUserRequest)(nil),                         // 92: autograd.v1.ActivateManagedUserRequest
+	(*GradeSubmissionRequest_RubricScore)(nil),                 // 93: autograd.v1.GradeSubmissionRequest.RubricScore
+	(*SubmissionSimilarity_Source)(nil),                        // 94: autograd.v1.SubmissionSimilarity.Source
+	(*SubmissionSimilarity_Region)(nil),                        // 95: autograd.v1.SubmissionSimilarity.Region
+	(*FindAllSubmissionsForAssignmentResponse_Submission)(nil), // 96: autograd.v1.FindAllSubmissionsForAssignmentResponse.Submission
+	(*StudentAssignment_Submission)(nil),                       // 97: autograd.v1.StudentAssignment.Submission
+	(*fieldmaskpb.FieldMask)(nil),                              // 98: google.protobuf.FieldMask
+}
+var file_autograd_v1_autograd_proto_depIdxs = []int32{
+	9,   // 0: autograd.v1.AssignmentFile.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	9,   // 1: autograd.v1.SubmissionFile.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	13,  // 2: autograd.v1.Assignment.assigner:type_name -> autograd.v1.Assigner
+	10,  // 3: autograd.v1.Assignment.case_input_file:type_name -> autograd.v1.AssignmentFile
+	10,  // 4: autograd.v1.Assignment.case_output_file:type_name -> autograd.v1.AssignmentFile
+	9,   // 5: autograd.v1.Assignment.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	20,  // 6: autograd.v1.Assignment.checker:type_name -> autograd.v1.AssignmentChecker
+	19,  // 7: autograd.v1.Assignment.limits:type_name -> autograd.v1.AssignmentLimits
+	16,  // 8: autograd.v1.Assignment.attempt_policy:type_name -> autograd.v1.AssignmentAttemptPolicy
+	17,  // 9: autograd.v1.Assignment.late_policy:type_name -> autograd.v1.AssignmentLatePolicy
+	15,  // 10: autograd.v1.Assignment.harness_files:type_name -> autograd.v1.AssignmentHarnessFile
+	14,  // 11: autograd.v1.Submission.assignment:type_name -> autograd.v1.Assignment
+	12,  // 12: autograd.v1.Submission.submitter:type_name -> autograd.v1.Submitter
+	11,  // 13: autograd.v1.Submission.submission_file:type_name -> autograd.v1.SubmissionFile
+	9,   // 14: autograd.v1.Submission.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	23,  // 15: autograd.v1.Submission.grade:type_name -> autograd.v1.SubmissionGrade
+	22,  // 16: autograd.v1.Submission.source_files:type_name -> autograd.v1.SourceFile
+	45,  // 17: autograd.v1.SubmissionGrade.rubric_scores:type_name -> autograd.v1.SubmissionRubricScore
+	20,  // 18: autograd.v1.UpdateAssignmentRequest.checker:type_name -> autograd.v1.AssignmentChecker
+	19,  // 19: autograd.v1.UpdateAssignmentRequest.limits:type_name -> autograd.v1.AssignmentLimits
+	16,  // 20: autograd.v1.UpdateAssignmentRequest.attempt_policy:type_name -> autograd.v1.AssignmentAttemptPolicy
+	17,  // 21: autograd.v1.UpdateAssignmentRequest.late_policy:type_name -> autograd.v1.AssignmentLatePolicy
+	15,  // 22: autograd.v1.UpdateAssignmentRequest.harness_files:type_name -> autograd.v1.AssignmentHarnessFile
+	98,  // 23: autograd.v1.UpdateAssignmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20,  // 24: autograd.v1.CreateAssignmentRequest.checker:type_name -> autograd.v1.AssignmentChecker
+	19,  // 25: autograd.v1.CreateAssignmentRequest.limits:type_name -> autograd.v1.AssignmentLimits
+	16,  // 26: autograd.v1.CreateAssignmentRequest.attempt_policy:type_name -> autograd.v1.AssignmentAttemptPolicy
+	17,  // 27: autograd.v1.CreateAssignmentRequest.late_policy:type_name -> autograd.v1.AssignmentLatePolicy
+	15,  // 28: autograd.v1.CreateAssignmentRequest.harness_files:type_name -> autograd.v1.AssignmentHarnessFile
+	9,   // 29: autograd.v1.AssignmentTestCase.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	30,  // 30: autograd.v1.FindAllAssignmentTestCasesResponse.test_cases:type_name -> autograd.v1.AssignmentTestCase
+	9,   // 31: autograd.v1.AssignmentExtension.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	35,  // 32: autograd.v1.FindAllAssignmentExtensionsResponse.extensions:type_name -> autograd.v1.AssignmentExtension
+	9,   // 33: autograd.v1.AssignmentRubricCriterion.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	40,  // 34: autograd.v1.FindAllAssignmentRubricCriteriaResponse.criteria:type_name -> autograd.v1.AssignmentRubricCriterion
+	93,  // 35: autograd.v1.GradeSubmissionRequest.rubric_scores:type_name -> autograd.v1.GradeSubmissionRequest.RubricScore
+	9,   // 36: autograd.v1.SubmissionGradeAudit.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	47,  // 37: autograd.v1.FindAllSubmissionGradeAuditsResponse.audits:type_name -> autograd.v1.SubmissionGradeAudit
+	94,  // 38: autograd.v1.SubmissionSimilarity.first:type_name -> autograd.v1.SubmissionSimilarity.Source
+	94,  // 39: autograd.v1.SubmissionSimilarity.second:type_name -> autograd.v1.SubmissionSimilarity.Source
+	95,  // 40: autograd.v1.SubmissionSimilarity.regions:type_name -> autograd.v1.SubmissionSimilarity.Region
+	9,   // 41: autograd.v1.SubmissionSimilarity.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	53,  // 42: autograd.v1.FindAllSubmissionSimilaritiesResponse.similarities:type_name -> autograd.v1.SubmissionSimilarity
+	9,   // 43: autograd.v1.Term.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	56,  // 44: autograd.v1.FindAllTermsResponse.terms:type_name -> autograd.v1.Term
+	56,  // 45: autograd.v1.Course.term:type_name -> autograd.v1.Term
+	61,  // 46: autograd.v1.Course.sections:type_name -> autograd.v1.CourseSection
+	9,   // 47: autograd.v1.Course.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	9,   // 48: autograd.v1.CourseSection.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	7,   // 49: autograd.v1.FindAllCoursesRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	60,  // 50: autograd.v1.FindAllCoursesResponse.courses:type_name -> autograd.v1.Course
+	6,   // 51: autograd.v1.FindAllCoursesResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
+	61,  // 52: autograd.v1.CourseEnrollment.section:type_name -> autograd.v1.CourseSection
+	9,   // 53: autograd.v1.CourseEnrollment.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	7,   // 54: autograd.v1.FindAllCourseEnrollmentsRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	67,  // 55: autograd.v1.FindAllCourseEnrollmentsResponse.enrollments:type_name -> autograd.v1.CourseEnrollment
+	6,   // 56: autograd.v1.FindAllCourseEnrollmentsResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
+	7,   // 57: autograd.v1.FindAllAssignmentsRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	14,  // 58: autograd.v1.FindAllAssignmentsResponse.assignments:type_name -> autograd.v1.Assignment
+	6,   // 59: autograd.v1.FindAllAssignmentsResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
+	9,   // 60: autograd.v1.ManagedUser.timestamp_metadata:type_name -> autograd.v1.TimestampMetadata
+	7,   // 61: autograd.v1.FindAllManagedUsersRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	73,  // 62: autograd.v1.FindAllManagedUsersResponse.managed_users:type_name -> autograd.v1.ManagedUser
+	6,   // 63: autograd.v1.FindAllManagedUsersResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
+	7,   // 64: autograd.v1.FindAllSubmissionsForAssignmentRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	96,  // 65: autograd.v1.FindAllSubmissionsForAssignmentResponse.submissions:type_name -> autograd.v1.FindAllSubmissionsForAssignmentResponse.Submission
+	7,   // 66: autograd.v1.FindAllStudentAssignmentsRequest.pagination_request:type_name -> autograd.v1.PaginationRequest
+	80,  // 67: autograd.v1.FindAllStudentAssignmentsResponse.assignments:type_name -> autograd.v1.StudentAssignment
+	6,   // 68: autograd.v1.FindAllStudentAssignmentsResponse.pagination_metadata:type_name -> autograd.v1.PaginationMetadata
+	97,  // 69: autograd.v1.StudentAssignment.submission:type_name -> autograd.v1.StudentAssignment.Submission
+	16,  // 70: autograd.v1.StudentAssignment.attempt_policy:type_name -> autograd.v1.AssignmentAttemptPolicy
+	17,  // 71: autograd.v1.StudentAssignment.late_policy:type_name -> autograd.v1.AssignmentLatePolicy
+	88,  // 72: autograd.v1.SubmissionAttempt.case_results:type_name -> autograd.v1.SubmissionCaseResult
+	18,  // 73: autograd.v1.SubmissionAttempt.lateness:type_name -> autograd.v1.SubmissionLateness
+	81,  // 74: autograd.v1.FindAllSubmissionAttemptsResponse.attempts:type_name -> autograd.v1.SubmissionAttempt
+	22,  // 75: autograd.v1.SubmitStudentSubmissionRequest.source_files:type_name -> autograd.v1.SourceFile
+	22,  // 76: autograd.v1.ResubmitStudentSubmissionRequest.source_files:type_name -> autograd.v1.SourceFile
+	18,  // 77: autograd.v1.FindAllSubmissionsForAssignmentResponse.Submission.lateness:type_name -> autograd.v1.SubmissionLateness
+	88,  // 78: autograd.v1.StudentAssignment.Submission.case_results:type_name -> autograd.v1.SubmissionCaseResult
+	18,  // 79: autograd.v1.StudentAssignment.Submission.lateness:type_name -> autograd.v1.SubmissionLateness
+	22,  // 80: autograd.v1.StudentAssignment.Submission.source_files:type_name -> autograd.v1.SourceFile
+	1,   // 81: autograd.v1.AutogradService.Ping:input_type -> autograd.v1.Empty
+	8,   // 82: autograd.v1.AutogradService.CreateManagedUser:input_type -> autograd.v1.CreateManagedUserRequest
+	92,  // 83: autograd.v1.AutogradService.ActivateManagedUser:input_type -> autograd.v1.ActivateManagedUserRequest
+	74,  // 84: autograd.v1.AutogradService.FindAllManagedUsers:input_type -> autograd.v1.FindAllManagedUsersRequest
+	25,  // 85: autograd.v1.AutogradService.CreateAssignment:input_type -> autograd.v1.CreateAssignmentRequest
+	24,  // 86: autograd.v1.AutogradService.UpdateAssignment:input_type -> autograd.v1.UpdateAssignmentRequest
+	5,   // 87: autograd.v1.AutogradService.DeleteAssignment:input_type -> autograd.v1.DeleteByIDRequest
+	26,  // 88: autograd.v1.AutogradService.CreateSubmission:input_type -> autograd.v1.CreateSubmissionRequest
+	27,  // 89: autograd.v1.AutogradService.UpdateSubmission:input_type -> autograd.v1.UpdateSubmissionRequest
+	5,   // 90: autograd.v1.AutogradService.DeleteSubmission:input_type -> autograd.v1.DeleteByIDRequest
+	31,  // 91: autograd.v1.AutogradService.CreateAssignmentTestCase:input_type -> autograd.v1.CreateAssignmentTestCaseRequest
+	32,  // 92: autograd.v1.AutogradService.UpdateAssignmentTestCase:input_type -> autograd.v1.UpdateAssignmentTestCaseRequest
+	5,   // 93: autograd.v1.AutogradService.DeleteAssignmentTestCase:input_type -> autograd.v1.DeleteByIDRequest
+	36,  // 94: autograd.v1.AutogradService.CreateAssignmentExtension:input_type -> autograd.v1.CreateAssignmentExtensionRequest
+	37,  // 95: autograd.v1.AutogradService.UpdateAssignmentExtension:input_type -> autograd.v1.UpdateAssignmentExtensionRequest
+	5,   // 96: autograd.v1.AutogradService.DeleteAssignmentExtension:input_type -> autograd.v1.DeleteByIDRequest
+	41,  // 97: autograd.v1.AutogradService.CreateAssignmentRubricCriterion:input_type -> autograd.v1.CreateAssignmentRubricCriterionRequest
+	42,  // 98: autograd.v1.AutogradService.UpdateAssignmentRubricCriterion:input_type -> autograd.v1.UpdateAssignmentRubricCriterionRequest
+	5,   // 99: autograd.v1.AutogradService.DeleteAssignmentRubricCriterion:input_type -> autograd.v1.DeleteByIDRequest
+	46,  // 100: autograd.v1.AutogradService.GradeSubmission:input_type -> autograd.v1.GradeSubmissionRequest
+	50,  // 101: autograd.v1.AutogradService.RegradeAssignment:input_type -> autograd.v1.RegradeAssignmentRequest
+	52,  // 102: autograd.v1.AutogradService.CheckAssignmentPlagiarism:input_type -> autograd.v1.CheckAssignmentPlagiarismRequest
+	57,  // 103: autograd.v1.AutogradService.CreateTerm:input_type -> autograd.v1.CreateTermRequest
+	62,  // 104: autograd.v1.AutogradService.CreateCourse:input_type -> autograd.v1.CreateCourseRequest
+	63,  // 105: autograd.v1.AutogradService.UpdateCourse:input_type -> autograd.v1.UpdateCourseRequest
+	5,   // 106: autograd.v1.AutogradService.DeleteCourse:input_type -> autograd.v1.DeleteByIDRequest
+	66,  // 107: autograd.v1.AutogradService.CreateCourseSection:input_type -> autograd.v1.CreateCourseSectionRequest
+	5,   // 108: autograd.v1.AutogradService.DeleteCourseSection:input_type -> autograd.v1.DeleteByIDRequest
+	68,  // 109: autograd.v1.AutogradService.EnrollCourseMember:input_type -> autograd.v1.EnrollCourseMemberRequest
+	5,   // 110: autograd.v1.AutogradService.UnenrollCourseMember:input_type -> autograd.v1.DeleteByIDRequest
+	78,  // 111: autograd.v1.AutogradService.FindAllStudentAssignments:input_type -> autograd.v1.FindAllStudentAssignmentsRequest
+	4,   // 112: autograd.v1.AutogradService.FindStudentAssignment:input_type -> autograd.v1.FindByIDRequest
+	82,  // 113: autograd.v1.AutogradService.FindAllSubmissionAttempts:input_type -> autograd.v1.FindAllSubmissionAttemptsRequest
+	84,  // 114: autograd.v1.AutogradService.DiffSubmissionAttempts:input_type -> autograd.v1.DiffSubmissionAttemptsRequest
+	90,  // 115: autograd.v1.AutogradService.SubmitStudentSubmission:input_type -> autograd.v1.SubmitStudentSubmissionRequest
+	91,  // 116: autograd.v1.AutogradService.ResubmitStudentSubmission:input_type -> autograd.v1.ResubmitStudentSubmissionRequest
+	86,  // 117: autograd.v1.AutogradService.RunCode:input_type -> autograd.v1.RunCodeRequest
+	28,  // 118: autograd.v1.AutogradService.Login:input_type -> autograd.v1.LoginRequest
+	4,   // 119: autograd.v1.AutogradQuery.FindAssignment:input_type -> autograd.v1.FindByIDRequest
+	71,  // 120: autograd.v1.AutogradQuery.FindAllAssignments:input_type -> autograd.v1.FindAllAssignmentsRequest
+	4,   // 121: autograd.v1.AutogradQuery.FindSubmission:input_type -> autograd.v1.FindByIDRequest
+	76,  // 122: autograd.v1.AutogradQuery.FindAllSubmissionForAssignment:input_type -> autograd.v1.FindAllSubmissionsForAssignmentRequest
+	33,  // 123: autograd.v1.AutogradQuery.FindAllAssignmentTestCases:input_type -> autograd.v1.FindAllAssignmentTestCasesRequest
+	38,  // 124: autograd.v1.AutogradQuery.FindAllAssignmentExtensions:input_type -> autograd.v1.FindAllAssignmentExtensionsRequest
+	43,  // 125: autograd.v1.AutogradQuery.FindAllAssignmentRubricCriteria:input_type -> autograd.v1.FindAllAssignmentRubricCriteriaRequest
+	48,  // 126: autograd.v1.AutogradQuery.FindAllSubmissionGradeAudits:input_type -> autograd.v1.FindAllSubmissionGradeAuditsRequest
+	54,  // 127: autograd.v1.AutogradQuery.FindAllSubmissionSimilarities:input_type -> autograd.v1.FindAllSubmissionSimilaritiesRequest
+	58,  // 128: autograd.v1.AutogradQuery.FindAllTerms:input_type -> autograd.v1.FindAllTermsRequest
+	64,  // 129: autograd.v1.AutogradQuery.FindAllCourses:input_type -> autograd.v1.FindAllCoursesRequest
+	4,   // 130: autograd.v1.AutogradQuery.FindCourse:input_type -> autograd.v1.FindByIDRequest
+	69,  // 131: autograd.v1.AutogradQuery.FindAllCourseEnrollments:input_type -> autograd.v1.FindAllCourseEnrollmentsRequest
+	3,   // 132: autograd.v1.AutogradService.Ping:output_type -> autograd.v1.PingResponse
+	2,   // 133: autograd.v1.AutogradService.CreateManagedUser:output_type -> autograd.v1.CreatedResponse
+	1,   // 134: autograd.v1.AutogradService.ActivateManagedUser:output_type -> autograd.v1.Empty
+	75,  // 135: autograd.v1.AutogradService.FindAllManagedUsers:output_type -> autograd.v1.FindAllManagedUsersResponse
+	2,   // 136: autograd.v1.AutogradService.CreateAssignment:output_type -> autograd.v1.CreatedResponse
+	1,   // 137: autograd.v1.AutogradService.UpdateAssignment:output_type -> autograd.v1.Empty
+	1,   // 138: autograd.v1.AutogradService.DeleteAssignment:output_type -> autograd.v1.Empty
+	2,   // 139: autograd.v1.AutogradService.CreateSubmission:output_type -> autograd.v1.CreatedResponse
+	1,   // 140: autograd.v1.AutogradService.UpdateSubmission:output_type -> autograd.v1.Empty
+	1,   // 141: autograd.v1.AutogradService.DeleteSubmission:output_type -> autograd.v1.Empty
+	2,   // 142: autograd.v1.AutogradService.CreateAssignmentTestCase:output_type -> autograd.v1.CreatedResponse
+	1,   // 143: autograd.v1.AutogradService.UpdateAssignmentTestCase:output_type -> autograd.v1.Empty
+	1,   // 144: autograd.v1.AutogradService.DeleteAssignmentTestCase:output_type -> autograd.v1.Empty
+	2,   // 145: autograd.v1.AutogradService.CreateAssignmentExtension:output_type -> autograd.v1.CreatedResponse
+	1,   // 146: autograd.v1.AutogradService.UpdateAssignmentExtension:output_type -> autograd.v1.Empty
+	1,   // 147: autograd.v1.AutogradService.DeleteAssignmentExtension:output_type -> autograd.v1.Empty
+	2,   // 148: autograd.v1.AutogradService.CreateAssignmentRubricCriterion:output_type -> autograd.v1.CreatedResponse
+	1,   // 149: autograd.v1.AutogradService.UpdateAssignmentRubricCriterion:output_type -> autograd.v1.Empty
+	1,   // 150: autograd.v1.AutogradService.DeleteAssignmentRubricCriterion:output_type -> autograd.v1.Empty
+	1,   // 151: autograd.v1.AutogradService.GradeSubmission:output_type -> autograd.v1.Empty
+	51,  // 152: autograd.v1.AutogradService.RegradeAssignment:output_type -> autograd.v1.RegradeAssignmentResponse
+	1,   // 153: autograd.v1.AutogradService.CheckAssignmentPlagiarism:output_type -> autograd.v1.Empty
+	2,   // 154: autograd.v1.AutogradService.CreateTerm:output_type -> autograd.v1.CreatedResponse
+	2,   // 155: autograd.v1.AutogradService.CreateCourse:output_type -> autograd.v1.CreatedResponse
+	1,   // 156: autograd.v1.AutogradService.UpdateCourse:output_type -> autograd.v1.Empty
+	1,   // 157: autograd.v1.AutogradService.DeleteCourse:output_type -> autograd.v1.Empty
+	2,   // 158: autograd.v1.AutogradService.CreateCourseSection:output_type -> autograd.v1.CreatedResponse
+	1,   // 159: autograd.v1.AutogradService.DeleteCourseSection:output_type -> autograd.v1.Empty
+	2,   // 160: autograd.v1.AutogradService.EnrollCourseMember:output_type -> autograd.v1.CreatedResponse
+	1,   // 161: autograd.v1.AutogradService.UnenrollCourseMember:output_type -> autograd.v1.Empty
+	79,  // 162: autograd.v1.AutogradService.FindAllStudentAssignments:output_type -> autograd.v1.FindAllStudentAssignmentsResponse
+	80,  // 163: autograd.v1.AutogradService.FindStudentAssignment:output_type -> autograd.v1.StudentAssignment
+	83,  // 164: autograd.v1.AutogradService.FindAllSubmissionAttempts:output_type -> autograd.v1.FindAllSubmissionAttemptsResponse
+	85,  // 165: autograd.v1.AutogradService.DiffSubmissionAttempts:output_type -> autograd.v1.DiffSubmissionAttemptsResponse
+	2,   // 166: autograd.v1.AutogradService.SubmitStudentSubmission:output_type -> autograd.v1.CreatedResponse
+	1,   // 167: autograd.v1.AutogradService.ResubmitStudentSubmission:output_type -> autograd.v1.Empty
+	87,  // 168: autograd.v1.AutogradService.RunCode:output_type -> autograd.v1.RunCodeResponse
+	29,  // 169: autograd.v1.AutogradService.Login:output_type -> autograd.v1.LoginResponse
+	14,  // 170: autograd.v1.AutogradQuery.FindAssignment:output_type -> autograd.v1.Assignment
+	72,  // 171: autograd.v1.AutogradQuery.FindAllAssignments:output_type -> autograd.v1.FindAllAssignmentsResponse
+	21,  // 172: autograd.v1.AutogradQuery.FindSubmission:output_type -> autograd.v1.Submission
+	77,  // 173: autograd.v1.AutogradQuery.FindAllSubmissionForAssignment:output_type -> autograd.v1.FindAllSubmissionsForAssignmentResponse
+	34,  // 174: autograd.v1.AutogradQuery.FindAllAssignmentTestCases:output_type -> autograd.v1.FindAllAssignmentTestCasesResponse
+	39,  // 175: autograd.v1.AutogradQuery.FindAllAssignmentExtensions:output_type -> autograd.v1.FindAllAssignmentExtensionsResponse
+	44,  // 176: autograd.v1.AutogradQuery.FindAllAssignmentRubricCriteria:output_type -> autograd.v1.FindAllAssignmentRubricCriteriaResponse
+	49,  // 177: autograd.v1.AutogradQuery.FindAllSubmissionGradeAudits:output_type -> autograd.v1.FindAllSubmissionGradeAuditsResponse
+	55,  // 178: autograd.v1.AutogradQuery.FindAllSubmissionSimilarities:output_type -> autograd.v1.FindAllSubmissionSimilaritiesResponse
+	59,  // 179: autograd.v1.AutogradQuery.FindAllTerms:output_type -> autograd.v1.FindAllTermsResponse
+	65,  // 180: autograd.v1.AutogradQuery.FindAllCourses:output_type -> autograd.v1.FindAllCoursesResponse
+	60,  // 181: autograd.v1.AutogradQuery.FindCourse:output_type -> autograd.v1.Course
+	70,  // 182: autograd.v1.AutogradQuery.FindAllCourseEnrollments:output_type -> autograd.v1.FindAllCourseEnrollmentsResponse
+	132, // [132:183] is the sub-list for method output_type
+	81,  // [81:132] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
+}
+
+func init() { file_autograd_v1_autograd_proto_init() }
+func file_autograd_v1_autograd_proto_init() {
+	if File_autograd_v1_autograd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_autograd_v1_autograd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateManagedUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submitter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentHarnessFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentAttemptPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentLatePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionLateness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentChecker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentTestCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssignmentTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentExtensionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssignmentExtensionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentExtensionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentExtensionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentRubricCriterion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentRubricCriterionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3134,8 +9841,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatedResponse); i {
+		file_autograd_v1_autograd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAssignmentRubricCriterionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3146,8 +9853,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_autograd_v1_autograd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentRubricCriteriaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3158,8 +9865,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindByIDRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllAssignmentRubricCriteriaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3170,8 +9877,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteByIDRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionRubricScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3182,8 +9889,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationMetadata); i {
+		file_autograd_v1_autograd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeSubmissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3194,8 +9901,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionGradeAudit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3206,8 +9913,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateManagedUserRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionGradeAuditsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3218,8 +9925,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimestampMetadata); i {
+		file_autograd_v1_autograd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionGradeAuditsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3230,8 +9937,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignmentFile); i {
+		file_autograd_v1_autograd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3242,8 +9949,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmissionFile); i {
+		file_autograd_v1_autograd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeAssignmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3254,8 +9961,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submitter); i {
+		file_autograd_v1_autograd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAssignmentPlagiarismRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3266,8 +9973,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assigner); i {
+		file_autograd_v1_autograd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionSimilarity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3278,8 +9985,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_autograd_v1_autograd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionSimilaritiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3290,8 +9997,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_autograd_v1_autograd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionSimilaritiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3302,8 +10009,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAssignmentRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Term); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3314,8 +10021,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAssignmentRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTermRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3326,8 +10033,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubmissionRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllTermsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3338,8 +10045,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubmissionRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllTermsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3350,8 +10057,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_autograd_v1_autograd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3362,8 +10069,8 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
+		file_autograd_v1_autograd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3374,7 +10081,115 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCourseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllCoursesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllCoursesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCourseSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CourseEnrollment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollCourseMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllCourseEnrollmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllCourseEnrollmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllAssignmentsRequest); i {
 			case 0:
 				return &v.state
@@ -3386,7 +10201,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllAssignmentsResponse); i {
 			case 0:
 				return &v.state
@@ -3398,7 +10213,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ManagedUser); i {
 			case 0:
 				return &v.state
@@ -3410,7 +10225,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllManagedUsersRequest); i {
 			case 0:
 				return &v.state
@@ -3422,7 +10237,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllManagedUsersResponse); i {
 			case 0:
 				return &v.state
@@ -3434,7 +10249,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllSubmissionsForAssignmentRequest); i {
 			case 0:
 				return &v.state
@@ -3446,7 +10261,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllSubmissionsForAssignmentResponse); i {
 			case 0:
 				return &v.state
@@ -3458,7 +10273,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllStudentAssignmentsRequest); i {
 			case 0:
 				return &v.state
@@ -3470,7 +10285,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllStudentAssignmentsResponse); i {
 			case 0:
 				return &v.state
@@ -3482,7 +10297,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StudentAssignment); i {
 			case 0:
 				return &v.state
@@ -3494,7 +10309,103 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionAttemptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAllSubmissionAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSubmissionAttemptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffSubmissionAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionCaseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StudentSubmission); i {
 			case 0:
 				return &v.state
@@ -3506,7 +10417,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmitStudentSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -3518,7 +10429,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResubmitStudentSubmissionRequest); i {
 			case 0:
 				return &v.state
@@ -3530,7 +10441,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActivateManagedUserRequest); i {
 			case 0:
 				return &v.state
@@ -3542,7 +10453,43 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeSubmissionRequest_RubricScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionSimilarity_Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionSimilarity_Region); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autograd_v1_autograd_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAllSubmissionsForAssignmentResponse_Submission); i {
 			case 0:
 				return &v.state
@@ -3554,7 +10501,7 @@ func file_autograd_v1_autograd_proto_init() {
 				return nil
 			}
 		}
-		file_autograd_v1_autograd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_autograd_v1_autograd_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StudentAssignment_Submission); i {
 			case 0:
 				return &v.state
@@ -3567,13 +10514,15 @@ func file_autograd_v1_autograd_proto_init() {
 			}
 		}
 	}
+	file_autograd_v1_autograd_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_autograd_v1_autograd_proto_msgTypes[31].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_autograd_v1_autograd_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   37,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -46,18 +46,6 @@ const (
 	// AutogradServiceFindAllManagedUsersProcedure is the fully-qualified name of the AutogradService's
 	// FindAllManagedUsers RPC.
 	AutogradServiceFindAllManagedUsersProcedure = "/autograd.v1.AutogradService/FindAllManagedUsers"
-	// AutogradServiceFindAssignmentProcedure is the fully-qualified name of the AutogradService's
-	// FindAssignment RPC.
-	AutogradServiceFindAssignmentProcedure = "/autograd.v1.AutogradService/FindAssignment"
-	// AutogradServiceFindAllAssignmentsProcedure is the fully-qualified name of the AutogradService's
-	// FindAllAssignments RPC.
-	AutogradServiceFindAllAssignmentsProcedure = "/autograd.v1.AutogradService/FindAllAssignments"
-	// AutogradServiceFindSubmissionProcedure is the fully-qualified name of the AutogradService's
-	// FindSubmission RPC.
-	AutogradServiceFindSubmissionProcedure = "/autograd.v1.AutogradService/FindSubmission"
-	// AutogradServiceFindAllSubmissionForAssignmentProcedure is the fully-qualified name of the
-	// AutogradService's FindAllSubmissionForAssignment RPC.
-	AutogradServiceFindAllSubmissionForAssignmentProcedure = "/autograd.v1.AutogradService/FindAllSubmissionForAssignment"
 	// AutogradServiceCreateAssignmentProcedure is the fully-qualified name of the AutogradService's
 	// CreateAssignment RPC.
 	AutogradServiceCreateAssignmentProcedure = "/autograd.v1.AutogradService/CreateAssignment"
@@ -76,18 +64,86 @@ const (
 	// AutogradServiceDeleteSubmissionProcedure is the fully-qualified name of the AutogradService's
 	// DeleteSubmission RPC.
 	AutogradServiceDeleteSubmissionProcedure = "/autograd.v1.AutogradService/DeleteSubmission"
+	// AutogradServiceCreateAssignmentTestCaseProcedure is the fully-qualified name of the
+	// AutogradService's CreateAssignmentTestCase RPC.
+	AutogradServiceCreateAssignmentTestCaseProcedure = "/autograd.v1.AutogradService/CreateAssignmentTestCase"
+	// AutogradServiceUpdateAssignmentTestCaseProcedure is the fully-qualified name of the
+	// AutogradService's UpdateAssignmentTestCase RPC.
+	AutogradServiceUpdateAssignmentTestCaseProcedure = "/autograd.v1.AutogradService/UpdateAssignmentTestCase"
+	// AutogradServiceDeleteAssignmentTestCaseProcedure is the fully-qualified name of the
+	// AutogradService's DeleteAssignmentTestCase RPC.
+	AutogradServiceDeleteAssignmentTestCaseProcedure = "/autograd.v1.AutogradService/DeleteAssignmentTestCase"
+	// AutogradServiceCreateAssignmentExtensionProcedure is the fully-qualified name of the
+	// AutogradService's CreateAssignmentExtension RPC.
+	AutogradServiceCreateAssignmentExtensionProcedure = "/autograd.v1.AutogradService/CreateAssignmentExtension"
+	// AutogradServiceUpdateAssignmentExtensionProcedure is the fully-qualified name of the
+	// AutogradService's UpdateAssignmentExtension RPC.
+	AutogradServiceUpdateAssignmentExtensionProcedure = "/autograd.v1.AutogradService/UpdateAssignmentExtension"
+	// AutogradServiceDeleteAssignmentExtensionProcedure is the fully-qualified name of the
+	// AutogradService's DeleteAssignmentExtension RPC.
+	AutogradServiceDeleteAssignmentExtensionProcedure = "/autograd.v1.AutogradService/DeleteAssignmentExtension"
+	// AutogradServiceCreateAssignmentRubricCriterionProcedure is the fully-qualified name of the
+	// AutogradService's CreateAssignmentRubricCriterion RPC.
+	AutogradServiceCreateAssignmentRubricCriterionProcedure = "/autograd.v1.AutogradService/CreateAssignmentRubricCriterion"
+	// AutogradServiceUpdateAssignmentRubricCriterionProcedure is the fully-qualified name of the
+	// AutogradService's UpdateAssignmentRubricCriterion RPC.
+	AutogradServiceUpdateAssignmentRubricCriterionProcedure = "/autograd.v1.AutogradService/UpdateAssignmentRubricCriterion"
+	// AutogradServiceDeleteAssignmentRubricCriterionProcedure is the fully-qualified name of the
+	// AutogradService's DeleteAssignmentRubricCriterion RPC.
+	AutogradServiceDeleteAssignmentRubricCriterionProcedure = "/autograd.v1.AutogradService/DeleteAssignmentRubricCriterion"
+	// AutogradServiceGradeSubmissionProcedure is the fully-qualified name of the AutogradService's
+	// GradeSubmission RPC.
+	AutogradServiceGradeSubmissionProcedure = "/autograd.v1.AutogradService/GradeSubmission"
+	// AutogradServiceRegradeAssignmentProcedure is the fully-qualified name of the AutogradService's
+	// RegradeAssignment RPC.
+	AutogradServiceRegradeAssignmentProcedure = "/autograd.v1.AutogradService/RegradeAssignment"
+	// AutogradServiceCheckAssignmentPlagiarismProcedure is the fully-qualified name of the
+	// AutogradService's CheckAssignmentPlagiarism RPC.
+	AutogradServiceCheckAssignmentPlagiarismProcedure = "/autograd.v1.AutogradService/CheckAssignmentPlagiarism"
+	// AutogradServiceCreateTermProcedure is the fully-qualified name of the AutogradService's
+	// CreateTerm RPC.
+	AutogradServiceCreateTermProcedure = "/autograd.v1.AutogradService/CreateTerm"
+	// AutogradServiceCreateCourseProcedure is the fully-qualified name of the AutogradService's
+	// CreateCourse RPC.
+	AutogradServiceCreateCourseProcedure = "/autograd.v1.AutogradService/CreateCourse"
+	// AutogradServiceUpdateCourseProcedure is the fully-qualified name of the AutogradService's
+	// UpdateCourse RPC.
+	AutogradServiceUpdateCourseProcedure = "/autograd.v1.AutogradService/UpdateCourse"
+	// AutogradServiceDeleteCourseProcedure is the fully-qualified name of the AutogradService's
+	// DeleteCourse RPC.
+	AutogradServiceDeleteCourseProcedure = "/autograd.v1.AutogradService/DeleteCourse"
+	// AutogradServiceCreateCourseSectionProcedure is the fully-qualified name of the AutogradService's
+	// CreateCourseSection RPC.
+	AutogradServiceCreateCourseSectionProcedure = "/autograd.v1.AutogradService/CreateCourseSection"
+	// AutogradServiceDeleteCourseSectionProcedure is the fully-qualified name of the AutogradService's
+	// DeleteCourseSection RPC.
+	AutogradServiceDeleteCourseSectionProcedure = "/autograd.v1.AutogradService/DeleteCourseSection"
+	// AutogradServiceEnrollCourseMemberProcedure is the fully-qualified name of the AutogradService's
+	// EnrollCourseMember RPC.
+	AutogradServiceEnrollCourseMemberProcedure = "/autograd.v1.AutogradService/EnrollCourseMember"
+	// AutogradServiceUnenrollCourseMemberProcedure is the fully-qualified name of the AutogradService's
+	// UnenrollCourseMember RPC.
+	AutogradServiceUnenrollCourseMemberProcedure = "/autograd.v1.AutogradService/UnenrollCourseMember"
 	// AutogradServiceFindAllStudentAssignmentsProcedure is the fully-qualified name of the
 	// AutogradService's FindAllStudentAssignments RPC.
 	AutogradServiceFindAllStudentAssignmentsProcedure = "/autograd.v1.AutogradService/FindAllStudentAssignments"
 	// AutogradServiceFindStudentAssignmentProcedure is the fully-qualified name of the
 	// AutogradService's FindStudentAssignment RPC.
 	AutogradServiceFindStudentAssignmentProcedure = "/autograd.v1.AutogradService/FindStudentAssignment"
+	// AutogradServiceFindAllSubmissionAttemptsProcedure is the fully-qualified name of the
+	// AutogradService's FindAllSubmissionAttempts RPC.
+	AutogradServiceFindAllSubmissionAttemptsProcedure = "/autograd.v1.AutogradService/FindAllSubmissionAttempts"
+	// AutogradServiceDiffSubmissionAttemptsProcedure is the fully-qualified name of the
+	// AutogradService's DiffSubmissionAttempts RPC.
+	AutogradServiceDiffSubmissionAttemptsProcedure = "/autograd.v1.AutogradService/DiffSubmissionAttempts"
 	// AutogradServiceSubmitStudentSubmissionProcedure is the fully-qualified name of the
 	// AutogradService's SubmitStudentSubmission RPC.
 	AutogradServiceSubmitStudentSubmissionProcedure = "/autograd.v1.AutogradService/SubmitStudentSubmission"
 	// AutogradServiceResubmitStudentSubmissionProcedure is the fully-qualified name of the
 	// AutogradService's ResubmitStudentSubmission RPC.
 	AutogradServiceResubmitStudentSubmissionProcedure = "/autograd.v1.AutogradService/ResubmitStudentSubmission"
+	// AutogradServiceRunCodeProcedure is the fully-qualified name of the AutogradService's RunCode RPC.
+	AutogradServiceRunCodeProcedure = "/autograd.v1.AutogradService/RunCode"
 	// AutogradServiceLoginProcedure is the fully-qualified name of the AutogradService's Login RPC.
 	AutogradServiceLoginProcedure = "/autograd.v1.AutogradService/Login"
 	// AutogradQueryFindAssignmentProcedure is the fully-qualified name of the AutogradQuery's
@@ -102,35 +158,90 @@ const (
 	// AutogradQueryFindAllSubmissionForAssignmentProcedure is the fully-qualified name of the
 	// AutogradQuery's FindAllSubmissionForAssignment RPC.
 	AutogradQueryFindAllSubmissionForAssignmentProcedure = "/autograd.v1.AutogradQuery/FindAllSubmissionForAssignment"
+	// AutogradQueryFindAllAssignmentTestCasesProcedure is the fully-qualified name of the
+	// AutogradQuery's FindAllAssignmentTestCases RPC.
+	AutogradQueryFindAllAssignmentTestCasesProcedure = "/autograd.v1.AutogradQuery/FindAllAssignmentTestCases"
+	// AutogradQueryFindAllAssignmentExtensionsProcedure is the fully-qualified name of the
+	// AutogradQuery's FindAllAssignmentExtensions RPC.
+	AutogradQueryFindAllAssignmentExtensionsProcedure = "/autograd.v1.AutogradQuery/FindAllAssignmentExtensions"
+	// AutogradQueryFindAllAssignmentRubricCriteriaProcedure is the fully-qualified name of the
+	// AutogradQuery's FindAllAssignmentRubricCriteria RPC.
+	AutogradQueryFindAllAssignmentRubricCriteriaProcedure = "/autograd.v1.AutogradQuery/FindAllAssignmentRubricCriteria"
+	// AutogradQueryFindAllSubmissionGradeAuditsProcedure is the fully-qualified name of the
+	// AutogradQuery's FindAllSubmissionGradeAudits RPC.
+	AutogradQueryFindAllSubmissionGradeAuditsProcedure = "/autograd.v1.AutogradQuery/FindAllSubmissionGradeAudits"
+	// AutogradQueryFindAllSubmissionSimilaritiesProcedure is the fully-qualified name of the
+	// AutogradQuery's FindAllSubmissionSimilarities RPC.
+	AutogradQueryFindAllSubmissionSimilaritiesProcedure = "/autograd.v1.AutogradQuery/FindAllSubmissionSimilarities"
+	// AutogradQueryFindAllTermsProcedure is the fully-qualified name of the AutogradQuery's
+	// FindAllTerms RPC.
+	AutogradQueryFindAllTermsProcedure = "/autograd.v1.AutogradQuery/FindAllTerms"
+	// AutogradQueryFindAllCoursesProcedure is the fully-qualified name of the AutogradQuery's
+	// FindAllCourses RPC.
+	AutogradQueryFindAllCoursesProcedure = "/autograd.v1.AutogradQuery/FindAllCourses"
+	// AutogradQueryFindCourseProcedure is the fully-qualified name of the AutogradQuery's FindCourse
+	// RPC.
+	AutogradQueryFindCourseProcedure = "/autograd.v1.AutogradQuery/FindCourse"
+	// AutogradQueryFindAllCourseEnrollmentsProcedure is the fully-qualified name of the AutogradQuery's
+	// FindAllCourseEnrollments RPC.
+	AutogradQueryFindAllCourseEnrollmentsProcedure = "/autograd.v1.AutogradQuery/FindAllCourseEnrollments"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	autogradServiceServiceDescriptor                              = v1.File_autograd_v1_autograd_proto.Services().ByName("AutogradService")
-	autogradServicePingMethodDescriptor                           = autogradServiceServiceDescriptor.Methods().ByName("Ping")
-	autogradServiceCreateManagedUserMethodDescriptor              = autogradServiceServiceDescriptor.Methods().ByName("CreateManagedUser")
-	autogradServiceActivateManagedUserMethodDescriptor            = autogradServiceServiceDescriptor.Methods().ByName("ActivateManagedUser")
-	autogradServiceFindAllManagedUsersMethodDescriptor            = autogradServiceServiceDescriptor.Methods().ByName("FindAllManagedUsers")
-	autogradServiceFindAssignmentMethodDescriptor                 = autogradServiceServiceDescriptor.Methods().ByName("FindAssignment")
-	autogradServiceFindAllAssignmentsMethodDescriptor             = autogradServiceServiceDescriptor.Methods().ByName("FindAllAssignments")
-	autogradServiceFindSubmissionMethodDescriptor                 = autogradServiceServiceDescriptor.Methods().ByName("FindSubmission")
-	autogradServiceFindAllSubmissionForAssignmentMethodDescriptor = autogradServiceServiceDescriptor.Methods().ByName("FindAllSubmissionForAssignment")
-	autogradServiceCreateAssignmentMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("CreateAssignment")
-	autogradServiceUpdateAssignmentMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("UpdateAssignment")
-	autogradServiceDeleteAssignmentMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("DeleteAssignment")
-	autogradServiceCreateSubmissionMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("CreateSubmission")
-	autogradServiceUpdateSubmissionMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("UpdateSubmission")
-	autogradServiceDeleteSubmissionMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("DeleteSubmission")
-	autogradServiceFindAllStudentAssignmentsMethodDescriptor      = autogradServiceServiceDescriptor.Methods().ByName("FindAllStudentAssignments")
-	autogradServiceFindStudentAssignmentMethodDescriptor          = autogradServiceServiceDescriptor.Methods().ByName("FindStudentAssignment")
-	autogradServiceSubmitStudentSubmissionMethodDescriptor        = autogradServiceServiceDescriptor.Methods().ByName("SubmitStudentSubmission")
-	autogradServiceResubmitStudentSubmissionMethodDescriptor      = autogradServiceServiceDescriptor.Methods().ByName("ResubmitStudentSubmission")
-	autogradServiceLoginMethodDescriptor                          = autogradServiceServiceDescriptor.Methods().ByName("Login")
-	autogradQueryServiceDescriptor                                = v1.File_autograd_v1_autograd_proto.Services().ByName("AutogradQuery")
-	autogradQueryFindAssignmentMethodDescriptor                   = autogradQueryServiceDescriptor.Methods().ByName("FindAssignment")
-	autogradQueryFindAllAssignmentsMethodDescriptor               = autogradQueryServiceDescriptor.Methods().ByName("FindAllAssignments")
-	autogradQueryFindSubmissionMethodDescriptor                   = autogradQueryServiceDescriptor.Methods().ByName("FindSubmission")
-	autogradQueryFindAllSubmissionForAssignmentMethodDescriptor   = autogradQueryServiceDescriptor.Methods().ByName("FindAllSubmissionForAssignment")
+	autogradServiceServiceDescriptor                               = v1.File_autograd_v1_autograd_proto.Services().ByName("AutogradService")
+	autogradServicePingMethodDescriptor                            = autogradServiceServiceDescriptor.Methods().ByName("Ping")
+	autogradServiceCreateManagedUserMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("CreateManagedUser")
+	autogradServiceActivateManagedUserMethodDescriptor             = autogradServiceServiceDescriptor.Methods().ByName("ActivateManagedUser")
+	autogradServiceFindAllManagedUsersMethodDescriptor             = autogradServiceServiceDescriptor.Methods().ByName("FindAllManagedUsers")
+	autogradServiceCreateAssignmentMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("CreateAssignment")
+	autogradServiceUpdateAssignmentMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("UpdateAssignment")
+	autogradServiceDeleteAssignmentMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("DeleteAssignment")
+	autogradServiceCreateSubmissionMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("CreateSubmission")
+	autogradServiceUpdateSubmissionMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("UpdateSubmission")
+	autogradServiceDeleteSubmissionMethodDescriptor                = autogradServiceServiceDescriptor.Methods().ByName("DeleteSubmission")
+	autogradServiceCreateAssignmentTestCaseMethodDescriptor        = autogradServiceServiceDescriptor.Methods().ByName("CreateAssignmentTestCase")
+	autogradServiceUpdateAssignmentTestCaseMethodDescriptor        = autogradServiceServiceDescriptor.Methods().ByName("UpdateAssignmentTestCase")
+	autogradServiceDeleteAssignmentTestCaseMethodDescriptor        = autogradServiceServiceDescriptor.Methods().ByName("DeleteAssignmentTestCase")
+	autogradServiceCreateAssignmentExtensionMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("CreateAssignmentExtension")
+	autogradServiceUpdateAssignmentExtensionMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("UpdateAssignmentExtension")
+	autogradServiceDeleteAssignmentExtensionMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("DeleteAssignmentExtension")
+	autogradServiceCreateAssignmentRubricCriterionMethodDescriptor = autogradServiceServiceDescriptor.Methods().ByName("CreateAssignmentRubricCriterion")
+	autogradServiceUpdateAssignmentRubricCriterionMethodDescriptor = autogradServiceServiceDescriptor.Methods().ByName("UpdateAssignmentRubricCriterion")
+	autogradServiceDeleteAssignmentRubricCriterionMethodDescriptor = autogradServiceServiceDescriptor.Methods().ByName("DeleteAssignmentRubricCriterion")
+	autogradServiceGradeSubmissionMethodDescriptor                 = autogradServiceServiceDescriptor.Methods().ByName("GradeSubmission")
+	autogradServiceRegradeAssignmentMethodDescriptor               = autogradServiceServiceDescriptor.Methods().ByName("RegradeAssignment")
+	autogradServiceCheckAssignmentPlagiarismMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("CheckAssignmentPlagiarism")
+	autogradServiceCreateTermMethodDescriptor                      = autogradServiceServiceDescriptor.Methods().ByName("CreateTerm")
+	autogradServiceCreateCourseMethodDescriptor                    = autogradServiceServiceDescriptor.Methods().ByName("CreateCourse")
+	autogradServiceUpdateCourseMethodDescriptor                    = autogradServiceServiceDescriptor.Methods().ByName("UpdateCourse")
+	autogradServiceDeleteCourseMethodDescriptor                    = autogradServiceServiceDescriptor.Methods().ByName("DeleteCourse")
+	autogradServiceCreateCourseSectionMethodDescriptor             = autogradServiceServiceDescriptor.Methods().ByName("CreateCourseSection")
+	autogradServiceDeleteCourseSectionMethodDescriptor             = autogradServiceServiceDescriptor.Methods().ByName("DeleteCourseSection")
+	autogradServiceEnrollCourseMemberMethodDescriptor              = autogradServiceServiceDescriptor.Methods().ByName("EnrollCourseMember")
+	autogradServiceUnenrollCourseMemberMethodDescriptor            = autogradServiceServiceDescriptor.Methods().ByName("UnenrollCourseMember")
+	autogradServiceFindAllStudentAssignmentsMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("FindAllStudentAssignments")
+	autogradServiceFindStudentAssignmentMethodDescriptor           = autogradServiceServiceDescriptor.Methods().ByName("FindStudentAssignment")
+	autogradServiceFindAllSubmissionAttemptsMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("FindAllSubmissionAttempts")
+	autogradServiceDiffSubmissionAttemptsMethodDescriptor          = autogradServiceServiceDescriptor.Methods().ByName("DiffSubmissionAttempts")
+	autogradServiceSubmitStudentSubmissionMethodDescriptor         = autogradServiceServiceDescriptor.Methods().ByName("SubmitStudentSubmission")
+	autogradServiceResubmitStudentSubmissionMethodDescriptor       = autogradServiceServiceDescriptor.Methods().ByName("ResubmitStudentSubmission")
+	autogradServiceRunCodeMethodDescriptor                         = autogradServiceServiceDescriptor.Methods().ByName("RunCode")
+	autogradServiceLoginMethodDescriptor                           = autogradServiceServiceDescriptor.Methods().ByName("Login")
+	autogradQueryServiceDescriptor                                 = v1.File_autograd_v1_autograd_proto.Services().ByName("AutogradQuery")
+	autogradQueryFindAssignmentMethodDescriptor                    = autogradQueryServiceDescriptor.Methods().ByName("FindAssignment")
+	autogradQueryFindAllAssignmentsMethodDescriptor                = autogradQueryServiceDescriptor.Methods().ByName("FindAllAssignments")
+	autogradQueryFindSubmissionMethodDescriptor                    = autogradQueryServiceDescriptor.Methods().ByName("FindSubmission")
+	autogradQueryFindAllSubmissionForAssignmentMethodDescriptor    = autogradQueryServiceDescriptor.Methods().ByName("FindAllSubmissionForAssignment")
+	autogradQueryFindAllAssignmentTestCasesMethodDescriptor        = autogradQueryServiceDescriptor.Methods().ByName("FindAllAssignmentTestCases")
+	autogradQueryFindAllAssignmentExtensionsMethodDescriptor       = autogradQueryServiceDescriptor.Methods().ByName("FindAllAssignmentExtensions")
+	autogradQueryFindAllAssignmentRubricCriteriaMethodDescriptor   = autogradQueryServiceDescriptor.Methods().ByName("FindAllAssignmentRubricCriteria")
+	autogradQueryFindAllSubmissionGradeAuditsMethodDescriptor      = autogradQueryServiceDescriptor.Methods().ByName("FindAllSubmissionGradeAudits")
+	autogradQueryFindAllSubmissionSimilaritiesMethodDescriptor     = autogradQueryServiceDescriptor.Methods().ByName("FindAllSubmissionSimilarities")
+	autogradQueryFindAllTermsMethodDescriptor                      = autogradQueryServiceDescriptor.Methods().ByName("FindAllTerms")
+	autogradQueryFindAllCoursesMethodDescriptor                    = autogradQueryServiceDescriptor.Methods().ByName("FindAllCourses")
+	autogradQueryFindCourseMethodDescriptor                        = autogradQueryServiceDescriptor.Methods().ByName("FindCourse")
+	autogradQueryFindAllCourseEnrollmentsMethodDescriptor          = autogradQueryServiceDescriptor.Methods().ByName("FindAllCourseEnrollments")
 )
 
 // AutogradServiceClient is a client for the autograd.v1.AutogradService service.
@@ -142,10 +253,10 @@ type AutogradServiceClient interface {
 	FindAllManagedUsers(context.Context, *connect.Request[v1.FindAllManagedUsersRequest]) (*connect.Response[v1.FindAllManagedUsersResponse], error)
 	// Assignment Submission
 	// Assignment Queries
-	FindAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Assignment], error)
-	FindAllAssignments(context.Context, *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error)
-	FindSubmission(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error)
-	FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error)
+	// rpc FindAssignment(FindByIDRequest) returns (Assignment) {}
+	// rpc FindAllAssignments(FindAllAssignmentsRequest) returns (FindAllAssignmentsResponse) {}
+	// rpc FindSubmission(FindByIDRequest) returns (Submission) {}
+	// rpc FindAllSubmissionForAssignment(FindAllSubmissionsForAssignmentRequest) returns (FindAllSubmissionsForAssignmentResponse) {}
 	// Assignment Command
 	CreateAssignment(context.Context, *connect.Request[v1.CreateAssignmentRequest]) (*connect.Response[v1.CreatedResponse], error)
 	UpdateAssignment(context.Context, *connect.Request[v1.UpdateAssignmentRequest]) (*connect.Response[v1.Empty], error)
@@ -153,13 +264,39 @@ type AutogradServiceClient interface {
 	CreateSubmission(context.Context, *connect.Request[v1.CreateSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error)
 	UpdateSubmission(context.Context, *connect.Request[v1.UpdateSubmissionRequest]) (*connect.Response[v1.Empty], error)
 	DeleteSubmission(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentTestCase(context.Context, *connect.Request[v1.CreateAssignmentTestCaseRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentTestCase(context.Context, *connect.Request[v1.UpdateAssignmentTestCaseRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentTestCase(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentExtension(context.Context, *connect.Request[v1.CreateAssignmentExtensionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentExtension(context.Context, *connect.Request[v1.UpdateAssignmentExtensionRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentExtension(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentRubricCriterion(context.Context, *connect.Request[v1.CreateAssignmentRubricCriterionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentRubricCriterion(context.Context, *connect.Request[v1.UpdateAssignmentRubricCriterionRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentRubricCriterion(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	GradeSubmission(context.Context, *connect.Request[v1.GradeSubmissionRequest]) (*connect.Response[v1.Empty], error)
+	RegradeAssignment(context.Context, *connect.Request[v1.RegradeAssignmentRequest]) (*connect.Response[v1.RegradeAssignmentResponse], error)
+	CheckAssignmentPlagiarism(context.Context, *connect.Request[v1.CheckAssignmentPlagiarismRequest]) (*connect.Response[v1.Empty], error)
+	// Course
+	// Course Command
+	CreateTerm(context.Context, *connect.Request[v1.CreateTermRequest]) (*connect.Response[v1.CreatedResponse], error)
+	CreateCourse(context.Context, *connect.Request[v1.CreateCourseRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateCourse(context.Context, *connect.Request[v1.UpdateCourseRequest]) (*connect.Response[v1.Empty], error)
+	DeleteCourse(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateCourseSection(context.Context, *connect.Request[v1.CreateCourseSectionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	DeleteCourseSection(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	EnrollCourseMember(context.Context, *connect.Request[v1.EnrollCourseMemberRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UnenrollCourseMember(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
 	// Student Assignment
 	// Student Assignment Queries
 	FindAllStudentAssignments(context.Context, *connect.Request[v1.FindAllStudentAssignmentsRequest]) (*connect.Response[v1.FindAllStudentAssignmentsResponse], error)
 	FindStudentAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.StudentAssignment], error)
+	FindAllSubmissionAttempts(context.Context, *connect.Request[v1.FindAllSubmissionAttemptsRequest]) (*connect.Response[v1.FindAllSubmissionAttemptsResponse], error)
+	DiffSubmissionAttempts(context.Context, *connect.Request[v1.DiffSubmissionAttemptsRequest]) (*connect.Response[v1.DiffSubmissionAttemptsResponse], error)
 	// Student Assignment Command
 	SubmitStudentSubmission(context.Context, *connect.Request[v1.SubmitStudentSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error)
 	ResubmitStudentSubmission(context.Context, *connect.Request[v1.ResubmitStudentSubmissionRequest]) (*connect.Response[v1.Empty], error)
+	// RunCode runs the code against a custom input without creating a submission
+	RunCode(context.Context, *connect.Request[v1.RunCodeRequest]) (*connect.Response[v1.RunCodeResponse], error)
 	// Auth
 	// Auth Mutation
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
@@ -199,30 +336,6 @@ func NewAutogradServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(autogradServiceFindAllManagedUsersMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		findAssignment: connect.NewClient[v1.FindByIDRequest, v1.Assignment](
-			httpClient,
-			baseURL+AutogradServiceFindAssignmentProcedure,
-			connect.WithSchema(autogradServiceFindAssignmentMethodDescriptor),
-			connect.WithClientOptions(opts...),
-		),
-		findAllAssignments: connect.NewClient[v1.FindAllAssignmentsRequest, v1.FindAllAssignmentsResponse](
-			httpClient,
-			baseURL+AutogradServiceFindAllAssignmentsProcedure,
-			connect.WithSchema(autogradServiceFindAllAssignmentsMethodDescriptor),
-			connect.WithClientOptions(opts...),
-		),
-		findSubmission: connect.NewClient[v1.FindByIDRequest, v1.Submission](
-			httpClient,
-			baseURL+AutogradServiceFindSubmissionProcedure,
-			connect.WithSchema(autogradServiceFindSubmissionMethodDescriptor),
-			connect.WithClientOptions(opts...),
-		),
-		findAllSubmissionForAssignment: connect.NewClient[v1.FindAllSubmissionsForAssignmentRequest, v1.FindAllSubmissionsForAssignmentResponse](
-			httpClient,
-			baseURL+AutogradServiceFindAllSubmissionForAssignmentProcedure,
-			connect.WithSchema(autogradServiceFindAllSubmissionForAssignmentMethodDescriptor),
-			connect.WithClientOptions(opts...),
-		),
 		createAssignment: connect.NewClient[v1.CreateAssignmentRequest, v1.CreatedResponse](
 			httpClient,
 			baseURL+AutogradServiceCreateAssignmentProcedure,
@@ -259,6 +372,126 @@ func NewAutogradServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(autogradServiceDeleteSubmissionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createAssignmentTestCase: connect.NewClient[v1.CreateAssignmentTestCaseRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateAssignmentTestCaseProcedure,
+			connect.WithSchema(autogradServiceCreateAssignmentTestCaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAssignmentTestCase: connect.NewClient[v1.UpdateAssignmentTestCaseRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceUpdateAssignmentTestCaseProcedure,
+			connect.WithSchema(autogradServiceUpdateAssignmentTestCaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAssignmentTestCase: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceDeleteAssignmentTestCaseProcedure,
+			connect.WithSchema(autogradServiceDeleteAssignmentTestCaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createAssignmentExtension: connect.NewClient[v1.CreateAssignmentExtensionRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateAssignmentExtensionProcedure,
+			connect.WithSchema(autogradServiceCreateAssignmentExtensionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAssignmentExtension: connect.NewClient[v1.UpdateAssignmentExtensionRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceUpdateAssignmentExtensionProcedure,
+			connect.WithSchema(autogradServiceUpdateAssignmentExtensionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAssignmentExtension: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceDeleteAssignmentExtensionProcedure,
+			connect.WithSchema(autogradServiceDeleteAssignmentExtensionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createAssignmentRubricCriterion: connect.NewClient[v1.CreateAssignmentRubricCriterionRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateAssignmentRubricCriterionProcedure,
+			connect.WithSchema(autogradServiceCreateAssignmentRubricCriterionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateAssignmentRubricCriterion: connect.NewClient[v1.UpdateAssignmentRubricCriterionRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceUpdateAssignmentRubricCriterionProcedure,
+			connect.WithSchema(autogradServiceUpdateAssignmentRubricCriterionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAssignmentRubricCriterion: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceDeleteAssignmentRubricCriterionProcedure,
+			connect.WithSchema(autogradServiceDeleteAssignmentRubricCriterionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		gradeSubmission: connect.NewClient[v1.GradeSubmissionRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceGradeSubmissionProcedure,
+			connect.WithSchema(autogradServiceGradeSubmissionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		regradeAssignment: connect.NewClient[v1.RegradeAssignmentRequest, v1.RegradeAssignmentResponse](
+			httpClient,
+			baseURL+AutogradServiceRegradeAssignmentProcedure,
+			connect.WithSchema(autogradServiceRegradeAssignmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkAssignmentPlagiarism: connect.NewClient[v1.CheckAssignmentPlagiarismRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceCheckAssignmentPlagiarismProcedure,
+			connect.WithSchema(autogradServiceCheckAssignmentPlagiarismMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createTerm: connect.NewClient[v1.CreateTermRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateTermProcedure,
+			connect.WithSchema(autogradServiceCreateTermMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createCourse: connect.NewClient[v1.CreateCourseRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateCourseProcedure,
+			connect.WithSchema(autogradServiceCreateCourseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateCourse: connect.NewClient[v1.UpdateCourseRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceUpdateCourseProcedure,
+			connect.WithSchema(autogradServiceUpdateCourseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCourse: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceDeleteCourseProcedure,
+			connect.WithSchema(autogradServiceDeleteCourseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createCourseSection: connect.NewClient[v1.CreateCourseSectionRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceCreateCourseSectionProcedure,
+			connect.WithSchema(autogradServiceCreateCourseSectionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCourseSection: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceDeleteCourseSectionProcedure,
+			connect.WithSchema(autogradServiceDeleteCourseSectionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		enrollCourseMember: connect.NewClient[v1.EnrollCourseMemberRequest, v1.CreatedResponse](
+			httpClient,
+			baseURL+AutogradServiceEnrollCourseMemberProcedure,
+			connect.WithSchema(autogradServiceEnrollCourseMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unenrollCourseMember: connect.NewClient[v1.DeleteByIDRequest, v1.Empty](
+			httpClient,
+			baseURL+AutogradServiceUnenrollCourseMemberProcedure,
+			connect.WithSchema(autogradServiceUnenrollCourseMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		findAllStudentAssignments: connect.NewClient[v1.FindAllStudentAssignmentsRequest, v1.FindAllStudentAssignmentsResponse](
 			httpClient,
 			baseURL+AutogradServiceFindAllStudentAssignmentsProcedure,
@@ -271,6 +504,18 @@ func NewAutogradServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(autogradServiceFindStudentAssignmentMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		findAllSubmissionAttempts: connect.NewClient[v1.FindAllSubmissionAttemptsRequest, v1.FindAllSubmissionAttemptsResponse](
+			httpClient,
+			baseURL+AutogradServiceFindAllSubmissionAttemptsProcedure,
+			connect.WithSchema(autogradServiceFindAllSubmissionAttemptsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		diffSubmissionAttempts: connect.NewClient[v1.DiffSubmissionAttemptsRequest, v1.DiffSubmissionAttemptsResponse](
+			httpClient,
+			baseURL+AutogradServiceDiffSubmissionAttemptsProcedure,
+			connect.WithSchema(autogradServiceDiffSubmissionAttemptsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		submitStudentSubmission: connect.NewClient[v1.SubmitStudentSubmissionRequest, v1.CreatedResponse](
 			httpClient,
 			baseURL+AutogradServiceSubmitStudentSubmissionProcedure,
@@ -283,6 +528,12 @@ func NewAutogradServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(autogradServiceResubmitStudentSubmissionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		runCode: connect.NewClient[v1.RunCodeRequest, v1.RunCodeResponse](
+			httpClient,
+			baseURL+AutogradServiceRunCodeProcedure,
+			connect.WithSchema(autogradServiceRunCodeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
 			httpClient,
 			baseURL+AutogradServiceLoginProcedure,
@@ -294,25 +545,44 @@ func NewAutogradServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 
 // autogradServiceClient implements AutogradServiceClient.
 type autogradServiceClient struct {
-	ping                           *connect.Client[v1.Empty, v1.PingResponse]
-	createManagedUser              *connect.Client[v1.CreateManagedUserRequest, v1.CreatedResponse]
-	activateManagedUser            *connect.Client[v1.ActivateManagedUserRequest, v1.Empty]
-	findAllManagedUsers            *connect.Client[v1.FindAllManagedUsersRequest, v1.FindAllManagedUsersResponse]
-	findAssignment                 *connect.Client[v1.FindByIDRequest, v1.Assignment]
-	findAllAssignments             *connect.Client[v1.FindAllAssignmentsRequest, v1.FindAllAssignmentsResponse]
-	findSubmission                 *connect.Client[v1.FindByIDRequest, v1.Submission]
-	findAllSubmissionForAssignment *connect.Client[v1.FindAllSubmissionsForAssignmentRequest, v1.FindAllSubmissionsForAssignmentResponse]
-	createAssignment               *connect.Client[v1.CreateAssignmentRequest, v1.CreatedResponse]
-	updateAssignment               *connect.Client[v1.UpdateAssignmentRequest, v1.Empty]
-	deleteAssignment               *connect.Client[v1.DeleteByIDRequest, v1.Empty]
-	createSubmission               *connect.Client[v1.CreateSubmissionRequest, v1.CreatedResponse]
-	updateSubmission               *connect.Client[v1.UpdateSubmissionRequest, v1.Empty]
-	deleteSubmission               *connect.Client[v1.DeleteByIDRequest, v1.Empty]
-	findAllStudentAssignments      *connect.Client[v1.FindAllStudentAssignmentsRequest, v1.FindAllStudentAssignmentsResponse]
-	findStudentAssignment          *connect.Client[v1.FindByIDRequest, v1.StudentAssignment]
-	submitStudentSubmission        *connect.Client[v1.SubmitStudentSubmissionRequest, v1.CreatedResponse]
-	resubmitStudentSubmission      *connect.Client[v1.ResubmitStudentSubmissionRequest, v1.Empty]
-	login                          *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	ping                            *connect.Client[v1.Empty, v1.PingResponse]
+	createManagedUser               *connect.Client[v1.CreateManagedUserRequest, v1.CreatedResponse]
+	activateManagedUser             *connect.Client[v1.ActivateManagedUserRequest, v1.Empty]
+	findAllManagedUsers             *connect.Client[v1.FindAllManagedUsersRequest, v1.FindAllManagedUsersResponse]
+	createAssignment                *connect.Client[v1.CreateAssignmentRequest, v1.CreatedResponse]
+	updateAssignment                *connect.Client[v1.UpdateAssignmentRequest, v1.Empty]
+	deleteAssignment                *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	createSubmission                *connect.Client[v1.CreateSubmissionRequest, v1.CreatedResponse]
+	updateSubmission                *connect.Client[v1.UpdateSubmissionRequest, v1.Empty]
+	deleteSubmission                *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	createAssignmentTestCase        *connect.Client[v1.CreateAssignmentTestCaseRequest, v1.CreatedResponse]
+	updateAssignmentTestCase        *connect.Client[v1.UpdateAssignmentTestCaseRequest, v1.Empty]
+	deleteAssignmentTestCase        *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	createAssignmentExtension       *connect.Client[v1.CreateAssignmentExtensionRequest, v1.CreatedResponse]
+	updateAssignmentExtension       *connect.Client[v1.UpdateAssignmentExtensionRequest, v1.Empty]
+	deleteAssignmentExtension       *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	createAssignmentRubricCriterion *connect.Client[v1.CreateAssignmentRubricCriterionRequest, v1.CreatedResponse]
+	updateAssignmentRubricCriterion *connect.Client[v1.UpdateAssignmentRubricCriterionRequest, v1.Empty]
+	deleteAssignmentRubricCriterion *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	gradeSubmission                 *connect.Client[v1.GradeSubmissionRequest, v1.Empty]
+	regradeAssignment               *connect.Client[v1.RegradeAssignmentRequest, v1.RegradeAssignmentResponse]
+	checkAssignmentPlagiarism       *connect.Client[v1.CheckAssignmentPlagiarismRequest, v1.Empty]
+	createTerm                      *connect.Client[v1.CreateTermRequest, v1.CreatedResponse]
+	createCourse                    *connect.Client[v1.CreateCourseRequest, v1.CreatedResponse]
+	updateCourse                    *connect.Client[v1.UpdateCourseRequest, v1.Empty]
+	deleteCourse                    *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	createCourseSection             *connect.Client[v1.CreateCourseSectionRequest, v1.CreatedResponse]
+	deleteCourseSection             *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	enrollCourseMember              *connect.Client[v1.EnrollCourseMemberRequest, v1.CreatedResponse]
+	unenrollCourseMember            *connect.Client[v1.DeleteByIDRequest, v1.Empty]
+	findAllStudentAssignments       *connect.Client[v1.FindAllStudentAssignmentsRequest, v1.FindAllStudentAssignmentsResponse]
+	findStudentAssignment           *connect.Client[v1.FindByIDRequest, v1.StudentAssignment]
+	findAllSubmissionAttempts       *connect.Client[v1.FindAllSubmissionAttemptsRequest, v1.FindAllSubmissionAttemptsResponse]
+	diffSubmissionAttempts          *connect.Client[v1.DiffSubmissionAttemptsRequest, v1.DiffSubmissionAttemptsResponse]
+	submitStudentSubmission         *connect.Client[v1.SubmitStudentSubmissionRequest, v1.CreatedResponse]
+	resubmitStudentSubmission       *connect.Client[v1.ResubmitStudentSubmissionRequest, v1.Empty]
+	runCode                         *connect.Client[v1.RunCodeRequest, v1.RunCodeResponse]
+	login                           *connect.Client[v1.LoginRequest, v1.LoginResponse]
 }
 
 // Ping calls autograd.v1.AutogradService.Ping.
@@ -335,26 +605,6 @@ func (c *autogradServiceClient) FindAllManagedUsers(ctx context.Context, req *co
 	return c.findAllManagedUsers.CallUnary(ctx, req)
 }
 
-// FindAssignment calls autograd.v1.AutogradService.FindAssignment.
-func (c *autogradServiceClient) FindAssignment(ctx context.Context, req *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Assignment], error) {
-	return c.findAssignment.CallUnary(ctx, req)
-}
-
-// FindAllAssignments calls autograd.v1.AutogradService.FindAllAssignments.
-func (c *autogradServiceClient) FindAllAssignments(ctx context.Context, req *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error) {
-	return c.findAllAssignments.CallUnary(ctx, req)
-}
-
-// FindSubmission calls autograd.v1.AutogradService.FindSubmission.
-func (c *autogradServiceClient) FindSubmission(ctx context.Context, req *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error) {
-	return c.findSubmission.CallUnary(ctx, req)
-}
-
-// FindAllSubmissionForAssignment calls autograd.v1.AutogradService.FindAllSubmissionForAssignment.
-func (c *autogradServiceClient) FindAllSubmissionForAssignment(ctx context.Context, req *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error) {
-	return c.findAllSubmissionForAssignment.CallUnary(ctx, req)
-}
-
 // CreateAssignment calls autograd.v1.AutogradService.CreateAssignment.
 func (c *autogradServiceClient) CreateAssignment(ctx context.Context, req *connect.Request[v1.CreateAssignmentRequest]) (*connect.Response[v1.CreatedResponse], error) {
 	return c.createAssignment.CallUnary(ctx, req)
@@ -385,6 +635,109 @@ func (c *autogradServiceClient) DeleteSubmission(ctx context.Context, req *conne
 	return c.deleteSubmission.CallUnary(ctx, req)
 }
 
+// CreateAssignmentTestCase calls autograd.v1.AutogradService.CreateAssignmentTestCase.
+func (c *autogradServiceClient) CreateAssignmentTestCase(ctx context.Context, req *connect.Request[v1.CreateAssignmentTestCaseRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createAssignmentTestCase.CallUnary(ctx, req)
+}
+
+// UpdateAssignmentTestCase calls autograd.v1.AutogradService.UpdateAssignmentTestCase.
+func (c *autogradServiceClient) UpdateAssignmentTestCase(ctx context.Context, req *connect.Request[v1.UpdateAssignmentTestCaseRequest]) (*connect.Response[v1.Empty], error) {
+	return c.updateAssignmentTestCase.CallUnary(ctx, req)
+}
+
+// DeleteAssignmentTestCase calls autograd.v1.AutogradService.DeleteAssignmentTestCase.
+func (c *autogradServiceClient) DeleteAssignmentTestCase(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteAssignmentTestCase.CallUnary(ctx, req)
+}
+
+// CreateAssignmentExtension calls autograd.v1.AutogradService.CreateAssignmentExtension.
+func (c *autogradServiceClient) CreateAssignmentExtension(ctx context.Context, req *connect.Request[v1.CreateAssignmentExtensionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createAssignmentExtension.CallUnary(ctx, req)
+}
+
+// UpdateAssignmentExtension calls autograd.v1.AutogradService.UpdateAssignmentExtension.
+func (c *autogradServiceClient) UpdateAssignmentExtension(ctx context.Context, req *connect.Request[v1.UpdateAssignmentExtensionRequest]) (*connect.Response[v1.Empty], error) {
+	return c.updateAssignmentExtension.CallUnary(ctx, req)
+}
+
+// DeleteAssignmentExtension calls autograd.v1.AutogradService.DeleteAssignmentExtension.
+func (c *autogradServiceClient) DeleteAssignmentExtension(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteAssignmentExtension.CallUnary(ctx, req)
+}
+
+// CreateAssignmentRubricCriterion calls
+// autograd.v1.AutogradService.CreateAssignmentRubricCriterion.
+func (c *autogradServiceClient) CreateAssignmentRubricCriterion(ctx context.Context, req *connect.Request[v1.CreateAssignmentRubricCriterionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createAssignmentRubricCriterion.CallUnary(ctx, req)
+}
+
+// UpdateAssignmentRubricCriterion calls
+// autograd.v1.AutogradService.UpdateAssignmentRubricCriterion.
+func (c *autogradServiceClient) UpdateAssignmentRubricCriterion(ctx context.Context, req *connect.Request[v1.UpdateAssignmentRubricCriterionRequest]) (*connect.Response[v1.Empty], error) {
+	return c.updateAssignmentRubricCriterion.CallUnary(ctx, req)
+}
+
+// DeleteAssignmentRubricCriterion calls
+// autograd.v1.AutogradService.DeleteAssignmentRubricCriterion.
+func (c *autogradServiceClient) DeleteAssignmentRubricCriterion(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteAssignmentRubricCriterion.CallUnary(ctx, req)
+}
+
+// GradeSubmission calls autograd.v1.AutogradService.GradeSubmission.
+func (c *autogradServiceClient) GradeSubmission(ctx context.Context, req *connect.Request[v1.GradeSubmissionRequest]) (*connect.Response[v1.Empty], error) {
+	return c.gradeSubmission.CallUnary(ctx, req)
+}
+
+// RegradeAssignment calls autograd.v1.AutogradService.RegradeAssignment.
+func (c *autogradServiceClient) RegradeAssignment(ctx context.Context, req *connect.Request[v1.RegradeAssignmentRequest]) (*connect.Response[v1.RegradeAssignmentResponse], error) {
+	return c.regradeAssignment.CallUnary(ctx, req)
+}
+
+// CheckAssignmentPlagiarism calls autograd.v1.AutogradService.CheckAssignmentPlagiarism.
+func (c *autogradServiceClient) CheckAssignmentPlagiarism(ctx context.Context, req *connect.Request[v1.CheckAssignmentPlagiarismRequest]) (*connect.Response[v1.Empty], error) {
+	return c.checkAssignmentPlagiarism.CallUnary(ctx, req)
+}
+
+// CreateTerm calls autograd.v1.AutogradService.CreateTerm.
+func (c *autogradServiceClient) CreateTerm(ctx context.Context, req *connect.Request[v1.CreateTermRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createTerm.CallUnary(ctx, req)
+}
+
+// CreateCourse calls autograd.v1.AutogradService.CreateCourse.
+func (c *autogradServiceClient) CreateCourse(ctx context.Context, req *connect.Request[v1.CreateCourseRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createCourse.CallUnary(ctx, req)
+}
+
+// UpdateCourse calls autograd.v1.AutogradService.UpdateCourse.
+func (c *autogradServiceClient) UpdateCourse(ctx context.Context, req *connect.Request[v1.UpdateCourseRequest]) (*connect.Response[v1.Empty], error) {
+	return c.updateCourse.CallUnary(ctx, req)
+}
+
+// DeleteCourse calls autograd.v1.AutogradService.DeleteCourse.
+func (c *autogradServiceClient) DeleteCourse(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteCourse.CallUnary(ctx, req)
+}
+
+// CreateCourseSection calls autograd.v1.AutogradService.CreateCourseSection.
+func (c *autogradServiceClient) CreateCourseSection(ctx context.Context, req *connect.Request[v1.CreateCourseSectionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.createCourseSection.CallUnary(ctx, req)
+}
+
+// DeleteCourseSection calls autograd.v1.AutogradService.DeleteCourseSection.
+func (c *autogradServiceClient) DeleteCourseSection(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteCourseSection.CallUnary(ctx, req)
+}
+
+// EnrollCourseMember calls autograd.v1.AutogradService.EnrollCourseMember.
+func (c *autogradServiceClient) EnrollCourseMember(ctx context.Context, req *connect.Request[v1.EnrollCourseMemberRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return c.enrollCourseMember.CallUnary(ctx, req)
+}
+
+// UnenrollCourseMember calls autograd.v1.AutogradService.UnenrollCourseMember.
+func (c *autogradServiceClient) UnenrollCourseMember(ctx context.Context, req *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return c.unenrollCourseMember.CallUnary(ctx, req)
+}
+
 // FindAllStudentAssignments calls autograd.v1.AutogradService.FindAllStudentAssignments.
 func (c *autogradServiceClient) FindAllStudentAssignments(ctx context.Context, req *connect.Request[v1.FindAllStudentAssignmentsRequest]) (*connect.Response[v1.FindAllStudentAssignmentsResponse], error) {
 	return c.findAllStudentAssignments.CallUnary(ctx, req)
@@ -395,6 +748,16 @@ func (c *autogradServiceClient) FindStudentAssignment(ctx context.Context, req *
 	return c.findStudentAssignment.CallUnary(ctx, req)
 }
 
+// FindAllSubmissionAttempts calls autograd.v1.AutogradService.FindAllSubmissionAttempts.
+func (c *autogradServiceClient) FindAllSubmissionAttempts(ctx context.Context, req *connect.Request[v1.FindAllSubmissionAttemptsRequest]) (*connect.Response[v1.FindAllSubmissionAttemptsResponse], error) {
+	return c.findAllSubmissionAttempts.CallUnary(ctx, req)
+}
+
+// DiffSubmissionAttempts calls autograd.v1.AutogradService.DiffSubmissionAttempts.
+func (c *autogradServiceClient) DiffSubmissionAttempts(ctx context.Context, req *connect.Request[v1.DiffSubmissionAttemptsRequest]) (*connect.Response[v1.DiffSubmissionAttemptsResponse], error) {
+	return c.diffSubmissionAttempts.CallUnary(ctx, req)
+}
+
 // SubmitStudentSubmission calls autograd.v1.AutogradService.SubmitStudentSubmission.
 func (c *autogradServiceClient) SubmitStudentSubmission(ctx context.Context, req *connect.Request[v1.SubmitStudentSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error) {
 	return c.submitStudentSubmission.CallUnary(ctx, req)
@@ -405,6 +768,11 @@ func (c *autogradServiceClient) ResubmitStudentSubmission(ctx context.Context, r
 	return c.resubmitStudentSubmission.CallUnary(ctx, req)
 }
 
+// RunCode calls autograd.v1.AutogradService.RunCode.
+func (c *autogradServiceClient) RunCode(ctx context.Context, req *connect.Request[v1.RunCodeRequest]) (*connect.Response[v1.RunCodeResponse], error) {
+	return c.runCode.CallUnary(ctx, req)
+}
+
 // Login calls autograd.v1.AutogradService.Login.
 func (c *autogradServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return c.login.CallUnary(ctx, req)
@@ -419,10 +787,10 @@ type AutogradServiceHandler interface {
 	FindAllManagedUsers(context.Context, *connect.Request[v1.FindAllManagedUsersRequest]) (*connect.Response[v1.FindAllManagedUsersResponse], error)
 	// Assignment Submission
 	// Assignment Queries
-	FindAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Assignment], error)
-	FindAllAssignments(context.Context, *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error)
-	FindSubmission(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error)
-	FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error)
+	// rpc FindAssignment(FindByIDRequest) returns (Assignment) {}
+	// rpc FindAllAssignments(FindAllAssignmentsRequest) returns (FindAllAssignmentsResponse) {}
+	// rpc FindSubmission(FindByIDRequest) returns (Submission) {}
+	// rpc FindAllSubmissionForAssignment(FindAllSubmissionsForAssignmentRequest) returns (FindAllSubmissionsForAssignmentResponse) {}
 	// Assignment Command
 	CreateAssignment(context.Context, *connect.Request[v1.CreateAssignmentRequest]) (*connect.Response[v1.CreatedResponse], error)
 	UpdateAssignment(context.Context, *connect.Request[v1.UpdateAssignmentRequest]) (*connect.Response[v1.Empty], error)
@@ -430,13 +798,39 @@ type AutogradServiceHandler interface {
 	CreateSubmission(context.Context, *connect.Request[v1.CreateSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error)
 	UpdateSubmission(context.Context, *connect.Request[v1.UpdateSubmissionRequest]) (*connect.Response[v1.Empty], error)
 	DeleteSubmission(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentTestCase(context.Context, *connect.Request[v1.CreateAssignmentTestCaseRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentTestCase(context.Context, *connect.Request[v1.UpdateAssignmentTestCaseRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentTestCase(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentExtension(context.Context, *connect.Request[v1.CreateAssignmentExtensionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentExtension(context.Context, *connect.Request[v1.UpdateAssignmentExtensionRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentExtension(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateAssignmentRubricCriterion(context.Context, *connect.Request[v1.CreateAssignmentRubricCriterionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateAssignmentRubricCriterion(context.Context, *connect.Request[v1.UpdateAssignmentRubricCriterionRequest]) (*connect.Response[v1.Empty], error)
+	DeleteAssignmentRubricCriterion(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	GradeSubmission(context.Context, *connect.Request[v1.GradeSubmissionRequest]) (*connect.Response[v1.Empty], error)
+	RegradeAssignment(context.Context, *connect.Request[v1.RegradeAssignmentRequest]) (*connect.Response[v1.RegradeAssignmentResponse], error)
+	CheckAssignmentPlagiarism(context.Context, *connect.Request[v1.CheckAssignmentPlagiarismRequest]) (*connect.Response[v1.Empty], error)
+	// Course
+	// Course Command
+	CreateTerm(context.Context, *connect.Request[v1.CreateTermRequest]) (*connect.Response[v1.CreatedResponse], error)
+	CreateCourse(context.Context, *connect.Request[v1.CreateCourseRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UpdateCourse(context.Context, *connect.Request[v1.UpdateCourseRequest]) (*connect.Response[v1.Empty], error)
+	DeleteCourse(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	CreateCourseSection(context.Context, *connect.Request[v1.CreateCourseSectionRequest]) (*connect.Response[v1.CreatedResponse], error)
+	DeleteCourseSection(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
+	EnrollCourseMember(context.Context, *connect.Request[v1.EnrollCourseMemberRequest]) (*connect.Response[v1.CreatedResponse], error)
+	UnenrollCourseMember(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error)
 	// Student Assignment
 	// Student Assignment Queries
 	FindAllStudentAssignments(context.Context, *connect.Request[v1.FindAllStudentAssignmentsRequest]) (*connect.Response[v1.FindAllStudentAssignmentsResponse], error)
 	FindStudentAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.StudentAssignment], error)
+	FindAllSubmissionAttempts(context.Context, *connect.Request[v1.FindAllSubmissionAttemptsRequest]) (*connect.Response[v1.FindAllSubmissionAttemptsResponse], error)
+	DiffSubmissionAttempts(context.Context, *connect.Request[v1.DiffSubmissionAttemptsRequest]) (*connect.Response[v1.DiffSubmissionAttemptsResponse], error)
 	// Student Assignment Command
 	SubmitStudentSubmission(context.Context, *connect.Request[v1.SubmitStudentSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error)
 	ResubmitStudentSubmission(context.Context, *connect.Request[v1.ResubmitStudentSubmissionRequest]) (*connect.Response[v1.Empty], error)
+	// RunCode runs the code against a custom input without creating a submission
+	RunCode(context.Context, *connect.Request[v1.RunCodeRequest]) (*connect.Response[v1.RunCodeResponse], error)
 	// Auth
 	// Auth Mutation
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
@@ -472,30 +866,6 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 		connect.WithSchema(autogradServiceFindAllManagedUsersMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
-	autogradServiceFindAssignmentHandler := connect.NewUnaryHandler(
-		AutogradServiceFindAssignmentProcedure,
-		svc.FindAssignment,
-		connect.WithSchema(autogradServiceFindAssignmentMethodDescriptor),
-		connect.WithHandlerOptions(opts...),
-	)
-	autogradServiceFindAllAssignmentsHandler := connect.NewUnaryHandler(
-		AutogradServiceFindAllAssignmentsProcedure,
-		svc.FindAllAssignments,
-		connect.WithSchema(autogradServiceFindAllAssignmentsMethodDescriptor),
-		connect.WithHandlerOptions(opts...),
-	)
-	autogradServiceFindSubmissionHandler := connect.NewUnaryHandler(
-		AutogradServiceFindSubmissionProcedure,
-		svc.FindSubmission,
-		connect.WithSchema(autogradServiceFindSubmissionMethodDescriptor),
-		connect.WithHandlerOptions(opts...),
-	)
-	autogradServiceFindAllSubmissionForAssignmentHandler := connect.NewUnaryHandler(
-		AutogradServiceFindAllSubmissionForAssignmentProcedure,
-		svc.FindAllSubmissionForAssignment,
-		connect.WithSchema(autogradServiceFindAllSubmissionForAssignmentMethodDescriptor),
-		connect.WithHandlerOptions(opts...),
-	)
 	autogradServiceCreateAssignmentHandler := connect.NewUnaryHandler(
 		AutogradServiceCreateAssignmentProcedure,
 		svc.CreateAssignment,
@@ -532,6 +902,126 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 		connect.WithSchema(autogradServiceDeleteSubmissionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	autogradServiceCreateAssignmentTestCaseHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateAssignmentTestCaseProcedure,
+		svc.CreateAssignmentTestCase,
+		connect.WithSchema(autogradServiceCreateAssignmentTestCaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceUpdateAssignmentTestCaseHandler := connect.NewUnaryHandler(
+		AutogradServiceUpdateAssignmentTestCaseProcedure,
+		svc.UpdateAssignmentTestCase,
+		connect.WithSchema(autogradServiceUpdateAssignmentTestCaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDeleteAssignmentTestCaseHandler := connect.NewUnaryHandler(
+		AutogradServiceDeleteAssignmentTestCaseProcedure,
+		svc.DeleteAssignmentTestCase,
+		connect.WithSchema(autogradServiceDeleteAssignmentTestCaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCreateAssignmentExtensionHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateAssignmentExtensionProcedure,
+		svc.CreateAssignmentExtension,
+		connect.WithSchema(autogradServiceCreateAssignmentExtensionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceUpdateAssignmentExtensionHandler := connect.NewUnaryHandler(
+		AutogradServiceUpdateAssignmentExtensionProcedure,
+		svc.UpdateAssignmentExtension,
+		connect.WithSchema(autogradServiceUpdateAssignmentExtensionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDeleteAssignmentExtensionHandler := connect.NewUnaryHandler(
+		AutogradServiceDeleteAssignmentExtensionProcedure,
+		svc.DeleteAssignmentExtension,
+		connect.WithSchema(autogradServiceDeleteAssignmentExtensionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCreateAssignmentRubricCriterionHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateAssignmentRubricCriterionProcedure,
+		svc.CreateAssignmentRubricCriterion,
+		connect.WithSchema(autogradServiceCreateAssignmentRubricCriterionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceUpdateAssignmentRubricCriterionHandler := connect.NewUnaryHandler(
+		AutogradServiceUpdateAssignmentRubricCriterionProcedure,
+		svc.UpdateAssignmentRubricCriterion,
+		connect.WithSchema(autogradServiceUpdateAssignmentRubricCriterionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDeleteAssignmentRubricCriterionHandler := connect.NewUnaryHandler(
+		AutogradServiceDeleteAssignmentRubricCriterionProcedure,
+		svc.DeleteAssignmentRubricCriterion,
+		connect.WithSchema(autogradServiceDeleteAssignmentRubricCriterionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceGradeSubmissionHandler := connect.NewUnaryHandler(
+		AutogradServiceGradeSubmissionProcedure,
+		svc.GradeSubmission,
+		connect.WithSchema(autogradServiceGradeSubmissionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceRegradeAssignmentHandler := connect.NewUnaryHandler(
+		AutogradServiceRegradeAssignmentProcedure,
+		svc.RegradeAssignment,
+		connect.WithSchema(autogradServiceRegradeAssignmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCheckAssignmentPlagiarismHandler := connect.NewUnaryHandler(
+		AutogradServiceCheckAssignmentPlagiarismProcedure,
+		svc.CheckAssignmentPlagiarism,
+		connect.WithSchema(autogradServiceCheckAssignmentPlagiarismMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCreateTermHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateTermProcedure,
+		svc.CreateTerm,
+		connect.WithSchema(autogradServiceCreateTermMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCreateCourseHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateCourseProcedure,
+		svc.CreateCourse,
+		connect.WithSchema(autogradServiceCreateCourseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceUpdateCourseHandler := connect.NewUnaryHandler(
+		AutogradServiceUpdateCourseProcedure,
+		svc.UpdateCourse,
+		connect.WithSchema(autogradServiceUpdateCourseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDeleteCourseHandler := connect.NewUnaryHandler(
+		AutogradServiceDeleteCourseProcedure,
+		svc.DeleteCourse,
+		connect.WithSchema(autogradServiceDeleteCourseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceCreateCourseSectionHandler := connect.NewUnaryHandler(
+		AutogradServiceCreateCourseSectionProcedure,
+		svc.CreateCourseSection,
+		connect.WithSchema(autogradServiceCreateCourseSectionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDeleteCourseSectionHandler := connect.NewUnaryHandler(
+		AutogradServiceDeleteCourseSectionProcedure,
+		svc.DeleteCourseSection,
+		connect.WithSchema(autogradServiceDeleteCourseSectionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceEnrollCourseMemberHandler := connect.NewUnaryHandler(
+		AutogradServiceEnrollCourseMemberProcedure,
+		svc.EnrollCourseMember,
+		connect.WithSchema(autogradServiceEnrollCourseMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceUnenrollCourseMemberHandler := connect.NewUnaryHandler(
+		AutogradServiceUnenrollCourseMemberProcedure,
+		svc.UnenrollCourseMember,
+		connect.WithSchema(autogradServiceUnenrollCourseMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	autogradServiceFindAllStudentAssignmentsHandler := connect.NewUnaryHandler(
 		AutogradServiceFindAllStudentAssignmentsProcedure,
 		svc.FindAllStudentAssignments,
@@ -544,6 +1034,18 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 		connect.WithSchema(autogradServiceFindStudentAssignmentMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	autogradServiceFindAllSubmissionAttemptsHandler := connect.NewUnaryHandler(
+		AutogradServiceFindAllSubmissionAttemptsProcedure,
+		svc.FindAllSubmissionAttempts,
+		connect.WithSchema(autogradServiceFindAllSubmissionAttemptsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradServiceDiffSubmissionAttemptsHandler := connect.NewUnaryHandler(
+		AutogradServiceDiffSubmissionAttemptsProcedure,
+		svc.DiffSubmissionAttempts,
+		connect.WithSchema(autogradServiceDiffSubmissionAttemptsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	autogradServiceSubmitStudentSubmissionHandler := connect.NewUnaryHandler(
 		AutogradServiceSubmitStudentSubmissionProcedure,
 		svc.SubmitStudentSubmission,
@@ -556,6 +1058,12 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 		connect.WithSchema(autogradServiceResubmitStudentSubmissionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	autogradServiceRunCodeHandler := connect.NewUnaryHandler(
+		AutogradServiceRunCodeProcedure,
+		svc.RunCode,
+		connect.WithSchema(autogradServiceRunCodeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	autogradServiceLoginHandler := connect.NewUnaryHandler(
 		AutogradServiceLoginProcedure,
 		svc.Login,
@@ -572,14 +1080,6 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 			autogradServiceActivateManagedUserHandler.ServeHTTP(w, r)
 		case AutogradServiceFindAllManagedUsersProcedure:
 			autogradServiceFindAllManagedUsersHandler.ServeHTTP(w, r)
-		case AutogradServiceFindAssignmentProcedure:
-			autogradServiceFindAssignmentHandler.ServeHTTP(w, r)
-		case AutogradServiceFindAllAssignmentsProcedure:
-			autogradServiceFindAllAssignmentsHandler.ServeHTTP(w, r)
-		case AutogradServiceFindSubmissionProcedure:
-			autogradServiceFindSubmissionHandler.ServeHTTP(w, r)
-		case AutogradServiceFindAllSubmissionForAssignmentProcedure:
-			autogradServiceFindAllSubmissionForAssignmentHandler.ServeHTTP(w, r)
 		case AutogradServiceCreateAssignmentProcedure:
 			autogradServiceCreateAssignmentHandler.ServeHTTP(w, r)
 		case AutogradServiceUpdateAssignmentProcedure:
@@ -592,14 +1092,60 @@ func NewAutogradServiceHandler(svc AutogradServiceHandler, opts ...connect.Handl
 			autogradServiceUpdateSubmissionHandler.ServeHTTP(w, r)
 		case AutogradServiceDeleteSubmissionProcedure:
 			autogradServiceDeleteSubmissionHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateAssignmentTestCaseProcedure:
+			autogradServiceCreateAssignmentTestCaseHandler.ServeHTTP(w, r)
+		case AutogradServiceUpdateAssignmentTestCaseProcedure:
+			autogradServiceUpdateAssignmentTestCaseHandler.ServeHTTP(w, r)
+		case AutogradServiceDeleteAssignmentTestCaseProcedure:
+			autogradServiceDeleteAssignmentTestCaseHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateAssignmentExtensionProcedure:
+			autogradServiceCreateAssignmentExtensionHandler.ServeHTTP(w, r)
+		case AutogradServiceUpdateAssignmentExtensionProcedure:
+			autogradServiceUpdateAssignmentExtensionHandler.ServeHTTP(w, r)
+		case AutogradServiceDeleteAssignmentExtensionProcedure:
+			autogradServiceDeleteAssignmentExtensionHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateAssignmentRubricCriterionProcedure:
+			autogradServiceCreateAssignmentRubricCriterionHandler.ServeHTTP(w, r)
+		case AutogradServiceUpdateAssignmentRubricCriterionProcedure:
+			autogradServiceUpdateAssignmentRubricCriterionHandler.ServeHTTP(w, r)
+		case AutogradServiceDeleteAssignmentRubricCriterionProcedure:
+			autogradServiceDeleteAssignmentRubricCriterionHandler.ServeHTTP(w, r)
+		case AutogradServiceGradeSubmissionProcedure:
+			autogradServiceGradeSubmissionHandler.ServeHTTP(w, r)
+		case AutogradServiceRegradeAssignmentProcedure:
+			autogradServiceRegradeAssignmentHandler.ServeHTTP(w, r)
+		case AutogradServiceCheckAssignmentPlagiarismProcedure:
+			autogradServiceCheckAssignmentPlagiarismHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateTermProcedure:
+			autogradServiceCreateTermHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateCourseProcedure:
+			autogradServiceCreateCourseHandler.ServeHTTP(w, r)
+		case AutogradServiceUpdateCourseProcedure:
+			autogradServiceUpdateCourseHandler.ServeHTTP(w, r)
+		case AutogradServiceDeleteCourseProcedure:
+			autogradServiceDeleteCourseHandler.ServeHTTP(w, r)
+		case AutogradServiceCreateCourseSectionProcedure:
+			autogradServiceCreateCourseSectionHandler.ServeHTTP(w, r)
+		case AutogradServiceDeleteCourseSectionProcedure:
+			autogradServiceDeleteCourseSectionHandler.ServeHTTP(w, r)
+		case AutogradServiceEnrollCourseMemberProcedure:
+			autogradServiceEnrollCourseMemberHandler.ServeHTTP(w, r)
+		case AutogradServiceUnenrollCourseMemberProcedure:
+			autogradServiceUnenrollCourseMemberHandler.ServeHTTP(w, r)
 		case AutogradServiceFindAllStudentAssignmentsProcedure:
 			autogradServiceFindAllStudentAssignmentsHandler.ServeHTTP(w, r)
 		case AutogradServiceFindStudentAssignmentProcedure:
 			autogradServiceFindStudentAssignmentHandler.ServeHTTP(w, r)
+		case AutogradServiceFindAllSubmissionAttemptsProcedure:
+			autogradServiceFindAllSubmissionAttemptsHandler.ServeHTTP(w, r)
+		case AutogradServiceDiffSubmissionAttemptsProcedure:
+			autogradServiceDiffSubmissionAttemptsHandler.ServeHTTP(w, r)
 		case AutogradServiceSubmitStudentSubmissionProcedure:
 			autogradServiceSubmitStudentSubmissionHandler.ServeHTTP(w, r)
 		case AutogradServiceResubmitStudentSubmissionProcedure:
 			autogradServiceResubmitStudentSubmissionHandler.ServeHTTP(w, r)
+		case AutogradServiceRunCodeProcedure:
+			autogradServiceRunCodeHandler.ServeHTTP(w, r)
 		case AutogradServiceLoginProcedure:
 			autogradServiceLoginHandler.ServeHTTP(w, r)
 		default:
@@ -627,22 +1173,6 @@ func (UnimplementedAutogradServiceHandler) FindAllManagedUsers(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAllManagedUsers is not implemented"))
 }
 
-func (UnimplementedAutogradServiceHandler) FindAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Assignment], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAssignment is not implemented"))
-}
-
-func (UnimplementedAutogradServiceHandler) FindAllAssignments(context.Context, *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAllAssignments is not implemented"))
-}
-
-func (UnimplementedAutogradServiceHandler) FindSubmission(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindSubmission is not implemented"))
-}
-
-func (UnimplementedAutogradServiceHandler) FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAllSubmissionForAssignment is not implemented"))
-}
-
 func (UnimplementedAutogradServiceHandler) CreateAssignment(context.Context, *connect.Request[v1.CreateAssignmentRequest]) (*connect.Response[v1.CreatedResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateAssignment is not implemented"))
 }
@@ -667,6 +1197,86 @@ func (UnimplementedAutogradServiceHandler) DeleteSubmission(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteSubmission is not implemented"))
 }
 
+func (UnimplementedAutogradServiceHandler) CreateAssignmentTestCase(context.Context, *connect.Request[v1.CreateAssignmentTestCaseRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateAssignmentTestCase is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) UpdateAssignmentTestCase(context.Context, *connect.Request[v1.UpdateAssignmentTestCaseRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.UpdateAssignmentTestCase is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DeleteAssignmentTestCase(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteAssignmentTestCase is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CreateAssignmentExtension(context.Context, *connect.Request[v1.CreateAssignmentExtensionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateAssignmentExtension is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) UpdateAssignmentExtension(context.Context, *connect.Request[v1.UpdateAssignmentExtensionRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.UpdateAssignmentExtension is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DeleteAssignmentExtension(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteAssignmentExtension is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CreateAssignmentRubricCriterion(context.Context, *connect.Request[v1.CreateAssignmentRubricCriterionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateAssignmentRubricCriterion is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) UpdateAssignmentRubricCriterion(context.Context, *connect.Request[v1.UpdateAssignmentRubricCriterionRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.UpdateAssignmentRubricCriterion is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DeleteAssignmentRubricCriterion(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteAssignmentRubricCriterion is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) GradeSubmission(context.Context, *connect.Request[v1.GradeSubmissionRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.GradeSubmission is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) RegradeAssignment(context.Context, *connect.Request[v1.RegradeAssignmentRequest]) (*connect.Response[v1.RegradeAssignmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.RegradeAssignment is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CheckAssignmentPlagiarism(context.Context, *connect.Request[v1.CheckAssignmentPlagiarismRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CheckAssignmentPlagiarism is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CreateTerm(context.Context, *connect.Request[v1.CreateTermRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateTerm is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CreateCourse(context.Context, *connect.Request[v1.CreateCourseRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateCourse is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) UpdateCourse(context.Context, *connect.Request[v1.UpdateCourseRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.UpdateCourse is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DeleteCourse(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteCourse is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) CreateCourseSection(context.Context, *connect.Request[v1.CreateCourseSectionRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.CreateCourseSection is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DeleteCourseSection(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DeleteCourseSection is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) EnrollCourseMember(context.Context, *connect.Request[v1.EnrollCourseMemberRequest]) (*connect.Response[v1.CreatedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.EnrollCourseMember is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) UnenrollCourseMember(context.Context, *connect.Request[v1.DeleteByIDRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.UnenrollCourseMember is not implemented"))
+}
+
 func (UnimplementedAutogradServiceHandler) FindAllStudentAssignments(context.Context, *connect.Request[v1.FindAllStudentAssignmentsRequest]) (*connect.Response[v1.FindAllStudentAssignmentsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAllStudentAssignments is not implemented"))
 }
@@ -675,6 +1285,14 @@ func (UnimplementedAutogradServiceHandler) FindStudentAssignment(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindStudentAssignment is not implemented"))
 }
 
+func (UnimplementedAutogradServiceHandler) FindAllSubmissionAttempts(context.Context, *connect.Request[v1.FindAllSubmissionAttemptsRequest]) (*connect.Response[v1.FindAllSubmissionAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.FindAllSubmissionAttempts is not implemented"))
+}
+
+func (UnimplementedAutogradServiceHandler) DiffSubmissionAttempts(context.Context, *connect.Request[v1.DiffSubmissionAttemptsRequest]) (*connect.Response[v1.DiffSubmissionAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.DiffSubmissionAttempts is not implemented"))
+}
+
 func (UnimplementedAutogradServiceHandler) SubmitStudentSubmission(context.Context, *connect.Request[v1.SubmitStudentSubmissionRequest]) (*connect.Response[v1.CreatedResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.SubmitStudentSubmission is not implemented"))
 }
@@ -683,6 +1301,10 @@ func (UnimplementedAutogradServiceHandler) ResubmitStudentSubmission(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.ResubmitStudentSubmission is not implemented"))
 }
 
+func (UnimplementedAutogradServiceHandler) RunCode(context.Context, *connect.Request[v1.RunCodeRequest]) (*connect.Response[v1.RunCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.RunCode is not implemented"))
+}
+
 func (UnimplementedAutogradServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradService.Login is not implemented"))
 }
@@ -693,6 +1315,15 @@ type AutogradQueryClient interface {
 	FindAllAssignments(context.Context, *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error)
 	FindSubmission(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error)
 	FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error)
+	FindAllAssignmentTestCases(context.Context, *connect.Request[v1.FindAllAssignmentTestCasesRequest]) (*connect.Response[v1.FindAllAssignmentTestCasesResponse], error)
+	FindAllAssignmentExtensions(context.Context, *connect.Request[v1.FindAllAssignmentExtensionsRequest]) (*connect.Response[v1.FindAllAssignmentExtensionsResponse], error)
+	FindAllAssignmentRubricCriteria(context.Context, *connect.Request[v1.FindAllAssignmentRubricCriteriaRequest]) (*connect.Response[v1.FindAllAssignmentRubricCriteriaResponse], error)
+	FindAllSubmissionGradeAudits(context.Context, *connect.Request[v1.FindAllSubmissionGradeAuditsRequest]) (*connect.Response[v1.FindAllSubmissionGradeAuditsResponse], error)
+	FindAllSubmissionSimilarities(context.Context, *connect.Request[v1.FindAllSubmissionSimilaritiesRequest]) (*connect.Response[v1.FindAllSubmissionSimilaritiesResponse], error)
+	FindAllTerms(context.Context, *connect.Request[v1.FindAllTermsRequest]) (*connect.Response[v1.FindAllTermsResponse], error)
+	FindAllCourses(context.Context, *connect.Request[v1.FindAllCoursesRequest]) (*connect.Response[v1.FindAllCoursesResponse], error)
+	FindCourse(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Course], error)
+	FindAllCourseEnrollments(context.Context, *connect.Request[v1.FindAllCourseEnrollmentsRequest]) (*connect.Response[v1.FindAllCourseEnrollmentsResponse], error)
 }
 
 // NewAutogradQueryClient constructs a client for the autograd.v1.AutogradQuery service. By default,
@@ -729,15 +1360,78 @@ func NewAutogradQueryClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(autogradQueryFindAllSubmissionForAssignmentMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		findAllAssignmentTestCases: connect.NewClient[v1.FindAllAssignmentTestCasesRequest, v1.FindAllAssignmentTestCasesResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllAssignmentTestCasesProcedure,
+			connect.WithSchema(autogradQueryFindAllAssignmentTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllAssignmentExtensions: connect.NewClient[v1.FindAllAssignmentExtensionsRequest, v1.FindAllAssignmentExtensionsResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllAssignmentExtensionsProcedure,
+			connect.WithSchema(autogradQueryFindAllAssignmentExtensionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllAssignmentRubricCriteria: connect.NewClient[v1.FindAllAssignmentRubricCriteriaRequest, v1.FindAllAssignmentRubricCriteriaResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllAssignmentRubricCriteriaProcedure,
+			connect.WithSchema(autogradQueryFindAllAssignmentRubricCriteriaMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllSubmissionGradeAudits: connect.NewClient[v1.FindAllSubmissionGradeAuditsRequest, v1.FindAllSubmissionGradeAuditsResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllSubmissionGradeAuditsProcedure,
+			connect.WithSchema(autogradQueryFindAllSubmissionGradeAuditsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllSubmissionSimilarities: connect.NewClient[v1.FindAllSubmissionSimilaritiesRequest, v1.FindAllSubmissionSimilaritiesResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllSubmissionSimilaritiesProcedure,
+			connect.WithSchema(autogradQueryFindAllSubmissionSimilaritiesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllTerms: connect.NewClient[v1.FindAllTermsRequest, v1.FindAllTermsResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllTermsProcedure,
+			connect.WithSchema(autogradQueryFindAllTermsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllCourses: connect.NewClient[v1.FindAllCoursesRequest, v1.FindAllCoursesResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllCoursesProcedure,
+			connect.WithSchema(autogradQueryFindAllCoursesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findCourse: connect.NewClient[v1.FindByIDRequest, v1.Course](
+			httpClient,
+			baseURL+AutogradQueryFindCourseProcedure,
+			connect.WithSchema(autogradQueryFindCourseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findAllCourseEnrollments: connect.NewClient[v1.FindAllCourseEnrollmentsRequest, v1.FindAllCourseEnrollmentsResponse](
+			httpClient,
+			baseURL+AutogradQueryFindAllCourseEnrollmentsProcedure,
+			connect.WithSchema(autogradQueryFindAllCourseEnrollmentsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // autogradQueryClient implements AutogradQueryClient.
 type autogradQueryClient struct {
-	findAssignment                 *connect.Client[v1.FindByIDRequest, v1.Assignment]
-	findAllAssignments             *connect.Client[v1.FindAllAssignmentsRequest, v1.FindAllAssignmentsResponse]
-	findSubmission                 *connect.Client[v1.FindByIDRequest, v1.Submission]
-	findAllSubmissionForAssignment *connect.Client[v1.FindAllSubmissionsForAssignmentRequest, v1.FindAllSubmissionsForAssignmentResponse]
+	findAssignment                  *connect.Client[v1.FindByIDRequest, v1.Assignment]
+	findAllAssignments              *connect.Client[v1.FindAllAssignmentsRequest, v1.FindAllAssignmentsResponse]
+	findSubmission                  *connect.Client[v1.FindByIDRequest, v1.Submission]
+	findAllSubmissionForAssignment  *connect.Client[v1.FindAllSubmissionsForAssignmentRequest, v1.FindAllSubmissionsForAssignmentResponse]
+	findAllAssignmentTestCases      *connect.Client[v1.FindAllAssignmentTestCasesRequest, v1.FindAllAssignmentTestCasesResponse]
+	findAllAssignmentExtensions     *connect.Client[v1.FindAllAssignmentExtensionsRequest, v1.FindAllAssignmentExtensionsResponse]
+	findAllAssignmentRubricCriteria *connect.Client[v1.FindAllAssignmentRubricCriteriaRequest, v1.FindAllAssignmentRubricCriteriaResponse]
+	findAllSubmissionGradeAudits    *connect.Client[v1.FindAllSubmissionGradeAuditsRequest, v1.FindAllSubmissionGradeAuditsResponse]
+	findAllSubmissionSimilarities   *connect.Client[v1.FindAllSubmissionSimilaritiesRequest, v1.FindAllSubmissionSimilaritiesResponse]
+	findAllTerms                    *connect.Client[v1.FindAllTermsRequest, v1.FindAllTermsResponse]
+	findAllCourses                  *connect.Client[v1.FindAllCoursesRequest, v1.FindAllCoursesResponse]
+	findCourse                      *connect.Client[v1.FindByIDRequest, v1.Course]
+	findAllCourseEnrollments        *connect.Client[v1.FindAllCourseEnrollmentsRequest, v1.FindAllCourseEnrollmentsResponse]
 }
 
 // FindAssignment calls autograd.v1.AutogradQuery.FindAssignment.
@@ -760,12 +1454,66 @@ func (c *autogradQueryClient) FindAllSubmissionForAssignment(ctx context.Context
 	return c.findAllSubmissionForAssignment.CallUnary(ctx, req)
 }
 
+// FindAllAssignmentTestCases calls autograd.v1.AutogradQuery.FindAllAssignmentTestCases.
+func (c *autogradQueryClient) FindAllAssignmentTestCases(ctx context.Context, req *connect.Request[v1.FindAllAssignmentTestCasesRequest]) (*connect.Response[v1.FindAllAssignmentTestCasesResponse], error) {
+	return c.findAllAssignmentTestCases.CallUnary(ctx, req)
+}
+
+// FindAllAssignmentExtensions calls autograd.v1.AutogradQuery.FindAllAssignmentExtensions.
+func (c *autogradQueryClient) FindAllAssignmentExtensions(ctx context.Context, req *connect.Request[v1.FindAllAssignmentExtensionsRequest]) (*connect.Response[v1.FindAllAssignmentExtensionsResponse], error) {
+	return c.findAllAssignmentExtensions.CallUnary(ctx, req)
+}
+
+// FindAllAssignmentRubricCriteria calls autograd.v1.AutogradQuery.FindAllAssignmentRubricCriteria.
+func (c *autogradQueryClient) FindAllAssignmentRubricCriteria(ctx context.Context, req *connect.Request[v1.FindAllAssignmentRubricCriteriaRequest]) (*connect.Response[v1.FindAllAssignmentRubricCriteriaResponse], error) {
+	return c.findAllAssignmentRubricCriteria.CallUnary(ctx, req)
+}
+
+// FindAllSubmissionGradeAudits calls autograd.v1.AutogradQuery.FindAllSubmissionGradeAudits.
+func (c *autogradQueryClient) FindAllSubmissionGradeAudits(ctx context.Context, req *connect.Request[v1.FindAllSubmissionGradeAuditsRequest]) (*connect.Response[v1.FindAllSubmissionGradeAuditsResponse], error) {
+	return c.findAllSubmissionGradeAudits.CallUnary(ctx, req)
+}
+
+// FindAllSubmissionSimilarities calls autograd.v1.AutogradQuery.FindAllSubmissionSimilarities.
+func (c *autogradQueryClient) FindAllSubmissionSimilarities(ctx context.Context, req *connect.Request[v1.FindAllSubmissionSimilaritiesRequest]) (*connect.Response[v1.FindAllSubmissionSimilaritiesResponse], error) {
+	return c.findAllSubmissionSimilarities.CallUnary(ctx, req)
+}
+
+// FindAllTerms calls autograd.v1.AutogradQuery.FindAllTerms.
+func (c *autogradQueryClient) FindAllTerms(ctx context.Context, req *connect.Request[v1.FindAllTermsRequest]) (*connect.Response[v1.FindAllTermsResponse], error) {
+	return c.findAllTerms.CallUnary(ctx, req)
+}
+
+// FindAllCourses calls autograd.v1.AutogradQuery.FindAllCourses.
+func (c *autogradQueryClient) FindAllCourses(ctx context.Context, req *connect.Request[v1.FindAllCoursesRequest]) (*connect.Response[v1.FindAllCoursesResponse], error) {
+	return c.findAllCourses.CallUnary(ctx, req)
+}
+
+// FindCourse calls autograd.v1.AutogradQuery.FindCourse.
+func (c *autogradQueryClient) FindCourse(ctx context.Context, req *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Course], error) {
+	return c.findCourse.CallUnary(ctx, req)
+}
+
+// FindAllCourseEnrollments calls autograd.v1.AutogradQuery.FindAllCourseEnrollments.
+func (c *autogradQueryClient) FindAllCourseEnrollments(ctx context.Context, req *connect.Request[v1.FindAllCourseEnrollmentsRequest]) (*connect.Response[v1.FindAllCourseEnrollmentsResponse], error) {
+	return c.findAllCourseEnrollments.CallUnary(ctx, req)
+}
+
 // AutogradQueryHandler is an implementation of the autograd.v1.AutogradQuery service.
 type AutogradQueryHandler interface {
 	FindAssignment(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Assignment], error)
 	FindAllAssignments(context.Context, *connect.Request[v1.FindAllAssignmentsRequest]) (*connect.Response[v1.FindAllAssignmentsResponse], error)
 	FindSubmission(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Submission], error)
 	FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error)
+	FindAllAssignmentTestCases(context.Context, *connect.Request[v1.FindAllAssignmentTestCasesRequest]) (*connect.Response[v1.FindAllAssignmentTestCasesResponse], error)
+	FindAllAssignmentExtensions(context.Context, *connect.Request[v1.FindAllAssignmentExtensionsRequest]) (*connect.Response[v1.FindAllAssignmentExtensionsResponse], error)
+	FindAllAssignmentRubricCriteria(context.Context, *connect.Request[v1.FindAllAssignmentRubricCriteriaRequest]) (*connect.Response[v1.FindAllAssignmentRubricCriteriaResponse], error)
+	FindAllSubmissionGradeAudits(context.Context, *connect.Request[v1.FindAllSubmissionGradeAuditsRequest]) (*connect.Response[v1.FindAllSubmissionGradeAuditsResponse], error)
+	FindAllSubmissionSimilarities(context.Context, *connect.Request[v1.FindAllSubmissionSimilaritiesRequest]) (*connect.Response[v1.FindAllSubmissionSimilaritiesResponse], error)
+	FindAllTerms(context.Context, *connect.Request[v1.FindAllTermsRequest]) (*connect.Response[v1.FindAllTermsResponse], error)
+	FindAllCourses(context.Context, *connect.Request[v1.FindAllCoursesRequest]) (*connect.Response[v1.FindAllCoursesResponse], error)
+	FindCourse(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Course], error)
+	FindAllCourseEnrollments(context.Context, *connect.Request[v1.FindAllCourseEnrollmentsRequest]) (*connect.Response[v1.FindAllCourseEnrollmentsResponse], error)
 }
 
 // NewAutogradQueryHandler builds an HTTP handler from the service implementation. It returns the
@@ -798,6 +1546,60 @@ func NewAutogradQueryHandler(svc AutogradQueryHandler, opts ...connect.HandlerOp
 		connect.WithSchema(autogradQueryFindAllSubmissionForAssignmentMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	autogradQueryFindAllAssignmentTestCasesHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllAssignmentTestCasesProcedure,
+		svc.FindAllAssignmentTestCases,
+		connect.WithSchema(autogradQueryFindAllAssignmentTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllAssignmentExtensionsHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllAssignmentExtensionsProcedure,
+		svc.FindAllAssignmentExtensions,
+		connect.WithSchema(autogradQueryFindAllAssignmentExtensionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllAssignmentRubricCriteriaHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllAssignmentRubricCriteriaProcedure,
+		svc.FindAllAssignmentRubricCriteria,
+		connect.WithSchema(autogradQueryFindAllAssignmentRubricCriteriaMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllSubmissionGradeAuditsHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllSubmissionGradeAuditsProcedure,
+		svc.FindAllSubmissionGradeAudits,
+		connect.WithSchema(autogradQueryFindAllSubmissionGradeAuditsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllSubmissionSimilaritiesHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllSubmissionSimilaritiesProcedure,
+		svc.FindAllSubmissionSimilarities,
+		connect.WithSchema(autogradQueryFindAllSubmissionSimilaritiesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllTermsHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllTermsProcedure,
+		svc.FindAllTerms,
+		connect.WithSchema(autogradQueryFindAllTermsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllCoursesHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllCoursesProcedure,
+		svc.FindAllCourses,
+		connect.WithSchema(autogradQueryFindAllCoursesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindCourseHandler := connect.NewUnaryHandler(
+		AutogradQueryFindCourseProcedure,
+		svc.FindCourse,
+		connect.WithSchema(autogradQueryFindCourseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	autogradQueryFindAllCourseEnrollmentsHandler := connect.NewUnaryHandler(
+		AutogradQueryFindAllCourseEnrollmentsProcedure,
+		svc.FindAllCourseEnrollments,
+		connect.WithSchema(autogradQueryFindAllCourseEnrollmentsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/autograd.v1.AutogradQuery/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AutogradQueryFindAssignmentProcedure:
@@ -808,6 +1610,24 @@ func NewAutogradQueryHandler(svc AutogradQueryHandler, opts ...connect.HandlerOp
 			autogradQueryFindSubmissionHandler.ServeHTTP(w, r)
 		case AutogradQueryFindAllSubmissionForAssignmentProcedure:
 			autogradQueryFindAllSubmissionForAssignmentHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllAssignmentTestCasesProcedure:
+			autogradQueryFindAllAssignmentTestCasesHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllAssignmentExtensionsProcedure:
+			autogradQueryFindAllAssignmentExtensionsHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllAssignmentRubricCriteriaProcedure:
+			autogradQueryFindAllAssignmentRubricCriteriaHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllSubmissionGradeAuditsProcedure:
+			autogradQueryFindAllSubmissionGradeAuditsHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllSubmissionSimilaritiesProcedure:
+			autogradQueryFindAllSubmissionSimilaritiesHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllTermsProcedure:
+			autogradQueryFindAllTermsHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllCoursesProcedure:
+			autogradQueryFindAllCoursesHandler.ServeHTTP(w, r)
+		case AutogradQueryFindCourseProcedure:
+			autogradQueryFindCourseHandler.ServeHTTP(w, r)
+		case AutogradQueryFindAllCourseEnrollmentsProcedure:
+			autogradQueryFindAllCourseEnrollmentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -832,3 +1652,39 @@ func (UnimplementedAutogradQueryHandler) FindSubmission(context.Context, *connec
 func (UnimplementedAutogradQueryHandler) FindAllSubmissionForAssignment(context.Context, *connect.Request[v1.FindAllSubmissionsForAssignmentRequest]) (*connect.Response[v1.FindAllSubmissionsForAssignmentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllSubmissionForAssignment is not implemented"))
 }
+
+func (UnimplementedAutogradQueryHandler) FindAllAssignmentTestCases(context.Context, *connect.Request[v1.FindAllAssignmentTestCasesRequest]) (*connect.Response[v1.FindAllAssignmentTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllAssignmentTestCases is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllAssignmentExtensions(context.Context, *connect.Request[v1.FindAllAssignmentExtensionsRequest]) (*connect.Response[v1.FindAllAssignmentExtensionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllAssignmentExtensions is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllAssignmentRubricCriteria(context.Context, *connect.Request[v1.FindAllAssignmentRubricCriteriaRequest]) (*connect.Response[v1.FindAllAssignmentRubricCriteriaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllAssignmentRubricCriteria is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllSubmissionGradeAudits(context.Context, *connect.Request[v1.FindAllSubmissionGradeAuditsRequest]) (*connect.Response[v1.FindAllSubmissionGradeAuditsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllSubmissionGradeAudits is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllSubmissionSimilarities(context.Context, *connect.Request[v1.FindAllSubmissionSimilaritiesRequest]) (*connect.Response[v1.FindAllSubmissionSimilaritiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllSubmissionSimilarities is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllTerms(context.Context, *connect.Request[v1.FindAllTermsRequest]) (*connect.Response[v1.FindAllTermsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllTerms is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllCourses(context.Context, *connect.Request[v1.FindAllCoursesRequest]) (*connect.Response[v1.FindAllCoursesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllCourses is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindCourse(context.Context, *connect.Request[v1.FindByIDRequest]) (*connect.Response[v1.Course], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindCourse is not implemented"))
+}
+
+func (UnimplementedAutogradQueryHandler) FindAllCourseEnrollments(context.Context, *connect.Request[v1.FindAllCourseEnrollmentsRequest]) (*connect.Response[v1.FindAllCourseEnrollmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("autograd.v1.AutogradQuery.FindAllCourseEnrollments is not implemented"))
+}
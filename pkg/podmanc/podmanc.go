@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"fmt"
 	"os/exec"
+
+	"github.com/fahmifan/autograd/pkg/core/grading"
 )
 
 type CPP struct {
 	MountDir        string
 	ProgramFileName string
+	// Limits defaults to the c++ language limits
+	Limits grading.Limits
 }
 
 type Result struct {
@@ -20,15 +24,19 @@ func (res *Result) Output() []byte {
 }
 
 func (cr *CPP) Run() (Result, error) {
+	spec, _ := grading.LookupLanguageSpec(grading.LanguageCPP)
+	limits := cr.Limits.WithDefault(spec.DefaultLimits)
+
 	args := []string{
 		"run", "--rm",
-		"--memory=512m",
+		fmt.Sprintf("--memory=%s", limits.MemoryLimit),
+		fmt.Sprintf("--pids-limit=%d", limits.ProcessLimit),
 		"--cpus=1",
 		"--network=none",
 		"-v", fmt.Sprintf(`%s:/src`, cr.MountDir),
 		"-w", "/src",
 		"gcc:latest",
-		"/bin/bash", "-c", fmt.Sprintf("g++ -o app %s && timeout 10s ./app", cr.ProgramFileName),
+		"/bin/bash", "-c", fmt.Sprintf("g++ -o app %s && timeout %s ./app", cr.ProgramFileName, limits.TimeLimit),
 	}
 
 	stdout := bytes.NewBuffer(nil)
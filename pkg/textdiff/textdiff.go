@@ -0,0 +1,269 @@
+// Package textdiff computes line based diffs of source code
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ContextLines is the number of unchanged lines around a change in a hunk
+const ContextLines = 3
+
+// maxEditDistance bounds the diff search, texts that differ more than it
+// are diffed as a whole replacement
+const maxEditDistance = 2000
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns the unified diff of from & to, it's empty when they are equal
+func Unified(fromName, toName, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	ops := diffLines(splitLines(from), splitLines(to))
+
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", fromName, toName)
+	for _, h := range hunks(ops) {
+		h.write(buf)
+	}
+	return buf.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines finds the shortest edit script with the Myers algorithm,
+// the common prefix & suffix are trimmed first as most edits are small
+func diffLines(a, b []string) []op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{opEqual, line})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{opEqual, line})
+	}
+	return ops
+}
+
+func myers(a, b []string) []op {
+	n, m := len(a), len(b)
+	maxD := n + m
+	if maxD > maxEditDistance {
+		return replaceAll(a, b)
+	}
+
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	trace := [][]int{}
+
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, offset, d)
+			}
+		}
+	}
+
+	return replaceAll(a, b)
+}
+
+// backtrack walks the saved furthest reaching paths from the end to the start
+func backtrack(a, b []string, trace [][]int, offset, d int) []op {
+	x, y := len(a), len(b)
+	reversed := []op{}
+
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, op{opEqual, a[x]})
+		}
+
+		if x == prevX {
+			y--
+			reversed = append(reversed, op{opInsert, b[y]})
+		} else {
+			x--
+			reversed = append(reversed, op{opDelete, a[x]})
+		}
+	}
+
+	for x > 0 && y > 0 {
+		x--
+		y--
+		reversed = append(reversed, op{opEqual, a[x]})
+	}
+
+	ops := make([]op, len(reversed))
+	for i := range reversed {
+		ops[i] = reversed[len(reversed)-1-i]
+	}
+	return ops
+}
+
+func replaceAll(a, b []string) []op {
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a {
+		ops = append(ops, op{opDelete, line})
+	}
+	for _, line := range b {
+		ops = append(ops, op{opInsert, line})
+	}
+	return ops
+}
+
+type hunk struct {
+	fromLine, fromCount int
+	toLine, toCount     int
+	ops                 []op
+}
+
+// hunks groups the changes with their context lines,
+// changes closer than twice the context are merged into one hunk
+func hunks(ops []op) []hunk {
+	result := []hunk{}
+
+	// fromLine & toLine are the 0 based line numbers of ops[i]
+	fromLine, toLine := 0, 0
+	var current *hunk
+	lastChange := -1
+
+	for i, o := range ops {
+		if o.kind != opEqual {
+			if current == nil || i-lastChange > 2*ContextLines {
+				start := max(0, i-ContextLines)
+				if current != nil {
+					current.ops = append(current.ops, ops[lastChange+1:lastChange+1+ContextLines]...)
+					result = append(result, *current)
+				}
+
+				fromStart, toStart := fromLine, toLine
+				for _, prev := range ops[start:i] {
+					if prev.kind == opEqual {
+						fromStart--
+						toStart--
+					}
+				}
+
+				current = &hunk{fromLine: fromStart, toLine: toStart, ops: append([]op(nil), ops[start:i]...)}
+			} else {
+				current.ops = append(current.ops, ops[lastChange+1:i]...)
+			}
+
+			current.ops = append(current.ops, o)
+			lastChange = i
+		}
+
+		switch o.kind {
+		case opEqual:
+			fromLine++
+			toLine++
+		case opDelete:
+			fromLine++
+		case opInsert:
+			toLine++
+		}
+	}
+
+	if current != nil {
+		end := min(len(ops), lastChange+1+ContextLines)
+		current.ops = append(current.ops, ops[lastChange+1:end]...)
+		result = append(result, *current)
+	}
+
+	for i := range result {
+		for _, o := range result[i].ops {
+			if o.kind != opInsert {
+				result[i].fromCount++
+			}
+			if o.kind != opDelete {
+				result[i].toCount++
+			}
+		}
+	}
+
+	return result
+}
+
+func (h hunk) write(buf *strings.Builder) {
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(h.fromLine, h.fromCount), hunkRange(h.toLine, h.toCount))
+	for _, o := range h.ops {
+		switch o.kind {
+		case opEqual:
+			buf.WriteString(" ")
+		case opDelete:
+			buf.WriteString("-")
+		case opInsert:
+			buf.WriteString("+")
+		}
+		buf.WriteString(o.line)
+		buf.WriteString("\n")
+	}
+}
+
+// hunkRange formats the 0 based start line in the unified diff 1 based format
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,47 @@
+package textdiff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		diff string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{
+			"change a line",
+			"a\nb\nc\n",
+			"a\nx\nc\n",
+			"--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			"insert into empty",
+			"",
+			"a\nb\n",
+			"--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			"far changes are separate hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			"x\n2\n3\n4\n5\n6\n7\n8\n9\ny\n",
+			"--- old\n+++ new\n@@ -1,4 +1,4 @@\n-1\n+x\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+y\n",
+		},
+		{
+			"near changes are merged",
+			"1\n2\n3\n4\n5\n",
+			"1\nx\n3\ny\n5\n",
+			"--- old\n+++ new\n@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n-4\n+y\n 5\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := Unified("old", "new", tt.from, tt.to)
+			if diff != tt.diff {
+				t.Errorf("diff:\n%s\nwant:\n%s", diff, tt.diff)
+			}
+		})
+	}
+}
@@ -7,19 +7,25 @@ package xsqlc
 
 import (
 	"context"
+	"database/sql"
 )
 
 const findAllOutboxItemIDsByStatus = `-- name: FindAllOutboxItemIDsByStatus :many
-SELECT id FROM outbox_items WHERE "status" = $1 LIMIT $2
+SELECT id FROM outbox_items
+WHERE "status" = $1
+    -- scheduled items are sent once they are due
+    AND (run_at IS NULL OR run_at <= $2)
+LIMIT $3
 `
 
 type FindAllOutboxItemIDsByStatusParams struct {
 	Status    string
+	Now       sql.NullTime
 	SizeLimit int32
 }
 
 func (q *Queries) FindAllOutboxItemIDsByStatus(ctx context.Context, arg FindAllOutboxItemIDsByStatusParams) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, findAllOutboxItemIDsByStatus, arg.Status, arg.SizeLimit)
+	rows, err := q.db.QueryContext(ctx, findAllOutboxItemIDsByStatus, arg.Status, arg.Now, arg.SizeLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +48,7 @@ func (q *Queries) FindAllOutboxItemIDsByStatus(ctx context.Context, arg FindAllO
 }
 
 const findOutboxItemByByKey = `-- name: FindOutboxItemByByKey :one
-SELECT id, idempotent_key, status, job_type, payload, version FROM outbox_items WHERE idempotent_key = $1 AND "status" = $2 LIMIT 1
+SELECT id, idempotent_key, status, job_type, payload, version, run_at FROM outbox_items WHERE idempotent_key = $1 AND "status" = $2 LIMIT 1
 `
 
 type FindOutboxItemByByKeyParams struct {
@@ -60,12 +66,13 @@ func (q *Queries) FindOutboxItemByByKey(ctx context.Context, arg FindOutboxItemB
 		&i.JobType,
 		&i.Payload,
 		&i.Version,
+		&i.RunAt,
 	)
 	return i, err
 }
 
 const findOutboxItemByID = `-- name: FindOutboxItemByID :one
-SELECT id, idempotent_key, status, job_type, payload, version FROM outbox_items WHERE id = $1
+SELECT id, idempotent_key, status, job_type, payload, version, run_at FROM outbox_items WHERE id = $1
 `
 
 func (q *Queries) FindOutboxItemByID(ctx context.Context, id string) (OutboxItem, error) {
@@ -78,6 +85,7 @@ func (q *Queries) FindOutboxItemByID(ctx context.Context, id string) (OutboxItem
 		&i.JobType,
 		&i.Payload,
 		&i.Version,
+		&i.RunAt,
 	)
 	return i, err
 }
@@ -7,11 +7,12 @@ package xsqlc
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createOutboxItem = `-- name: CreateOutboxItem :one
-INSERT INTO outbox_items (id, idempotent_key, "status", job_type, payload)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO outbox_items (id, idempotent_key, "status", job_type, payload, run_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING id, "version"
 `
 
@@ -21,6 +22,7 @@ type CreateOutboxItemParams struct {
 	Status        string
 	JobType       string
 	Payload       string
+	RunAt         sql.NullTime
 }
 
 type CreateOutboxItemRow struct {
@@ -35,6 +37,7 @@ func (q *Queries) CreateOutboxItem(ctx context.Context, arg CreateOutboxItemPara
 		arg.Status,
 		arg.JobType,
 		arg.Payload,
+		arg.RunAt,
 	)
 	var i CreateOutboxItemRow
 	err := row.Scan(&i.ID, &i.Version)
@@ -50,6 +50,7 @@ type OutboxItem struct {
 	JobType       string
 	Payload       string
 	Version       int32
+	RunAt         sql.NullTime
 }
 
 type RelUserToActivationToken struct {